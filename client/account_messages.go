@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const accountMessageListResourceEndpoint = "/admin/api/accounts/%d/messages.json"
+
+// SendAccountMessage Send a message from the provider to a developer account, i.e. about key rotations or deprecations.
+// The message shows up in the developer portal inbox of the account and is emailed to its admins
+func (c *ThreeScaleClient) SendAccountMessage(accountID int64, subject, body string) (*AccountMessage, error) {
+	if body == "" {
+		return nil, errors.New("account message needs a body")
+	}
+
+	endpoint := fmt.Sprintf(accountMessageListResourceEndpoint, accountID)
+
+	values := url.Values{}
+	values.Add("subject", subject)
+	values.Add("body", body)
+
+	req, err := c.buildPostReq(endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[AccountMessage](c, req, http.StatusCreated)
+}
+
+// AccountSegment - Developer accounts messaged by SendSegmentMessage. Zero fields match every account
+type AccountSegment struct {
+	// State of the accounts, i.e. "approved", "pending" or "rejected"
+	State string
+	// ApplicationPlanID selects the accounts with at least one application subscribed to the plan
+	ApplicationPlanID int64
+}
+
+// MessageDelivery - Outcome of the message sent to one account of a segment
+type MessageDelivery struct {
+	AccountID int64
+	// Message is the sent message, nil when sending failed
+	Message *AccountMessage
+	Err     error
+}
+
+// MessageDeliveryReport - Outcome of SendSegmentMessage, deliveries being in the account list order
+type MessageDeliveryReport struct {
+	Deliveries []MessageDelivery
+	Sent       int
+	Failed     int
+}
+
+// SendSegmentMessage sends the message to every developer account of the segment, one at a time
+// and at most one every interval, i.e. for maintenance window announcements.
+// Every account is attempted, failures being reported in the delivery report.
+// When ctx is done the accounts not messaged yet are reported with the context error
+func (c *ThreeScaleClient) SendSegmentMessage(ctx context.Context, segment AccountSegment, subject, body string, interval time.Duration) (*MessageDeliveryReport, error) {
+	if body == "" {
+		return nil, errors.New("account message needs a body")
+	}
+
+	accountIDs, err := c.segmentAccountIDs(segment)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MessageDeliveryReport{Deliveries: make([]MessageDelivery, 0, len(accountIDs))}
+	for idx, accountID := range accountIDs {
+		delivery := MessageDelivery{AccountID: accountID}
+		if idx > 0 && interval > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(interval):
+			}
+		}
+
+		if delivery.Err = ctx.Err(); delivery.Err == nil {
+			delivery.Message, delivery.Err = c.SendAccountMessage(accountID, subject, body)
+		}
+
+		if delivery.Err != nil {
+			report.Failed++
+		} else {
+			report.Sent++
+		}
+		report.Deliveries = append(report.Deliveries, delivery)
+	}
+	return report, nil
+}
+
+// segmentAccountIDs returns the IDs of the accounts of the segment, in the account list order
+func (c *ThreeScaleClient) segmentAccountIDs(segment AccountSegment) ([]int64, error) {
+	accounts, err := c.ListDeveloperAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	var subscribed map[int64]bool
+	if segment.ApplicationPlanID != 0 {
+		apps, err := c.ListAllApplicationsFiltered(Params{"plan_id": strconv.FormatInt(segment.ApplicationPlanID, 10)})
+		if err != nil {
+			return nil, err
+		}
+		subscribed = map[int64]bool{}
+		for _, app := range apps.Applications {
+			// servers not supporting the plan_id filter return every application
+			if app.Application.PlanID != 0 && app.Application.PlanID != segment.ApplicationPlanID {
+				continue
+			}
+			subscribed[app.Application.UserAccountID] = true
+		}
+	}
+
+	var accountIDs []int64
+	for _, account := range accounts.Items {
+		if account.Element.ID == nil {
+			continue
+		}
+		if segment.State != "" && (account.Element.State == nil || *account.Element.State != segment.State) {
+			continue
+		}
+		if subscribed != nil && !subscribed[*account.Element.ID] {
+			continue
+		}
+		accountIDs = append(accountIDs, *account.Element.ID)
+	}
+	return accountIDs, nil
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestSendAccountMessage(t *testing.T) {
+	var accountID int64 = 42
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf(accountMessageListResourceEndpoint, accountID),
+			Form:   map[string]string{"subject": "Key rotation", "body": "Your keys rotate tomorrow"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusCreated, AccountMessage{Element: AccountMessageItem{
+			ID: 7, Subject: "Key rotation", Body: "Your keys rotate tomorrow", State: "sent",
+		}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	message, err := c.SendAccountMessage(accountID, "Key rotation", "Your keys rotate tomorrow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(7), message.Element.ID)
+	equals(t, "sent", message.Element.State)
+}
+
+func TestSendAccountMessageErrors(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+	})
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	if _, err := c.SendAccountMessage(42, "subject", ""); err == nil {
+		t.Fatal("expected missing body error")
+	}
+
+	if _, err := c.SendAccountMessage(42, "subject", "body"); !IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+}
+
+func segmentTestClient(t *testing.T, sent *[]string, failAccount string) *ThreeScaleClient {
+	approved, pending := "approved", "pending"
+	account := func(id int64, state *string) DeveloperAccount {
+		return DeveloperAccount{Element: DeveloperAccountItem{ID: &id, State: state}}
+	}
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.Path {
+		case developerAccountListResourceEndpoint:
+			return jsonTestResponse(t, http.StatusOK, DeveloperAccountList{Items: []DeveloperAccount{
+				account(1, &approved), account(2, &pending), account(3, &approved), account(4, &approved),
+			}})
+		case listAllApplications:
+			equals(t, "10", req.URL.Query().Get("plan_id"))
+			return jsonTestResponse(t, http.StatusOK, ApplicationList{Applications: []ApplicationElem{
+				{Application: Application{ID: 100, UserAccountID: 1, PlanID: 10}},
+				{Application: Application{ID: 101, UserAccountID: 2, PlanID: 10}},
+				{Application: Application{ID: 102, UserAccountID: 3, PlanID: 10}},
+				{Application: Application{ID: 103, UserAccountID: 4, PlanID: 11}},
+			}})
+		}
+
+		accountID := strings.TrimSuffix(strings.TrimPrefix(req.URL.Path, "/admin/api/accounts/"), "/messages.json")
+		*sent = append(*sent, accountID)
+		if accountID == failAccount {
+			return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+		}
+		return jsonTestResponse(t, http.StatusCreated, AccountMessage{Element: AccountMessageItem{Subject: "Maintenance"}})
+	})
+
+	return NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+}
+
+func TestSendSegmentMessage(t *testing.T) {
+	var sent []string
+	c := segmentTestClient(t, &sent, "3")
+
+	start := time.Now()
+	report, err := c.SendSegmentMessage(context.Background(), AccountSegment{State: "approved", ApplicationPlanID: 10},
+		"Maintenance", "Down on Sunday", 20*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Fatalf("expected messages to be spaced by the interval; took %v", elapsed)
+	}
+
+	equals(t, []string{"1", "3"}, sent)
+	equals(t, 1, report.Sent)
+	equals(t, 1, report.Failed)
+	equals(t, int64(1), report.Deliveries[0].AccountID)
+	equals(t, "Maintenance", report.Deliveries[0].Message.Element.Subject)
+	equals(t, int64(3), report.Deliveries[1].AccountID)
+	if !IsNotFound(report.Deliveries[1].Err) {
+		t.Fatalf("expected not found error; got %v", report.Deliveries[1].Err)
+	}
+}
+
+func TestSendSegmentMessageCancelled(t *testing.T) {
+	var sent []string
+	c := segmentTestClient(t, &sent, "")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := c.SendSegmentMessage(ctx, AccountSegment{}, "Maintenance", "Down on Sunday", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 0, len(sent))
+	equals(t, 4, report.Failed)
+	equals(t, context.Canceled, report.Deliveries[3].Err)
+}
@@ -11,7 +11,7 @@ const (
 )
 
 // Deprecated: Use ListDeveloperAccounts instead
-func (c *ThreeScaleClient) ListAccounts() (*AccountList, error) {
+func (c *ThreeScaleClient) ListAccounts(opts ...ListOptions) (*AccountList, error) {
 	req, err := c.buildGetReq(accountList)
 	if err != nil {
 		return nil, err
@@ -20,34 +20,12 @@ func (c *ThreeScaleClient) ListAccounts() (*AccountList, error) {
 	urlValues := url.Values{}
 	req.URL.RawQuery = urlValues.Encode()
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	applyListOptions(req, opts...)
 
-	accountList := &AccountList{}
-	err = handleJsonResp(resp, http.StatusOK, accountList)
-	return accountList, err
+	return doReq[AccountList](c, req, http.StatusOK)
 }
 
 func (c *ThreeScaleClient) FindAccount(username string) (*Account, error) {
-	req, err := c.buildGetReq(findAccount)
-	if err != nil {
-		return nil, err
-	}
-
-	urlValues := url.Values{}
-	urlValues.Add("username", username)
-	req.URL.RawQuery = urlValues.Encode()
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	apiResp := &AccountElem{}
-	err = handleJsonResp(resp, http.StatusOK, apiResp)
+	apiResp, err := doParams[AccountElem](c, http.MethodGet, findAccount, Params{"username": username}, http.StatusOK)
 	return &apiResp.Account, err
 }
@@ -14,41 +14,22 @@ const (
 )
 
 // ListActiveDocs List existing activedocs for the client provider account
-func (c *ThreeScaleClient) ListActiveDocs() (*ActiveDocList, error) {
+func (c *ThreeScaleClient) ListActiveDocs(opts ...ListOptions) (*ActiveDocList, error) {
 	req, err := c.buildGetReq(activeDocListEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	applyListOptions(req, opts...)
 
-	activeDocList := &ActiveDocList{}
-	err = handleJsonResp(resp, http.StatusOK, activeDocList)
-	return activeDocList, err
+	return doReq[ActiveDocList](c, req, http.StatusOK)
 }
 
 // ActiveDoc Reads 3scale Activedoc
 func (c *ThreeScaleClient) ActiveDoc(id int64) (*ActiveDoc, error) {
 	endpoint := fmt.Sprintf(activeDocEndpoint, id)
 
-	req, err := c.buildGetJSONReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	activeDoc := &ActiveDoc{}
-	err = handleJsonResp(resp, http.StatusOK, activeDoc)
-	return activeDoc, err
+	return doGet[ActiveDoc](c, endpoint)
 }
 
 // CreateActiveDoc Create 3scale activedoc
@@ -64,15 +45,7 @@ func (c *ThreeScaleClient) CreateActiveDoc(activeDoc *ActiveDoc) (*ActiveDoc, er
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &ActiveDoc{}
-	err = handleJsonResp(resp, http.StatusCreated, respObj)
-	return respObj, err
+	return doReq[ActiveDoc](c, req, http.StatusCreated)
 }
 
 // UpdateActiveDoc Update existing activedoc
@@ -98,33 +71,14 @@ func (c *ThreeScaleClient) UpdateActiveDoc(activeDoc *ActiveDoc) (*ActiveDoc, er
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &ActiveDoc{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[ActiveDoc](c, req, http.StatusOK)
 }
 
 // DeleteActiveDoc Delete existing activedoc
 func (c *ThreeScaleClient) DeleteActiveDoc(id int64) error {
 	endpoint := fmt.Sprintf(activeDocEndpoint, id)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // UnbindActiveDocFromProduct removes product relationship from activedoc object
@@ -150,13 +104,5 @@ func (c *ThreeScaleClient) UnbindActiveDocFromProduct(id int64) (*ActiveDoc, err
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &ActiveDoc{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[ActiveDoc](c, req, http.StatusOK)
 }
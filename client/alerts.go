@@ -0,0 +1,89 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	alertListResourceEndpoint     = "/admin/api/alerts.json"
+	alertResourceEndpoint         = "/admin/api/alerts/%d.json"
+	alertAllResourceEndpoint      = "/admin/api/alerts/all.json"
+	ALERTS_PER_PAGE           int = 500
+)
+
+// ListAlerts List existing limit alerts
+// filterParams are sent as query params, i.e. "state" ('unread', 'read') or "application_id"
+func (c *ThreeScaleClient) ListAlerts(filterParams Params, opts ...ListOptions) (*AlertList, error) {
+	list := &AlertList{}
+
+	err := c.fetchListPages(mergeListOptions(opts), ALERTS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listAlertsPage(filterParams, options)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		return len(tmpList.Alerts), tmpList.Metadata, func() {
+			list.Alerts = append(list.Alerts, tmpList.Alerts...)
+			list.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ListAlertsPerPage List existing limit alerts in a single page
+// paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
+// paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
+func (c *ThreeScaleClient) ListAlertsPerPage(filterParams Params, paginationValues ...int) (*AlertList, error) {
+	return c.listAlertsPage(filterParams, paginationOptions(paginationValues))
+}
+
+func (c *ThreeScaleClient) listAlertsPage(filterParams Params, options ListOptions) (*AlertList, error) {
+	req, err := c.buildGetJSONReq(alertListResourceEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for k, v := range filterParams {
+		values.Add(k, v)
+	}
+	options.apply(values)
+	req.URL.RawQuery = values.Encode()
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	list := &AlertList{}
+	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
+	return list, err
+}
+
+// ReadAlert Reads 3scale limit alert
+func (c *ThreeScaleClient) ReadAlert(id int64) (*Alert, error) {
+	endpoint := fmt.Sprintf(alertResourceEndpoint, id)
+
+	return doGet[Alert](c, endpoint)
+}
+
+// DeleteAlert Delete 3scale limit alert
+func (c *ThreeScaleClient) DeleteAlert(id int64) error {
+	return c.deleteAlerts(fmt.Sprintf(alertResourceEndpoint, id))
+}
+
+// DeleteAllAlerts Delete every 3scale limit alert of the provider account
+func (c *ThreeScaleClient) DeleteAllAlerts() error {
+	return c.deleteAlerts(alertAllResourceEndpoint)
+}
+
+func (c *ThreeScaleClient) deleteAlerts(endpoint string) error {
+	return doDelete(c, endpoint)
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestListAlerts(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		// Will serve: 2 pages
+		// page 1 => ALERTS_PER_PAGE
+		// page 2 => 1
+		if req.URL.Path != alertListResourceEndpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", alertListResourceEndpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodGet {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
+		}
+
+		equals(t, "unread", req.URL.Query().Get("state"))
+		equals(t, strconv.Itoa(ALERTS_PER_PAGE), req.URL.Query().Get("per_page"))
+
+		n := 0
+		switch req.URL.Query().Get("page") {
+		case "1":
+			n = ALERTS_PER_PAGE
+		case "2":
+			n = 1
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", req.URL.Query().Get("page"))
+		}
+
+		list := AlertList{Alerts: make([]Alert, 0, n)}
+		for idx := 0; idx < n; idx++ {
+			list.Alerts = append(list.Alerts, Alert{Element: AlertItem{ID: int64(idx), State: "unread"}})
+		}
+		return jsonTestResponse(t, http.StatusOK, list)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListAlerts(Params{"state": "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, ALERTS_PER_PAGE+1, len(list.Alerts))
+}
+
+func TestReadAlert(t *testing.T) {
+	var (
+		alertID  int64 = 4
+		endpoint       = fmt.Sprintf(alertResourceEndpoint, alertID)
+		alert          = Alert{Element: AlertItem{ID: alertID, ApplicationID: 7, Level: 90, Utilization: 0.93, Message: "hits per minute: 93%"}}
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodGet {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
+		}
+
+		return jsonTestResponse(t, http.StatusOK, alert)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.ReadAlert(alertID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, alert, *obj)
+}
+
+func TestDeleteAlerts(t *testing.T) {
+	tests := []struct {
+		Name     string
+		endpoint string
+		call     func(c *ThreeScaleClient) error
+	}{
+		{"single", fmt.Sprintf(alertResourceEndpoint, 4), func(c *ThreeScaleClient) error { return c.DeleteAlert(4) }},
+		{"all", alertAllResourceEndpoint, func(c *ThreeScaleClient) error { return c.DeleteAllAlerts() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				if req.URL.Path != tt.endpoint {
+					subT.Fatalf("Path does not match. Expected [%s]; got [%s]", tt.endpoint, req.URL.Path)
+				}
+
+				if req.Method != http.MethodDelete {
+					subT.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodDelete, req.Method)
+				}
+
+				return jsonTestResponse(subT, http.StatusOK, struct{}{})
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", httpClient)
+			if err := tt.call(c); err != nil {
+				subT.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"strconv"
+)
+
+const serviceTokenAuthenticationType = "service_token"
+
+// APIcastDeploymentParams reads the latest proxy config of the environment and
+// returns the parameters to configure a self-managed APIcast serving it.
+// env parameter should be one of 'sandbox', 'production'
+func (c *ThreeScaleClient) APIcastDeploymentParams(svcId string, env string) (*APIcastDeploymentParams, error) {
+	if err := validateProxyConfigEnv(env); err != nil {
+		return nil, err
+	}
+
+	pc, err := c.GetLatestProxyConfig(svcId, env)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := NewAPIcastDeploymentParams(pc.ProxyConfig)
+	if err != nil {
+		return nil, err
+	}
+	params.PortalEndpoint = c.adminPortal.rawURL
+	return params, nil
+}
+
+// NewAPIcastDeploymentParams extracts the self-managed APIcast parameters from a proxy config.
+// PortalEndpoint is left empty, since the proxy config does not hold the admin portal URL
+func NewAPIcastDeploymentParams(pc ProxyConfig) (*APIcastDeploymentParams, error) {
+	if err := validateProxyConfigEnv(pc.Environment); err != nil {
+		return nil, err
+	}
+
+	proxy := pc.Content.Proxy
+
+	params := &APIcastDeploymentParams{
+		ServiceID:       pc.Content.ID,
+		Version:         pc.Version,
+		Environment:     pc.Environment,
+		DeploymentEnv:   "production",
+		PublicEndpoint:  proxy.Endpoint,
+		Hosts:           append([]string{}, proxy.Hosts...),
+		BackendEndpoint: proxy.Backend.Endpoint,
+		BackendHost:     proxy.Backend.Host,
+	}
+
+	if pc.Environment == ProxyConfigEnvSandbox {
+		params.DeploymentEnv = "staging"
+		params.PublicEndpoint = proxy.SandboxEndpoint
+	}
+
+	if pc.Content.BackendAuthenticationType == serviceTokenAuthenticationType {
+		params.ServiceToken = pc.Content.BackendAuthenticationValue
+	}
+
+	return params, nil
+}
+
+// Env returns the APIcast environment variables matching the parameters.
+// THREESCALE_PORTAL_ENDPOINT still needs an access token in its user info to be usable by APIcast
+func (p *APIcastDeploymentParams) Env() map[string]string {
+	env := map[string]string{
+		"THREESCALE_DEPLOYMENT_ENV": p.DeploymentEnv,
+		"APICAST_SERVICES_LIST":     strconv.FormatInt(p.ServiceID, 10),
+	}
+	if p.PortalEndpoint != "" {
+		env["THREESCALE_PORTAL_ENDPOINT"] = p.PortalEndpoint
+	}
+	if p.BackendEndpoint != "" {
+		env["BACKEND_ENDPOINT_OVERRIDE"] = p.BackendEndpoint
+	}
+	return env
+}
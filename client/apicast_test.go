@@ -0,0 +1,92 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func apicastTestProxyConfig(env string) ProxyConfig {
+	return ProxyConfig{
+		ID:          5,
+		Version:     3,
+		Environment: env,
+		Content: Content{
+			ID:                         12,
+			BackendAuthenticationType:  "service_token",
+			BackendAuthenticationValue: "someServiceToken",
+			Proxy: ContentProxy{
+				Endpoint:        "https://api.example.com:443",
+				SandboxEndpoint: "https://api-staging.example.com:443",
+				Hosts:           []string{"api.example.com", "api-staging.example.com"},
+				Backend: Backend{
+					Endpoint: "https://su1.3scale.net",
+					Host:     "su1.3scale.net",
+				},
+			},
+		},
+	}
+}
+
+func TestNewAPIcastDeploymentParams(t *testing.T) {
+	params, err := NewAPIcastDeploymentParams(apicastTestProxyConfig(ProxyConfigEnvProduction))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, &APIcastDeploymentParams{
+		ServiceID:       12,
+		Version:         3,
+		Environment:     ProxyConfigEnvProduction,
+		DeploymentEnv:   "production",
+		PublicEndpoint:  "https://api.example.com:443",
+		Hosts:           []string{"api.example.com", "api-staging.example.com"},
+		BackendEndpoint: "https://su1.3scale.net",
+		BackendHost:     "su1.3scale.net",
+		ServiceToken:    "someServiceToken",
+	}, params)
+
+	pc := apicastTestProxyConfig(ProxyConfigEnvSandbox)
+	pc.Content.BackendAuthenticationType = "provider_key"
+	params, err = NewAPIcastDeploymentParams(pc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, "staging", params.DeploymentEnv)
+	equals(t, "https://api-staging.example.com:443", params.PublicEndpoint)
+	equals(t, "", params.ServiceToken)
+
+	if _, err := NewAPIcastDeploymentParams(ProxyConfig{Environment: "staging"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestAPIcastDeploymentParams(t *testing.T) {
+	var (
+		svcID    = "12"
+		env      = ProxyConfigEnvProduction
+		endpoint = fmt.Sprintf(proxyConfigLatestGet, svcID, env)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		return jsonTestResponse(t, http.StatusOK, ProxyConfigElement{ProxyConfig: apicastTestProxyConfig(env)})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	params, err := c.APIcastDeploymentParams(svcID, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, map[string]string{
+		"THREESCALE_DEPLOYMENT_ENV":  "production",
+		"APICAST_SERVICES_LIST":      "12",
+		"THREESCALE_PORTAL_ENDPOINT": "https://www.test.com:443",
+		"BACKEND_ENDPOINT_OVERRIDE":  "https://su1.3scale.net",
+	}, params.Env())
+}
@@ -6,63 +6,126 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
-	appRead                    = "/admin/api/accounts/%d/applications/%d.json"
-	appCreate                  = "/admin/api/accounts/%s/applications.json"
-	appList                    = "/admin/api/accounts/%d/applications.json"
-	appUpdate                  = "/admin/api/accounts/%d/applications/%d.json"
-	appDelete                  = "/admin/api/accounts/%d/applications/%d.json"
-	appChangePlan              = "/admin/api/accounts/%d/applications/%d/change_plan.json"
-	appCreatePlanCustomization = "/admin/api/accounts/%d/applications/%d/customize_plan.json"
-	appDeletePlanCustomization = "/admin/api/accounts/%d/applications/%d/decustomize_plan.json"
-	appSuspend                 = "/admin/api/accounts/%d/applications/%d/suspend.json"
-	appResume                  = "/admin/api/accounts/%d/applications/%d/resume.json"
-	listAllApplications        = "/admin/api/applications.json"
+	appRead                        = "/admin/api/accounts/%d/applications/%d.json"
+	appCreate                      = "/admin/api/accounts/%s/applications.json"
+	appList                        = "/admin/api/accounts/%d/applications.json"
+	appUpdate                      = "/admin/api/accounts/%d/applications/%d.json"
+	appDelete                      = "/admin/api/accounts/%d/applications/%d.json"
+	appChangePlan                  = "/admin/api/accounts/%d/applications/%d/change_plan.json"
+	appCreatePlanCustomization     = "/admin/api/accounts/%d/applications/%d/customize_plan.json"
+	appDeletePlanCustomization     = "/admin/api/accounts/%d/applications/%d/decustomize_plan.json"
+	appSuspend                     = "/admin/api/accounts/%d/applications/%d/suspend.json"
+	appResume                      = "/admin/api/accounts/%d/applications/%d/resume.json"
+	listAllApplications            = "/admin/api/applications.json"
+	APPLICATIONS_PER_PAGE      int = 500
+)
+
+// ApplicationState - State of an application as returned by Application.AppState
+type ApplicationState string
+
+// Application states
+const (
+	ApplicationStatePending   ApplicationState = "pending"
+	ApplicationStateLive      ApplicationState = "live"
+	ApplicationStateSuspended ApplicationState = "suspended"
 )
 
 // CreateApp - Create an application.
 // The application object can be extended with Fields Definitions in the Admin Portal where you can add/remove fields
+// Deprecated: Use CreateApplication instead
 func (c *ThreeScaleClient) CreateApp(accountId, planId, name, description string) (Application, error) {
-	var app Application
-	endpoint := fmt.Sprintf(appCreate, accountId)
+	app, err := c.createApplication(accountId, planId, name, Params{"description": description})
+	if err != nil {
+		return Application{}, err
+	}
+	return *app, nil
+}
+
+// CreateApplication Create an application of the account subscribed to the given application plan.
+// params are sent along with the name, i.e. "description", "user_key", "application_id", "application_key",
+// "redirect_url" or the custom fields defined in the Admin Portal. See ApplicationCreateParams
+func (c *ThreeScaleClient) CreateApplication(accountID, planID int64, name string, params Params) (*Application, error) {
+	return c.createApplication(strconv.FormatInt(accountID, 10), strconv.FormatInt(planID, 10), name, params)
+}
+
+func (c *ThreeScaleClient) createApplication(accountID, planID, name string, params Params) (*Application, error) {
+	endpoint := fmt.Sprintf(appCreate, accountID)
 
 	values := url.Values{}
-	values.Add("account_id", accountId)
-	values.Add("plan_id", planId)
-	values.Add("name", name)
-	values.Add("description", description)
+	for k, v := range params {
+		values.Add(k, v)
+	}
+	values.Set("account_id", accountID)
+	values.Set("plan_id", planID)
+	values.Set("name", name)
 
 	body := strings.NewReader(values.Encode())
 	req, err := c.buildPostReq(endpoint, body)
 	if err != nil {
-		return app, httpReqError
+		return nil, httpReqError
 	}
+	req.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
-		return app, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	apiResp := &ApplicationElem{}
 	err = handleJsonResp(resp, http.StatusCreated, apiResp)
 	if err != nil {
-		return app, err
+		return nil, serviceSubscriptionErr(accountID, planID, err)
 	}
-	return apiResp.Application, nil
+	return &apiResp.Application, nil
+}
+
+// CreateAppWithServiceSubscription - Create an application, subscribing the account to the product first
+// when the tenant has service plans enabled and the account is not subscribed yet.
+func (c *ThreeScaleClient) CreateAppWithServiceSubscription(accountID, productID, planID int64, name, description string) (Application, error) {
+	params := Params{"description": description}
+
+	app, err := c.CreateApplication(accountID, planID, name, params)
+	if IsServiceSubscriptionRequired(err) {
+		if _, err := c.EnsureServiceSubscription(accountID, productID); err != nil {
+			return Application{}, err
+		}
+		app, err = c.CreateApplication(accountID, planID, name, params)
+	}
+	if err != nil {
+		return Application{}, err
+	}
+	return *app, nil
 }
 
 // ListApplications - List of applications for a given account.
-func (c *ThreeScaleClient) ListApplications(accountID int64) (*ApplicationList, error) {
+func (c *ThreeScaleClient) ListApplications(accountID int64, opts ...ListOptions) (*ApplicationList, error) {
+	return c.ListApplicationsFiltered(accountID, nil, opts...)
+}
+
+// ListApplicationsFiltered List applications of a given account matching the filters.
+// filterParams are sent as query params, i.e. "state" ('live', 'suspended', 'pending'), "plan_id", "service_id",
+// "active_since" or "inactive_since" (YYYY-MM-DD)
+func (c *ThreeScaleClient) ListApplicationsFiltered(accountID int64, filterParams Params, opts ...ListOptions) (*ApplicationList, error) {
 	endpoint := fmt.Sprintf(appList, accountID)
 	req, err := c.buildGetReq(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	values := url.Values{}
+	for k, v := range filterParams {
+		values.Add(k, v)
+	}
+	req.URL.RawQuery = values.Encode()
+
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +133,7 @@ func (c *ThreeScaleClient) ListApplications(accountID int64) (*ApplicationList,
 
 	applicationList := &ApplicationList{}
 	err = handleJsonResp(resp, http.StatusOK, applicationList)
+	applicationList.Metadata = pageInfo(applicationList.Metadata, resp.Header)
 	return applicationList, err
 }
 
@@ -77,84 +141,27 @@ func (c *ThreeScaleClient) ListApplications(accountID int64) (*ApplicationList,
 func (c *ThreeScaleClient) DeleteApplication(accountID, id int64) error {
 	applicationEndpoint := fmt.Sprintf(appDelete, accountID, id)
 
-	req, err := c.buildDeleteReq(applicationEndpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, applicationEndpoint)
 }
 
 func (c *ThreeScaleClient) UpdateApplication(accountID, id int64, params Params) (*Application, error) {
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
 	applicationEndpoint := fmt.Sprintf(appUpdate, accountID, id)
 
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(applicationEndpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	apiResp := &ApplicationElem{}
-	err = handleJsonResp(resp, http.StatusOK, apiResp)
+	apiResp, err := doPut[ApplicationElem](c, applicationEndpoint, params)
 	return &apiResp.Application, err
 }
 
 func (c *ThreeScaleClient) ChangeApplicationPlan(accountID, id, planId int64) (*Application, error) {
-	values := url.Values{}
-	values.Add("plan_id", strconv.FormatInt(planId, 10))
-
 	applicationEndpoint := fmt.Sprintf(appChangePlan, accountID, id)
 
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(applicationEndpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	apiResp := &ApplicationElem{}
-	err = handleJsonResp(resp, http.StatusOK, apiResp)
+	apiResp, err := doPut[ApplicationElem](c, applicationEndpoint, Params{"plan_id": strconv.FormatInt(planId, 10)})
 	return &apiResp.Application, err
 }
 
 func (c *ThreeScaleClient) CreateApplicationCustomPlan(accountId, id int64) (*ApplicationPlanItem, error) {
 	endpoint := fmt.Sprintf(appCreatePlanCustomization, accountId, id)
 
-	req, err := c.buildUpdateReq(endpoint, nil)
-	if err != nil {
-		return nil, httpReqError
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	apiResp := &ApplicationPlan{}
-	err = handleJsonResp(resp, http.StatusOK, apiResp)
+	apiResp, err := doPut[ApplicationPlan](c, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +176,7 @@ func (c *ThreeScaleClient) DeleteApplicationCustomPlan(accountID, id int64) erro
 		return err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -181,19 +188,7 @@ func (c *ThreeScaleClient) DeleteApplicationCustomPlan(accountID, id int64) erro
 func (c *ThreeScaleClient) ApplicationSuspend(accountId, id int64) (*Application, error) {
 	endpoint := fmt.Sprintf(appSuspend, accountId, id)
 
-	req, err := c.buildUpdateReq(endpoint, nil)
-	if err != nil {
-		return nil, httpReqError
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	apiResp := &ApplicationElem{}
-	err = handleJsonResp(resp, http.StatusOK, apiResp)
+	apiResp, err := doPut[ApplicationElem](c, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -203,19 +198,7 @@ func (c *ThreeScaleClient) ApplicationSuspend(accountId, id int64) (*Application
 func (c *ThreeScaleClient) ApplicationResume(accountId, id int64) (*Application, error) {
 	endpoint := fmt.Sprintf(appResume, accountId, id)
 
-	req, err := c.buildUpdateReq(endpoint, nil)
-	if err != nil {
-		return nil, httpReqError
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	apiResp := &ApplicationElem{}
-	err = handleJsonResp(resp, http.StatusOK, apiResp)
+	apiResp, err := doPut[ApplicationElem](c, endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -225,31 +208,68 @@ func (c *ThreeScaleClient) ApplicationResume(accountId, id int64) (*Application,
 func (c *ThreeScaleClient) Application(accountId, id int64) (*Application, error) {
 	endpoint := fmt.Sprintf(appRead, accountId, id)
 
-	req, err := c.buildGetJSONReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
+	apiResp, err := doGet[ApplicationElem](c, endpoint)
+	return &apiResp.Application, err
+}
+
+// ListAllApplications List every application of the provider, fetching all pages.
+// Pages are fetched concurrently when enabled with SetPageConcurrency
+func (c *ThreeScaleClient) ListAllApplications(opts ...ListOptions) (*ApplicationList, error) {
+	return c.ListAllApplicationsFiltered(nil, opts...)
+}
 
-	resp, err := c.httpClient.Do(req)
+// ListAllApplicationsFiltered List every application of the provider matching the filters, fetching all pages.
+// filterParams are sent as query params, i.e. "state" ('live', 'suspended', 'pending'), "plan_id", "service_id",
+// "active_since" or "inactive_since" (YYYY-MM-DD)
+func (c *ThreeScaleClient) ListAllApplicationsFiltered(filterParams Params, opts ...ListOptions) (*ApplicationList, error) {
+	list := &ApplicationList{}
+
+	err := c.fetchListPages(mergeListOptions(opts), APPLICATIONS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listAllApplicationsPage(filterParams, options)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		return len(tmpList.Applications), tmpList.Metadata, func() {
+			list.Applications = append(list.Applications, tmpList.Applications...)
+			list.Metadata = tmpList.Metadata
+		}, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	apiResp := &ApplicationElem{}
-	err = handleJsonResp(resp, http.StatusOK, apiResp)
-	return &apiResp.Application, err
+	return list, nil
 }
 
-func (c *ThreeScaleClient) ListAllApplications() (*ApplicationList, error) {
-	endpoint := listAllApplications
+// ListAllApplicationsPerPage List applications of the provider for a given page
+// paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
+// paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
+func (c *ThreeScaleClient) ListAllApplicationsPerPage(paginationValues ...int) (*ApplicationList, error) {
+	return c.ListAllApplicationsFilteredPerPage(nil, paginationValues...)
+}
+
+// ListAllApplicationsFilteredPerPage List applications of the provider matching the filters for a given page.
+// See ListAllApplicationsFiltered for filterParams and ListAllApplicationsPerPage for paginationValues
+func (c *ThreeScaleClient) ListAllApplicationsFilteredPerPage(filterParams Params, paginationValues ...int) (*ApplicationList, error) {
+	return c.listAllApplicationsPage(filterParams, paginationOptions(paginationValues))
+}
 
-	req, err := c.buildGetJSONReq(endpoint)
+func (c *ThreeScaleClient) listAllApplicationsPage(filterParams Params, options ListOptions) (*ApplicationList, error) {
+	queryValues := url.Values{}
+	for k, v := range filterParams {
+		queryValues.Add(k, v)
+	}
+
+	options.apply(queryValues)
+
+	req, err := c.buildGetJSONReq(listAllApplications)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	req.URL.RawQuery = queryValues.Encode()
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -257,5 +277,41 @@ func (c *ThreeScaleClient) ListAllApplications() (*ApplicationList, error) {
 
 	apiResp := &ApplicationList{}
 	err = handleJsonResp(resp, http.StatusOK, apiResp)
+	apiResp.Metadata = pageInfo(apiResp.Metadata, resp.Header)
 	return apiResp, err
 }
+
+// AppState returns the application state as ApplicationState
+func (a *Application) AppState() ApplicationState {
+	return ApplicationState(a.State)
+}
+
+// CreatedTime parses the created_at timestamp of the application
+func (a *Application) CreatedTime() (time.Time, error) {
+	return parseAPITime(a.CreatedAt)
+}
+
+// UpdatedTime parses the updated_at timestamp of the application
+func (a *Application) UpdatedTime() (time.Time, error) {
+	return parseAPITime(a.UpdatedAt)
+}
+
+// FirstTrafficTime parses the first_traffic_at timestamp of the application.
+// The zero time is returned when the application never had traffic
+func (a *Application) FirstTrafficTime() (time.Time, error) {
+	return parseAPITime(a.FirstTrafficAt)
+}
+
+// FirstDailyTrafficTime parses the first_daily_traffic_at timestamp of the application.
+// The zero time is returned when the application never had traffic
+func (a *Application) FirstDailyTrafficTime() (time.Time, error) {
+	return parseAPITime(a.FirstDailyTrafficAt)
+}
+
+// parseAPITime parses RFC3339 timestamps returned by the API, empty values are parsed as the zero time
+func parseAPITime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
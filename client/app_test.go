@@ -3,12 +3,15 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/3scale/3scale-porta-go-client/fake"
 )
@@ -30,7 +33,7 @@ func TestCreateApp(t *testing.T) {
 		{
 			name:      "Test app creation fail",
 			returnErr: true,
-			expectErr: `error calling 3scale system - reason: { "error": "Your access token does not have the correct permissions" } - code: 403`,
+			expectErr: `error calling 3scale system - reason: { "error": "Your access token does not have the correct permissions" } - code: 403 - endpoint: POST /admin/api/accounts/321/applications.json`,
 		},
 		{
 			name: "Test app creation success",
@@ -39,13 +42,16 @@ func TestCreateApp(t *testing.T) {
 
 	for _, input := range inputs {
 		httpClient := NewTestClient(func(req *http.Request) *http.Response {
-			if req.Method != http.MethodPost {
-				t.Fatalf("wrong helper called for create app api")
-			}
-
-			if req.URL.Path != "/admin/api/accounts/321/applications.json" {
-				t.Fatal("wrong url generated by CreateApp function")
-			}
+			fake.RequestAssertion{
+				Method: http.MethodPost,
+				Path:   "/admin/api/accounts/321/applications.json",
+				Form: map[string]string{
+					"account_id":  accountID,
+					"plan_id":     planID,
+					"name":        name,
+					"description": input.name,
+				},
+			}.Assert(t, req)
 
 			if input.returnErr {
 				return fake.CreateAppError()
@@ -78,6 +84,63 @@ func TestCreateApp(t *testing.T) {
 	}
 }
 
+func TestCreateApplication(t *testing.T) {
+	var (
+		accountID int64 = 321
+		planID    int64 = 71
+		appID     int64 = 157
+	)
+
+	params, err := ApplicationCreateParams{
+		Description:    &[]string{"Webshop"}[0],
+		ApplicationID:  &[]string{"my-app-id"}[0],
+		ApplicationKey: &[]string{"my-app-key"}[0],
+		RedirectURL:    &[]string{"https://example.com/callback"}[0],
+		Extra:          Params{"tier": "gold"},
+	}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf(appCreate, "321"),
+			Form: map[string]string{
+				"account_id":      "321",
+				"plan_id":         "71",
+				"name":            "webshop",
+				"description":     "Webshop",
+				"application_id":  "my-app-id",
+				"application_key": "my-app-key",
+				"redirect_url":    "https://example.com/callback",
+				"tier":            "gold",
+			},
+		}.Assert(t, req)
+		equals(t, "application/json", req.Header.Get("Accept"))
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body: ioutil.NopCloser(strings.NewReader(
+				`{"application":{"id":157,"user_account_id":"321","plan_id":71,"name":"webshop","description":"Webshop","tier":"gold"}}`,
+			)),
+			Header: make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	app, err := c.CreateApplication(accountID, planID, "webshop", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, appID, app.ID)
+	equals(t, accountID, app.UserAccountID)
+	equals(t, planID, app.PlanID)
+	equals(t, "Webshop", app.Description)
+	equals(t, map[string]interface{}{"tier": "gold"}, app.Extra)
+}
+
 func TestListApp(t *testing.T) {
 	const (
 		accessToken = "someAccessToken"
@@ -109,13 +172,10 @@ func TestListApp(t *testing.T) {
 
 	for _, input := range inputs {
 		httpClient := NewTestClient(func(req *http.Request) *http.Response {
-			if req.Method != http.MethodGet {
-				t.Fatalf("wrong helper called")
-			}
-
-			if req.URL.Path != fmt.Sprintf(appList, accountID) {
-				t.Fatalf("wrong url generated")
-			}
+			fake.RequestAssertion{
+				Method: http.MethodGet,
+				Path:   fmt.Sprintf(appList, accountID),
+			}.Assert(t, req)
 
 			bodyReader := bytes.NewReader(helperLoadBytes(t, input.ResponseBodyFile))
 			return &http.Response{
@@ -170,14 +230,10 @@ func TestDeleteApp(t *testing.T) {
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-
-		if req.URL.Path != endpoint {
-			t.Fatal("wrong url generated by CreateApp function")
-		}
-
-		if req.Method != http.MethodDelete {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodDelete, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodDelete,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		return &http.Response{
 			StatusCode: http.StatusOK,
@@ -203,17 +259,14 @@ func TestUpdateApplication(t *testing.T) {
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-		if req.URL.Path != endpoint {
-			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
-		}
-
-		if req.Method != http.MethodPut {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPut, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		application := &ApplicationElem{
 			Application{
-				UserAccountID: strconv.FormatInt(accountID, 10),
+				UserAccountID: accountID,
 				ID:            appID,
 				AppName:       "newName",
 			},
@@ -259,17 +312,14 @@ func TestChangeApplicationPlan(t *testing.T) {
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-		if req.URL.Path != endpoint {
-			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
-		}
-
-		if req.Method != http.MethodPut {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPut, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		application := &ApplicationElem{
 			Application{
-				UserAccountID: strconv.FormatInt(accountID, 10),
+				UserAccountID: accountID,
 				ID:            appID,
 				PlanID:        16,
 			},
@@ -318,13 +368,10 @@ func TestCreateApplicationCustomPlan(t *testing.T) {
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-		if req.URL.Path != endpoint {
-			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
-		}
-
-		if req.Method != http.MethodPut {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPut, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		applicationPlan := &ApplicationPlan{
 			Element: ApplicationPlanItem{
@@ -383,13 +430,10 @@ func TestDeleteApplicationCustomPlan(t *testing.T) {
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-		if req.URL.Path != endpoint {
-			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
-		}
-
-		if req.Method != http.MethodPut {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPut, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		return &http.Response{
 			StatusCode: http.StatusOK,
@@ -406,6 +450,27 @@ func TestDeleteApplicationCustomPlan(t *testing.T) {
 	}
 }
 
+func TestApplicationStateChangeErrorReturnsNil(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	app, err := c.ApplicationSuspend(321, 12)
+	if err == nil || app != nil {
+		t.Fatalf("expected nil application and error; got %v, %v", app, err)
+	}
+	app, err = c.ApplicationResume(321, 12)
+	if err == nil || app != nil {
+		t.Fatalf("expected nil application and error; got %v, %v", app, err)
+	}
+	plan, err := c.CreateApplicationCustomPlan(321, 12)
+	if err == nil || plan != nil {
+		t.Fatalf("expected nil plan and error; got %v, %v", plan, err)
+	}
+}
+
 func TestApplicationSuspend(t *testing.T) {
 	var (
 		appID     int64 = 12
@@ -415,18 +480,15 @@ func TestApplicationSuspend(t *testing.T) {
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-		if req.URL.Path != endpoint {
-			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
-		}
-
-		if req.Method != http.MethodPut {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPut, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		application := &ApplicationElem{
 			Application{
 				ID:            appID,
-				UserAccountID: strconv.FormatInt(accountID, 10),
+				UserAccountID: accountID,
 				State:         state,
 			},
 		}
@@ -467,18 +529,15 @@ func TestApplicationResume(t *testing.T) {
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-		if req.URL.Path != endpoint {
-			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
-		}
-
-		if req.Method != http.MethodPut {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPut, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		application := &ApplicationElem{
 			Application{
 				ID:            appID,
-				UserAccountID: strconv.FormatInt(accountID, 10),
+				UserAccountID: accountID,
 				State:         state,
 			},
 		}
@@ -521,20 +580,17 @@ func TestReadApplication(t *testing.T) {
 			Application{
 				ID:            ID,
 				PlanID:        planID,
-				UserAccountID: strconv.FormatInt(accountID, 10),
+				UserAccountID: accountID,
 				Description:   description,
 			},
 		}
 	)
 
 	httpClient := NewTestClient(func(req *http.Request) *http.Response {
-		if req.URL.Path != endpoint {
-			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
-		}
-
-		if req.Method != http.MethodGet {
-			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
-		}
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   endpoint,
+		}.Assert(t, req)
 
 		responseBodyBytes, err := json.Marshal(application)
 		if err != nil {
@@ -559,7 +615,7 @@ func TestReadApplication(t *testing.T) {
 		t.Fatal("application returned nil")
 	}
 
-	if *obj != application.Application {
+	if !reflect.DeepEqual(*obj, application.Application) {
 		t.Fatalf("Expected %v; got %v", application, *obj)
 	}
 }
@@ -594,13 +650,10 @@ func TestListAllApplications(t *testing.T) {
 
 	for _, input := range inputs {
 		httpClient := NewTestClient(func(req *http.Request) *http.Response {
-			if req.Method != http.MethodGet {
-				t.Fatalf("wrong helper called")
-			}
-
-			if req.URL.Path != listAllApplications {
-				t.Fatalf("wrong url generated")
-			}
+			fake.RequestAssertion{
+				Method: http.MethodGet,
+				Path:   listAllApplications,
+			}.Assert(t, req)
 
 			bodyReader := bytes.NewReader(helperLoadBytes(t, input.ResponseBodyFile))
 			return &http.Response{
@@ -645,3 +698,193 @@ func TestListAllApplications(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAppWithServiceSubscription(t *testing.T) {
+	var (
+		accountID int64 = 321
+		productID int64 = 18
+		planID    int64 = 71
+	)
+
+	subscribed := false
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.Path {
+		case "/admin/api/accounts/321/applications.json":
+			if !subscribed {
+				return &http.Response{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"errors":{"base":["You need to subscribe to the service first"]}}`)),
+					Header:     make(http.Header),
+				}
+			}
+			return fake.CreateAppSuccess("desc")
+		case fmt.Sprintf(serviceSubscriptionListResourceEndpoint, accountID):
+			if req.Method == http.MethodGet {
+				return jsonTestResponse(t, http.StatusOK, ServiceSubscriptionList{})
+			}
+			subscribed = true
+			return jsonTestResponse(t, http.StatusCreated, ServiceSubscription{
+				Element: ServiceSubscriptionItem{ID: 1, ServiceID: productID, PlanID: 2},
+			})
+		case fmt.Sprintf(servicePlanListResourceEndpoint, productID):
+			return jsonTestResponse(t, http.StatusOK, ServicePlanList{
+				Plans: []ServicePlan{{Element: ServicePlanItem{ID: 2, Default: true}}},
+			})
+		}
+		t.Fatalf("Unexpected request [%s %s]", req.Method, req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	_, err := c.CreateApp("321", "71", "test", "desc")
+	if !IsServiceSubscriptionRequired(err) {
+		t.Fatalf("expected service subscription required error; got %v", err)
+	}
+
+	app, err := c.CreateAppWithServiceSubscription(accountID, productID, planID, "test", "desc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !subscribed {
+		t.Fatal("service subscription not created")
+	}
+
+	equals(t, "desc", app.Description)
+}
+
+func TestCreateAppServiceSubscriptionErr(t *testing.T) {
+	inputs := []struct {
+		Name     string
+		Body     string
+		Required bool
+	}{
+		{"subscription required", `{"errors":{"base":["You need to subscribe to the service first"]}}`, true},
+		{"other subscription validation", `{"errors":{"base":["Subscription plan must be published"]}}`, false},
+		{"subscription message on another key", `{"errors":{"plan":["You need to subscribe to the service first"]}}`, false},
+	}
+
+	for _, input := range inputs {
+		t.Run(input.Name, func(subTest *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body:       ioutil.NopCloser(strings.NewReader(input.Body)),
+					Header:     make(http.Header),
+				}
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subTest), "someAccessToken", httpClient)
+			_, err := c.CreateApp("321", "71", "test", "desc")
+			if err == nil {
+				subTest.Fatal("expected error")
+			}
+
+			wrapped := fmt.Errorf("creating application: %w", err)
+			equals(subTest, input.Required, IsServiceSubscriptionRequired(wrapped))
+			equals(subTest, input.Required, errors.Is(wrapped, ErrServiceSubscriptionRequired))
+		})
+	}
+}
+
+func TestApplicationTypedFields(t *testing.T) {
+	body := []byte(`{"application": {
+		"id": 1,
+		"state": "live",
+		"created_at": "2020-03-31T10:00:00Z",
+		"updated_at": "2020-04-01T12:30:00+02:00",
+		"first_traffic_at": null
+	}}`)
+
+	elem := &ApplicationElem{}
+	if err := json.Unmarshal(body, elem); err != nil {
+		t.Fatal(err)
+	}
+	app := elem.Application
+
+	equals(t, ApplicationStateLive, app.AppState())
+
+	createdAt, err := app.CreatedTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, time.Date(2020, time.March, 31, 10, 0, 0, 0, time.UTC).Unix(), createdAt.Unix())
+
+	updatedAt, err := app.UpdatedTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, time.Date(2020, time.April, 1, 10, 30, 0, 0, time.UTC).Unix(), updatedAt.Unix())
+
+	firstTraffic, err := app.FirstTrafficTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, true, firstTraffic.IsZero())
+
+	app.FirstDailyTrafficAt = "yesterday"
+	if _, err := app.FirstDailyTrafficTime(); err == nil {
+		t.Fatal("expected parsing error")
+	}
+}
+
+func TestListApplicationsFiltered(t *testing.T) {
+	var accountID int64 = 321
+	endpoint := fmt.Sprintf(appList, accountID)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   endpoint,
+			Query:  map[string]string{"state": "suspended", "plan_id": "12"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, ApplicationList{Applications: []ApplicationElem{
+			{Application: Application{ID: 1, State: "suspended"}},
+		}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListApplicationsFiltered(accountID, Params{"state": "suspended", "plan_id": "12"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, len(list.Applications))
+	equals(t, "suspended", list.Applications[0].Application.State)
+}
+
+func TestListAllApplicationsFiltered(t *testing.T) {
+	var pages []string
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   listAllApplications,
+			Query: map[string]string{
+				"service_id":     "5",
+				"inactive_since": "2024-01-01",
+				"per_page":       strconv.Itoa(APPLICATIONS_PER_PAGE),
+			},
+		}.Assert(t, req)
+
+		query := req.URL.Query()
+		pages = append(pages, query.Get("page"))
+
+		apps := []ApplicationElem{}
+		if query.Get("page") == "1" {
+			for i := 0; i < APPLICATIONS_PER_PAGE; i++ {
+				apps = append(apps, ApplicationElem{Application: Application{ID: int64(i)}})
+			}
+		}
+		return jsonTestResponse(t, http.StatusOK, ApplicationList{Applications: apps})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListAllApplicationsFiltered(Params{"service_id": "5", "inactive_since": "2024-01-01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, APPLICATIONS_PER_PAGE, len(list.Applications))
+	equals(t, []string{"1", "2"}, pages)
+}
@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
 )
 
 func TestListApplicationPlansByProduct(t *testing.T) {
@@ -256,3 +258,22 @@ func TestUpdateApplicationPlan(t *testing.T) {
 		t.Fatalf("Name does not match. Expected [%s]; got [%s]", params["name"], obj.Element.Name)
 	}
 }
+
+func TestListAllApplicationPlans(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: appPlanAllResourceEndpoint}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, ApplicationPlanJSONList{Plans: []ApplicationPlan{
+			{Element: ApplicationPlanItem{ID: 1, SystemName: "plan01"}},
+			{Element: ApplicationPlanItem{ID: 2, SystemName: "plan02"}},
+		}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListAllApplicationPlans()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 2, len(list.Plans))
+	equals(t, "plan02", list.Plans[1].Element.SystemName)
+}
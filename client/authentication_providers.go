@@ -0,0 +1,94 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	adminPortalAuthProviderListResourceEndpoint = "/admin/api/account/authentication_providers.json"
+	adminPortalAuthProviderResourceEndpoint     = "/admin/api/account/authentication_providers/%d.json"
+)
+
+// Authentication provider kinds
+const (
+	AuthProviderKindKeycloak = "keycloak"
+	AuthProviderKindAuth0    = "auth0"
+)
+
+// ListAdminPortalAuthProviders List SSO integrations of the admin portal
+func (c *ThreeScaleClient) ListAdminPortalAuthProviders(opts ...ListOptions) (*AuthenticationProviderList, error) {
+	req, err := c.buildGetJSONReq(adminPortalAuthProviderListResourceEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	applyListOptions(req, opts...)
+
+	return doReq[AuthenticationProviderList](c, req, http.StatusOK)
+}
+
+// AdminPortalAuthProvider Reads admin portal SSO integration
+func (c *ThreeScaleClient) AdminPortalAuthProvider(id int64) (*AuthenticationProvider, error) {
+	endpoint := fmt.Sprintf(adminPortalAuthProviderResourceEndpoint, id)
+
+	return doGet[AuthenticationProvider](c, endpoint)
+}
+
+// CreateAdminPortalAuthProvider Create admin portal SSO integration
+// Kind, ClientID, ClientSecret and Site are required
+func (c *ThreeScaleClient) CreateAdminPortalAuthProvider(provider *AuthenticationProvider) (*AuthenticationProvider, error) {
+	if provider == nil {
+		return nil, errors.New("CreateAdminPortalAuthProvider needs not nil pointer")
+	}
+
+	bodyArr, err := json.Marshal(provider.Element)
+	if err != nil {
+		return nil, err
+	}
+	body := bytes.NewReader(bodyArr)
+
+	req, err := c.buildPostJSONReq(adminPortalAuthProviderListResourceEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[AuthenticationProvider](c, req, http.StatusCreated)
+}
+
+// UpdateAdminPortalAuthProvider Update existing admin portal SSO integration
+// Kind and SystemName cannot be updated
+func (c *ThreeScaleClient) UpdateAdminPortalAuthProvider(provider *AuthenticationProvider) (*AuthenticationProvider, error) {
+	if provider == nil {
+		return nil, errors.New("UpdateAdminPortalAuthProvider needs not nil pointer")
+	}
+
+	if provider.Element.ID == nil {
+		return nil, errors.New("UpdateAdminPortalAuthProvider needs not nil ID")
+	}
+
+	endpoint := fmt.Sprintf(adminPortalAuthProviderResourceEndpoint, *provider.Element.ID)
+
+	bodyArr, err := json.Marshal(provider.Element)
+	if err != nil {
+		return nil, err
+	}
+	body := bytes.NewReader(bodyArr)
+
+	req, err := c.buildUpdateJSONReq(endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[AuthenticationProvider](c, req, http.StatusOK)
+}
+
+// DeleteAdminPortalAuthProvider Delete existing admin portal SSO integration
+func (c *ThreeScaleClient) DeleteAdminPortalAuthProvider(id int64) error {
+	endpoint := fmt.Sprintf(adminPortalAuthProviderResourceEndpoint, id)
+
+	return doDelete(c, endpoint)
+}
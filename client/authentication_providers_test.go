@@ -0,0 +1,138 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func authProvider1() AuthenticationProvider {
+	var (
+		id        int64 = 1
+		kind            = AuthProviderKindKeycloak
+		clientID        = "3scale-admin"
+		site            = "https://sso.example.com/auth/realms/3scale"
+		published       = true
+	)
+
+	return AuthenticationProvider{Element: AuthenticationProviderItem{
+		ID:        &id,
+		Kind:      &kind,
+		ClientID:  &clientID,
+		Site:      &site,
+		Published: &published,
+	}}
+}
+
+func TestListAdminPortalAuthProviders(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: adminPortalAuthProviderListResourceEndpoint}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, AuthenticationProviderList{Items: []AuthenticationProvider{authProvider1()}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListAdminPortalAuthProviders()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, []AuthenticationProvider{authProvider1()}, list.Items)
+}
+
+func TestAdminPortalAuthProvider(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: fmt.Sprintf(adminPortalAuthProviderResourceEndpoint, 1)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, authProvider1())
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.AdminPortalAuthProvider(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, authProvider1(), *obj)
+}
+
+func TestCreateAdminPortalAuthProvider(t *testing.T) {
+	secret := "s3cr3t"
+	provider := authProvider1()
+	provider.Element.ID = nil
+	provider.Element.ClientSecret = &secret
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPost, Path: adminPortalAuthProviderListResourceEndpoint}.Assert(t, req)
+
+		bodyArr, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sent := AuthenticationProviderItem{}
+		if err := json.Unmarshal(bodyArr, &sent); err != nil {
+			t.Fatal(err)
+		}
+		equals(t, provider.Element, sent)
+
+		return jsonTestResponse(t, http.StatusCreated, authProvider1())
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CreateAdminPortalAuthProvider(&provider)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, int64(1), *obj.Element.ID)
+}
+
+func TestUpdateAdminPortalAuthProvider(t *testing.T) {
+	var (
+		id        int64 = 1
+		published       = false
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPut, Path: fmt.Sprintf(adminPortalAuthProviderResourceEndpoint, id)}.Assert(t, req)
+
+		bodyArr, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equals(t, `{"id":1,"published":false}`, string(bodyArr))
+
+		updated := authProvider1()
+		updated.Element.Published = &published
+		return jsonTestResponse(t, http.StatusOK, updated)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.UpdateAdminPortalAuthProvider(&AuthenticationProvider{Element: AuthenticationProviderItem{ID: &id, Published: &published}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, false, *obj.Element.Published)
+
+	if _, err := c.UpdateAdminPortalAuthProvider(&AuthenticationProvider{}); err == nil {
+		t.Fatal("expected error on nil ID")
+	}
+}
+
+func TestDeleteAdminPortalAuthProvider(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodDelete, Path: fmt.Sprintf(adminPortalAuthProviderResourceEndpoint, 1)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, map[string]interface{}{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.DeleteAdminPortalAuthProvider(1); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 )
 
@@ -25,22 +24,21 @@ const (
 )
 
 // ListBackends List existing backends
-func (c *ThreeScaleClient) ListBackendApis() (*BackendApiList, error) {
-	// Keep asking until the results length is lower than "per_page" param
-	currentPage := 1
+func (c *ThreeScaleClient) ListBackendApis(opts ...ListOptions) (*BackendApiList, error) {
 	backendList := &BackendApiList{}
 
-	allResultsPerPage := false
-	for next := true; next; next = allResultsPerPage {
-		tmpBackendList, err := c.ListBackendApisPerPage(currentPage, BACKENDS_PER_PAGE)
+	err := c.fetchListPages(mergeListOptions(opts), BACKENDS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpBackendList, err := c.listBackendApisPage(options)
 		if err != nil {
-			return nil, err
+			return 0, nil, nil, err
 		}
-
-		backendList.Backends = append(backendList.Backends, tmpBackendList.Backends...)
-
-		allResultsPerPage = len(tmpBackendList.Backends) == BACKENDS_PER_PAGE
-		currentPage += 1
+		return len(tmpBackendList.Backends), tmpBackendList.Metadata, func() {
+			backendList.Backends = append(backendList.Backends, tmpBackendList.Backends...)
+			backendList.Metadata = tmpBackendList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return backendList, nil
@@ -50,15 +48,13 @@ func (c *ThreeScaleClient) ListBackendApis() (*BackendApiList, error) {
 // paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
 // paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
 func (c *ThreeScaleClient) ListBackendApisPerPage(paginationValues ...int) (*BackendApiList, error) {
-	queryValues := url.Values{}
+	return c.listBackendApisPage(paginationOptions(paginationValues))
+}
 
-	if len(paginationValues) > 0 {
-		queryValues.Add("page", strconv.Itoa(paginationValues[0]))
-	}
+func (c *ThreeScaleClient) listBackendApisPage(options ListOptions) (*BackendApiList, error) {
+	queryValues := url.Values{}
 
-	if len(paginationValues) > 1 {
-		queryValues.Add("per_page", strconv.Itoa(paginationValues[1]))
-	}
+	options.apply(queryValues)
 
 	req, err := c.buildGetReq(backendListResourceEndpoint)
 	if err != nil {
@@ -67,7 +63,7 @@ func (c *ThreeScaleClient) ListBackendApisPerPage(paginationValues ...int) (*Bac
 
 	req.URL.RawQuery = queryValues.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -75,69 +71,27 @@ func (c *ThreeScaleClient) ListBackendApisPerPage(paginationValues ...int) (*Bac
 
 	backendList := &BackendApiList{}
 	err = handleJsonResp(resp, http.StatusOK, backendList)
+	backendList.Metadata = pageInfo(backendList.Metadata, resp.Header)
 	return backendList, err
 }
 
 // CreateBackendApi Create 3scale Backend
 func (c *ThreeScaleClient) CreateBackendApi(params Params) (*BackendApi, error) {
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(backendListResourceEndpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	backendApi := &BackendApi{}
-	err = handleJsonResp(resp, http.StatusCreated, backendApi)
-	return backendApi, err
+	return doPost[BackendApi](c, backendListResourceEndpoint, params)
 }
 
 // DeleteBackendApi Delete existing backend
 func (c *ThreeScaleClient) DeleteBackendApi(id int64) error {
 	backendEndpoint := fmt.Sprintf(backendResourceEndpoint, id)
 
-	req, err := c.buildDeleteReq(backendEndpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, backendEndpoint)
 }
 
 // BackendApi Read 3scale Backend
 func (c *ThreeScaleClient) BackendApi(id int64) (*BackendApi, error) {
 	backendEndpoint := fmt.Sprintf(backendResourceEndpoint, id)
 
-	req, err := c.buildGetReq(backendEndpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	backendAPI := &BackendApi{}
-	err = handleJsonResp(resp, http.StatusOK, backendAPI)
-	return backendAPI, err
+	return doGet[BackendApi](c, backendEndpoint)
 }
 
 // UpdateBackendApi Update 3scale Backend
@@ -155,34 +109,25 @@ func (c *ThreeScaleClient) UpdateBackendApi(id int64, params Params) (*BackendAp
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	backendAPI := &BackendApi{}
-	err = handleJsonResp(resp, http.StatusOK, backendAPI)
-	return backendAPI, err
+	return doReq[BackendApi](c, req, http.StatusOK)
 }
 
 // ListBackendapiMethods List existing backend methods
-func (c *ThreeScaleClient) ListBackendapiMethods(backendapiID, hitsID int64) (*MethodList, error) {
-	// Keep asking until the results length is lower than "per_page" param
-	currentPage := 1
+func (c *ThreeScaleClient) ListBackendapiMethods(backendapiID, hitsID int64, opts ...ListOptions) (*MethodList, error) {
 	methodList := &MethodList{}
 
-	allResultsPerPage := false
-	for next := true; next; next = allResultsPerPage {
-		tmpList, err := c.ListBackendapiMethodsPerPage(backendapiID, hitsID, currentPage, BACKEND_METRICS_PER_PAGE)
+	err := c.fetchListPages(mergeListOptions(opts), BACKEND_METRICS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listBackendapiMethodsPage(backendapiID, hitsID, options)
 		if err != nil {
-			return nil, err
+			return 0, nil, nil, err
 		}
-
-		methodList.Methods = append(methodList.Methods, tmpList.Methods...)
-
-		allResultsPerPage = len(tmpList.Methods) == BACKEND_METRICS_PER_PAGE
-		currentPage += 1
+		return len(tmpList.Methods), tmpList.Metadata, func() {
+			methodList.Methods = append(methodList.Methods, tmpList.Methods...)
+			methodList.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return methodList, nil
@@ -192,15 +137,13 @@ func (c *ThreeScaleClient) ListBackendapiMethods(backendapiID, hitsID int64) (*M
 // paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
 // paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
 func (c *ThreeScaleClient) ListBackendapiMethodsPerPage(backendapiID, hitsID int64, paginationValues ...int) (*MethodList, error) {
-	queryValues := url.Values{}
+	return c.listBackendapiMethodsPage(backendapiID, hitsID, paginationOptions(paginationValues))
+}
 
-	if len(paginationValues) > 0 {
-		queryValues.Add("page", strconv.Itoa(paginationValues[0]))
-	}
+func (c *ThreeScaleClient) listBackendapiMethodsPage(backendapiID, hitsID int64, options ListOptions) (*MethodList, error) {
+	queryValues := url.Values{}
 
-	if len(paginationValues) > 1 {
-		queryValues.Add("per_page", strconv.Itoa(paginationValues[1]))
-	}
+	options.apply(queryValues)
 
 	endpoint := fmt.Sprintf(backendMethodListResourceEndpoint, backendapiID, hitsID)
 	req, err := c.buildGetReq(endpoint)
@@ -210,7 +153,7 @@ func (c *ThreeScaleClient) ListBackendapiMethodsPerPage(backendapiID, hitsID int
 
 	req.URL.RawQuery = queryValues.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -218,6 +161,7 @@ func (c *ThreeScaleClient) ListBackendapiMethodsPerPage(backendapiID, hitsID int
 
 	list := &MethodList{}
 	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
 	return list, err
 }
 
@@ -225,108 +169,46 @@ func (c *ThreeScaleClient) ListBackendapiMethodsPerPage(backendapiID, hitsID int
 func (c *ThreeScaleClient) CreateBackendApiMethod(backendapiID, hitsID int64, params Params) (*Method, error) {
 	endpoint := fmt.Sprintf(backendMethodListResourceEndpoint, backendapiID, hitsID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &Method{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[Method](c, endpoint, params)
 }
 
 // DeleteBackendApiMethod Delete 3scale Backend method
 func (c *ThreeScaleClient) DeleteBackendApiMethod(backendapiID, hitsID, methodID int64) error {
 	endpoint := fmt.Sprintf(backendMethodResourceEndpoint, backendapiID, hitsID, methodID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // BackendApiMethod Read 3scale Backend method
 func (c *ThreeScaleClient) BackendApiMethod(backendapiID, hitsID, methodID int64) (*Method, error) {
 	endpoint := fmt.Sprintf(backendMethodResourceEndpoint, backendapiID, hitsID, methodID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &Method{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[Method](c, endpoint)
 }
 
 // UpdateBackendApiMethod Update 3scale Backend method
 func (c *ThreeScaleClient) UpdateBackendApiMethod(backendapiID, hitsID, methodID int64, params Params) (*Method, error) {
 	endpoint := fmt.Sprintf(backendMethodResourceEndpoint, backendapiID, hitsID, methodID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &Method{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[Method](c, endpoint, params)
 }
 
 // ListBackendapiMetrics List existing backend metric
-func (c *ThreeScaleClient) ListBackendapiMetrics(backendapiID int64) (*MetricJSONList, error) {
-	// Keep asking until the results length is lower than "per_page" param
-	currentPage := 1
+func (c *ThreeScaleClient) ListBackendapiMetrics(backendapiID int64, opts ...ListOptions) (*MetricJSONList, error) {
 	metricList := &MetricJSONList{}
 
-	allResultsPerPage := false
-	for next := true; next; next = allResultsPerPage {
-		tmpList, err := c.ListBackendapiMetricsPerPage(backendapiID, currentPage, BACKEND_METRICS_PER_PAGE)
+	err := c.fetchListPages(mergeListOptions(opts), BACKEND_METRICS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listBackendapiMetricsPage(backendapiID, options)
 		if err != nil {
-			return nil, err
+			return 0, nil, nil, err
 		}
-
-		metricList.Metrics = append(metricList.Metrics, tmpList.Metrics...)
-
-		allResultsPerPage = len(tmpList.Metrics) == BACKEND_METRICS_PER_PAGE
-		currentPage += 1
+		return len(tmpList.Metrics), tmpList.Metadata, func() {
+			metricList.Metrics = append(metricList.Metrics, tmpList.Metrics...)
+			metricList.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return metricList, nil
@@ -336,15 +218,13 @@ func (c *ThreeScaleClient) ListBackendapiMetrics(backendapiID int64) (*MetricJSO
 // paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
 // paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
 func (c *ThreeScaleClient) ListBackendapiMetricsPerPage(backendapiID int64, paginationValues ...int) (*MetricJSONList, error) {
-	queryValues := url.Values{}
+	return c.listBackendapiMetricsPage(backendapiID, paginationOptions(paginationValues))
+}
 
-	if len(paginationValues) > 0 {
-		queryValues.Add("page", strconv.Itoa(paginationValues[0]))
-	}
+func (c *ThreeScaleClient) listBackendapiMetricsPage(backendapiID int64, options ListOptions) (*MetricJSONList, error) {
+	queryValues := url.Values{}
 
-	if len(paginationValues) > 1 {
-		queryValues.Add("per_page", strconv.Itoa(paginationValues[1]))
-	}
+	options.apply(queryValues)
 
 	endpoint := fmt.Sprintf(backendMetricListResourceEndpoint, backendapiID)
 	req, err := c.buildGetReq(endpoint)
@@ -354,7 +234,7 @@ func (c *ThreeScaleClient) ListBackendapiMetricsPerPage(backendapiID int64, pagi
 
 	req.URL.RawQuery = queryValues.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -362,6 +242,7 @@ func (c *ThreeScaleClient) ListBackendapiMetricsPerPage(backendapiID int64, pagi
 
 	list := &MetricJSONList{}
 	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
 	return list, err
 }
 
@@ -369,107 +250,45 @@ func (c *ThreeScaleClient) ListBackendapiMetricsPerPage(backendapiID int64, pagi
 func (c *ThreeScaleClient) CreateBackendApiMetric(backendapiID int64, params Params) (*MetricJSON, error) {
 	endpoint := fmt.Sprintf(backendMetricListResourceEndpoint, backendapiID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MetricJSON{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[MetricJSON](c, endpoint, params)
 }
 
 // DeleteBackendApiMetric Delete 3scale Backend metric
 func (c *ThreeScaleClient) DeleteBackendApiMetric(backendapiID, metricID int64) error {
 	endpoint := fmt.Sprintf(backendMetricResourceEndpoint, backendapiID, metricID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // BackendApiMetric Read 3scale Backend metric
 func (c *ThreeScaleClient) BackendApiMetric(backendapiID, metricID int64) (*MetricJSON, error) {
 	endpoint := fmt.Sprintf(backendMetricResourceEndpoint, backendapiID, metricID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MetricJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[MetricJSON](c, endpoint)
 }
 
 // UpdateBackendApiMetric Update 3scale Backend metric
 func (c *ThreeScaleClient) UpdateBackendApiMetric(backendapiID, metricID int64, params Params) (*MetricJSON, error) {
 	endpoint := fmt.Sprintf(backendMetricResourceEndpoint, backendapiID, metricID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MetricJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[MetricJSON](c, endpoint, params)
 }
 
-func (c *ThreeScaleClient) ListBackendapiMappingRules(backendapiID int64) (*MappingRuleJSONList, error) {
-	// Keep asking until the results length is lower than "per_page" param
-	currentPage := 1
+func (c *ThreeScaleClient) ListBackendapiMappingRules(backendapiID int64, opts ...ListOptions) (*MappingRuleJSONList, error) {
 	mpList := &MappingRuleJSONList{}
 
-	allResultsPerPage := false
-	for next := true; next; next = allResultsPerPage {
-		tmpList, err := c.ListBackendapiMappingRulesPerPage(backendapiID, currentPage, BACKEND_MAPPINGRULES_PER_PAGE)
+	err := c.fetchListPages(mergeListOptions(opts), BACKEND_MAPPINGRULES_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listBackendapiMappingRulesPage(backendapiID, options)
 		if err != nil {
-			return nil, err
+			return 0, nil, nil, err
 		}
-
-		mpList.MappingRules = append(mpList.MappingRules, tmpList.MappingRules...)
-
-		allResultsPerPage = len(tmpList.MappingRules) == BACKEND_MAPPINGRULES_PER_PAGE
-		currentPage += 1
+		return len(tmpList.MappingRules), tmpList.Metadata, func() {
+			mpList.MappingRules = append(mpList.MappingRules, tmpList.MappingRules...)
+			mpList.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return mpList, nil
@@ -479,15 +298,13 @@ func (c *ThreeScaleClient) ListBackendapiMappingRules(backendapiID int64) (*Mapp
 // paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
 // paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
 func (c *ThreeScaleClient) ListBackendapiMappingRulesPerPage(backendapiID int64, paginationValues ...int) (*MappingRuleJSONList, error) {
-	queryValues := url.Values{}
+	return c.listBackendapiMappingRulesPage(backendapiID, paginationOptions(paginationValues))
+}
 
-	if len(paginationValues) > 0 {
-		queryValues.Add("page", strconv.Itoa(paginationValues[0]))
-	}
+func (c *ThreeScaleClient) listBackendapiMappingRulesPage(backendapiID int64, options ListOptions) (*MappingRuleJSONList, error) {
+	queryValues := url.Values{}
 
-	if len(paginationValues) > 1 {
-		queryValues.Add("per_page", strconv.Itoa(paginationValues[1]))
-	}
+	options.apply(queryValues)
 
 	endpoint := fmt.Sprintf(backendMRListResourceEndpoint, backendapiID)
 	req, err := c.buildGetReq(endpoint)
@@ -497,7 +314,7 @@ func (c *ThreeScaleClient) ListBackendapiMappingRulesPerPage(backendapiID int64,
 
 	req.URL.RawQuery = queryValues.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -505,6 +322,7 @@ func (c *ThreeScaleClient) ListBackendapiMappingRulesPerPage(backendapiID int64,
 
 	list := &MappingRuleJSONList{}
 	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
 	return list, err
 }
 
@@ -512,100 +330,41 @@ func (c *ThreeScaleClient) ListBackendapiMappingRulesPerPage(backendapiID int64,
 func (c *ThreeScaleClient) CreateBackendapiMappingRule(backendapiID int64, params Params) (*MappingRuleJSON, error) {
 	endpoint := fmt.Sprintf(backendMRListResourceEndpoint, backendapiID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MappingRuleJSON{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[MappingRuleJSON](c, endpoint, params)
 }
 
 // DeleteBackendapiMappingRule Delete 3scale Backend mapping rule
 func (c *ThreeScaleClient) DeleteBackendapiMappingRule(backendapiID, mrID int64) error {
 	endpoint := fmt.Sprintf(backendMRResourceEndpoint, backendapiID, mrID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // BackendapiMappingRule Read 3scale Backend mapping rule
 func (c *ThreeScaleClient) BackendapiMappingRule(backendapiID, mrID int64) (*MappingRuleJSON, error) {
 	endpoint := fmt.Sprintf(backendMRResourceEndpoint, backendapiID, mrID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MappingRuleJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[MappingRuleJSON](c, endpoint)
 }
 
 // UpdateBackendapiMappingRule Update 3scale Backend mapping rule
 func (c *ThreeScaleClient) UpdateBackendapiMappingRule(backendapiID, mrID int64, params Params) (*MappingRuleJSON, error) {
 	endpoint := fmt.Sprintf(backendMRResourceEndpoint, backendapiID, mrID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MappingRuleJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[MappingRuleJSON](c, endpoint, params)
 }
 
 // ListBackendapiUsages List existing backend usages for a given product
-func (c *ThreeScaleClient) ListBackendapiUsages(productID int64) (BackendAPIUsageList, error) {
+func (c *ThreeScaleClient) ListBackendapiUsages(productID int64, opts ...ListOptions) (BackendAPIUsageList, error) {
 	endpoint := fmt.Sprintf(backendUsageListResourceEndpoint, productID)
 	req, err := c.buildGetReq(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -616,91 +375,33 @@ func (c *ThreeScaleClient) ListBackendapiUsages(productID int64) (BackendAPIUsag
 	return list, err
 }
 
-// CreateBackendapiUsage Create 3scale Backend usage
+// CreateBackendapiUsage Create 3scale Backend usage. Valid params keys and their purpose are as follows:
+// "backend_api_id" - Backend API ID
+// "path"           - Path prefix where the backend is exposed in the product
 func (c *ThreeScaleClient) CreateBackendapiUsage(productID int64, params Params) (*BackendAPIUsage, error) {
 	endpoint := fmt.Sprintf(backendUsageListResourceEndpoint, productID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &BackendAPIUsage{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[BackendAPIUsage](c, endpoint, params)
 }
 
 // DeleteBackendapiUsage Delete 3scale Backend usage
 func (c *ThreeScaleClient) DeleteBackendapiUsage(productID, backendUsageID int64) error {
 	endpoint := fmt.Sprintf(backendUsageResourceEndpoint, productID, backendUsageID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // BackendapiUsage Read 3scale Backend usage
 func (c *ThreeScaleClient) BackendapiUsage(productID, backendUsageID int64) (*BackendAPIUsage, error) {
 	endpoint := fmt.Sprintf(backendUsageResourceEndpoint, productID, backendUsageID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &BackendAPIUsage{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[BackendAPIUsage](c, endpoint)
 }
 
-// UpdateBackendapiUsage Update 3scale Backend usage
+// UpdateBackendapiUsage Update 3scale Backend usage. Valid params keys and their purpose are as follows:
+// "path" - Path prefix where the backend is exposed in the product
 func (c *ThreeScaleClient) UpdateBackendapiUsage(productID, backendUsageID int64, params Params) (*BackendAPIUsage, error) {
 	endpoint := fmt.Sprintf(backendUsageResourceEndpoint, productID, backendUsageID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &BackendAPIUsage{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[BackendAPIUsage](c, endpoint, params)
 }
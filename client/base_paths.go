@@ -0,0 +1,71 @@
+package client
+
+import (
+	"strings"
+)
+
+// DefaultAPIBasePath is the prefix of every 3scale Account Management API endpoint
+const DefaultAPIBasePath = "/admin/api"
+
+// SetAPIBasePath replaces the /admin/api prefix of every endpoint with the given base path,
+// i.e. "/admin/api/v2" or "/porta/admin/api" for proxied deployments.
+// An empty base path restores the default prefix
+func (c *ThreeScaleClient) SetAPIBasePath(basePath string) {
+	c.apiBasePath = strings.TrimSuffix(basePath, "/")
+}
+
+// SetPathOverride rewrites endpoints starting with the original path prefix to the replacement prefix.
+// Prefixes match on path segments, so "/admin/api/services" matches "/admin/api/services.json"
+// and "/admin/api/services/1/metrics.json" but not "/admin/api/services_plans.json".
+// When several overrides match, the longest original prefix wins.
+// Overrides take precedence over the base path set by SetAPIBasePath.
+// An empty replacement removes the override
+func (c *ThreeScaleClient) SetPathOverride(original, replacement string) {
+	original = strings.TrimSuffix(original, "/")
+	if replacement == "" {
+		delete(c.pathOverrides, original)
+		return
+	}
+	if c.pathOverrides == nil {
+		c.pathOverrides = map[string]string{}
+	}
+	c.pathOverrides[original] = strings.TrimSuffix(replacement, "/")
+}
+
+// endpointURL returns the absolute URL for the endpoint after applying the configured path rewrites
+func (c *ThreeScaleClient) endpointURL(ep string) string {
+	return c.adminPortal.rawURL + c.rewritePath(ep)
+}
+
+func (c *ThreeScaleClient) rewritePath(ep string) string {
+	var match string
+	for original := range c.pathOverrides {
+		if len(original) > len(match) && hasPathPrefix(ep, original) {
+			match = original
+		}
+	}
+	if match != "" {
+		return c.pathOverrides[match] + ep[len(match):]
+	}
+
+	if c.apiBasePath != "" && hasPathPrefix(ep, DefaultAPIBasePath) {
+		return c.apiBasePath + ep[len(DefaultAPIBasePath):]
+	}
+
+	return ep
+}
+
+// hasPathPrefix reports whether prefix matches ep up to a segment or extension boundary
+func hasPathPrefix(ep, prefix string) bool {
+	if !strings.HasPrefix(ep, prefix) {
+		return false
+	}
+	if len(ep) == len(prefix) {
+		return true
+	}
+	switch ep[len(prefix)] {
+	case '/', '.', '?':
+		return true
+	}
+	return false
+}
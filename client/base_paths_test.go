@@ -0,0 +1,63 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRewritePath(t *testing.T) {
+	tests := []struct {
+		Name      string
+		basePath  string
+		overrides map[string]string
+		endpoint  string
+		expected  string
+	}{
+		{"defaults", "", nil, "/admin/api/services.json", "/admin/api/services.json"},
+		{"base path", "/admin/api/v2", nil, "/admin/api/services/1/metrics.json", "/admin/api/v2/services/1/metrics.json"},
+		{"base path trailing slash", "/porta/admin/api/", nil, "/admin/api/services.json", "/porta/admin/api/services.json"},
+		{"base path ignores other prefixes", "/admin/api/v2", nil, "/status/live", "/status/live"},
+		{"override", "", map[string]string{"/admin/api/services": "/custom/services"}, "/admin/api/services.json", "/custom/services.json"},
+		{"override on segment boundary", "", map[string]string{"/admin/api/services": "/custom/services"}, "/admin/api/services_plans.json", "/admin/api/services_plans.json"},
+		{"override wins over base path", "/admin/api/v2", map[string]string{"/admin/api/accounts": "/legacy/accounts"}, "/admin/api/accounts/3.json", "/legacy/accounts/3.json"},
+		{"longest override wins", "", map[string]string{
+			"/admin/api/services":         "/a",
+			"/admin/api/services/1/proxy": "/b",
+		}, "/admin/api/services/1/proxy.json", "/b.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", nil)
+			c.SetAPIBasePath(tt.basePath)
+			for original, replacement := range tt.overrides {
+				c.SetPathOverride(original, replacement)
+			}
+			equals(subT, tt.expected, c.rewritePath(tt.endpoint))
+		})
+	}
+}
+
+func TestSetPathOverrideRemove(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	c.SetPathOverride("/admin/api/services", "/custom/services")
+	c.SetPathOverride("/admin/api/services", "")
+	equals(t, "/admin/api/services.json", c.rewritePath("/admin/api/services.json"))
+}
+
+func TestSetAPIBasePathRequest(t *testing.T) {
+	const expectedPath = "/admin/api/v2/services.json"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != expectedPath {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", expectedPath, req.URL.Path)
+		}
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetAPIBasePath("/admin/api/v2")
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+}
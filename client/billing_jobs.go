@@ -0,0 +1,46 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	accountBillingJobResourceEndpoint = "/api/accounts/%d/billing_jobs.json"
+	tenantBillingJobResourceEndpoint  = "/master/api/providers/%d/billing_jobs.json"
+	billingJobDateLayout              = "2006-01-02"
+)
+
+// TriggerAccountBilling Triggers billing of developer account on the given date.
+// Billing runs asynchronously, resulting invoices can be fetched with ListAccountInvoices
+func (c *ThreeScaleClient) TriggerAccountBilling(accountID int64, date time.Time) error {
+	return c.triggerBilling(fmt.Sprintf(accountBillingJobResourceEndpoint, accountID), date)
+}
+
+// TriggerTenantBilling Triggers billing of every developer account of the tenant on the given date.
+// Requires master credentials
+func (c *ThreeScaleClient) TriggerTenantBilling(tenantID int64, date time.Time) error {
+	return c.triggerBilling(fmt.Sprintf(tenantBillingJobResourceEndpoint, tenantID), date)
+}
+
+func (c *ThreeScaleClient) triggerBilling(endpoint string, date time.Time) error {
+	values := url.Values{}
+	values.Add("date", date.Format(billingJobDateLayout))
+
+	body := strings.NewReader(values.Encode())
+	req, err := c.buildPostReq(endpoint, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return handleJsonResp(resp, http.StatusAccepted, nil)
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestTriggerAccountBilling(t *testing.T) {
+	var accountID int64 = 3
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf(accountBillingJobResourceEndpoint, accountID),
+			Form:   map[string]string{"date": "2020-03-31"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusAccepted, map[string]interface{}{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	err := c.TriggerAccountBilling(accountID, time.Date(2020, time.March, 31, 23, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTriggerTenantBilling(t *testing.T) {
+	var tenantID int64 = 2
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf(tenantBillingJobResourceEndpoint, tenantID),
+			Form:   map[string]string{"date": "2020-04-01"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusAccepted, map[string]interface{}{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someMasterToken", httpClient)
+	err := c.TriggerTenantBilling(tenantID, time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTriggerTenantBillingForbidden(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusForbidden, map[string]string{"error": "Access Denied"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	err := c.TriggerTenantBilling(2, time.Now())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	apiError, ok := err.(ApiErr)
+	if !ok {
+		t.Fatalf("expected ApiErr error type")
+	}
+
+	equals(t, http.StatusForbidden, apiError.Code())
+}
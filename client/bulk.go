@@ -0,0 +1,173 @@
+package client
+
+import (
+	"errors"
+	"sync"
+)
+
+// DefaultBulkConcurrency is the number of concurrent requests of bulk helpers, i.e. DeleteApplications and ImportAccounts
+const DefaultBulkConcurrency = 8
+
+// SetBulkConcurrency sets the number of concurrent requests of bulk helpers.
+// A workers value lower than 1 uses DefaultBulkConcurrency
+func (c *ThreeScaleClient) SetBulkConcurrency(workers int) {
+	c.bulkConcurrency = workers
+}
+
+// forEachConcurrently calls fn for every index lower than n, running at most bulkConcurrency calls at once
+func (c *ThreeScaleClient) forEachConcurrently(n int, fn func(idx int)) {
+	workers := c.bulkConcurrency
+	if workers < 1 {
+		workers = DefaultBulkConcurrency
+	}
+	if workers > n {
+		workers = n
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				fn(idx)
+			}
+		}()
+	}
+
+	for idx := 0; idx < n; idx++ {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// DeleteApplications deletes the applications of the account, sending at most SetBulkConcurrency requests at once.
+// Applications already deleted are ignored. Every deletion is attempted, failures being returned as BulkErr
+func (c *ThreeScaleClient) DeleteApplications(accountID int64, appIDs ...int64) error {
+	errs := make([]error, len(appIDs))
+	c.forEachConcurrently(len(appIDs), func(idx int) {
+		if err := c.DeleteApplication(accountID, appIDs[idx]); err != nil && !IsNotFound(err) {
+			errs[idx] = err
+		}
+	})
+
+	bulkErr := BulkErr{Total: len(appIDs)}
+	for idx, err := range errs {
+		if err != nil {
+			bulkErr.Failures = append(bulkErr.Failures, BulkFailure{ID: appIDs[idx], Err: err})
+		}
+	}
+	if len(bulkErr.Failures) > 0 {
+		return bulkErr
+	}
+	return nil
+}
+
+// AccountApprovalResult - Outcome of the approval of a pending developer account
+type AccountApprovalResult struct {
+	AccountID int64
+	// Account is the approved account, nil when the approval failed
+	Account *DeveloperAccount
+	Err     error
+}
+
+// ApprovePendingAccounts approves every developer account in pending state, sending at most SetBulkConcurrency requests at once.
+// Every listed account is attempted, the returned results being in the listing order.
+// Only the failure to list the pending accounts is returned as error
+func (c *ThreeScaleClient) ApprovePendingAccounts() ([]AccountApprovalResult, error) {
+	list, err := c.ListDeveloperAccountsFiltered(Params{"state": DeveloperAccountStatePending})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]AccountApprovalResult, len(list.Items))
+	c.forEachConcurrently(len(list.Items), func(idx int) {
+		id := list.Items[idx].Element.ID
+		if id == nil {
+			results[idx].Err = errors.New("pending account has no ID")
+			return
+		}
+		results[idx].AccountID = *id
+
+		account, err := c.ApproveDeveloperAccount(*id)
+		if err != nil {
+			results[idx].Err = err
+			return
+		}
+		results[idx].Account = account
+	})
+	return results, nil
+}
+
+// AccountImportSpec - Developer account created by ImportAccounts,
+// along with its admin user and an optional default application
+type AccountImportSpec struct {
+	// Account holds the account and admin user params of the signup
+	Account AccountCreateParams
+	// Application, when set, is created for the account once signed up
+	Application *ApplicationImportSpec
+}
+
+// ApplicationImportSpec - Default application of an imported account
+type ApplicationImportSpec struct {
+	PlanID      int64
+	Name        string
+	Description string
+}
+
+// AccountImportResult - Outcome of the import of an AccountImportSpec
+type AccountImportResult struct {
+	// Account is the created account, nil when the signup failed
+	Account *DeveloperAccount
+	// Application is the created default application, nil when not requested or failed
+	Application *Application
+	// Err is the signup or application creation failure
+	Err error
+}
+
+// ImportAccounts signs up the accounts of specs, sending at most SetBulkConcurrency requests at once.
+// Every spec is attempted, the returned results being in the order of specs.
+// An account whose default application could not be created is reported with both Account and Err set
+func (c *ThreeScaleClient) ImportAccounts(specs []AccountImportSpec) []AccountImportResult {
+	results := make([]AccountImportResult, len(specs))
+	c.forEachConcurrently(len(specs), func(idx int) {
+		results[idx] = c.importAccount(specs[idx])
+	})
+	return results
+}
+
+func (c *ThreeScaleClient) importAccount(spec AccountImportSpec) AccountImportResult {
+	params, err := spec.Account.Params()
+	if err != nil {
+		return AccountImportResult{Err: err}
+	}
+
+	account, err := c.Signup(params)
+	if err != nil {
+		return AccountImportResult{Err: err}
+	}
+	result := AccountImportResult{Account: account}
+
+	if spec.Application == nil {
+		return result
+	}
+	if account.Element.ID == nil {
+		result.Err = errors.New("signed up account has no ID")
+		return result
+	}
+
+	app, err := c.CreateApplication(
+		*account.Element.ID,
+		spec.Application.PlanID,
+		spec.Application.Name,
+		Params{"description": spec.Application.Description},
+	)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Application = app
+	return result
+}
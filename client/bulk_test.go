@@ -0,0 +1,227 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestDeleteApplications(t *testing.T) {
+	var inFlight, maxInFlight int32
+	mutex := &sync.Mutex{}
+	deleted := map[string]bool{}
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		if req.Method != http.MethodDelete {
+			t.Errorf("unexpected %s request", req.Method)
+		}
+
+		mutex.Lock()
+		deleted[req.URL.Path] = true
+		mutex.Unlock()
+
+		switch req.URL.Path {
+		case fmt.Sprintf(appDelete, 1, 13):
+			return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+		case fmt.Sprintf(appDelete, 1, 17):
+			return jsonTestResponse(t, http.StatusForbidden, map[string]string{"error": "forbidden"})
+		}
+		return jsonTestResponse(t, http.StatusOK, nil)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetBulkConcurrency(3)
+
+	appIDs := []int64{}
+	for id := int64(10); id < 30; id++ {
+		appIDs = append(appIDs, id)
+	}
+
+	err := c.DeleteApplications(1, appIDs...)
+	var bulkErr BulkErr
+	if !errors.As(err, &bulkErr) {
+		t.Fatalf("expected BulkErr; got %v", err)
+	}
+	equals(t, 20, bulkErr.Total)
+	equals(t, 1, len(bulkErr.Failures))
+	equals(t, int64(17), bulkErr.Failures[0].ID)
+	equals(t, true, IsForbidden(bulkErr.Failures[0].Err))
+
+	equals(t, 20, len(deleted))
+	if atomic.LoadInt32(&maxInFlight) > 3 {
+		t.Fatalf("concurrency limit exceeded: %d requests in flight", maxInFlight)
+	}
+}
+
+func TestDeleteApplicationsNoFailures(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, nil)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.DeleteApplications(1, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteApplications(1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestImportAccounts(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	c := newFakeServerClient(t, server, "someAccessToken")
+	c.SetBulkConcurrency(2)
+
+	product, err := c.CreateProduct("My API", Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := c.CreateApplicationPlan(product.Element.ID, Params{"name": "Basic"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	specs := []AccountImportSpec{
+		{
+			Account:     AccountCreateParams{OrgName: "ACME", Username: "john", Email: "john@example.com"},
+			Application: &ApplicationImportSpec{PlanID: plan.Element.ID, Name: "default", Description: "imported"},
+		},
+		{
+			Account: AccountCreateParams{OrgName: "Globex", Username: "jane", Email: "jane@example.com"},
+		},
+		{
+			// username already taken
+			Account: AccountCreateParams{OrgName: "Initech", Username: "john", Email: "other@example.com"},
+		},
+		{
+			Account:     AccountCreateParams{OrgName: "Umbrella", Username: "alice", Email: "alice@example.com"},
+			Application: &ApplicationImportSpec{PlanID: plan.Element.ID + 100, Name: "default"},
+		},
+	}
+
+	results := c.ImportAccounts(specs)
+	equals(t, 4, len(results))
+
+	// concurrent signups decide which "john" is created first
+	johns := 0
+	for _, idx := range []int{0, 2} {
+		if results[idx].Err == nil {
+			johns++
+		}
+	}
+	equals(t, 1, johns)
+	if results[0].Err == nil {
+		equals(t, "default", results[0].Application.AppName)
+		equals(t, plan.Element.ID, results[0].Application.PlanID)
+	}
+
+	equals(t, nil, results[1].Err)
+	equals(t, "Globex", *results[1].Account.Element.OrgName)
+	equals(t, (*Application)(nil), results[1].Application)
+
+	if results[3].Account == nil || results[3].Err == nil || results[3].Application != nil {
+		t.Fatalf("expected account created and application failure; got %+v", results[3])
+	}
+}
+
+func TestApprovePendingAccounts(t *testing.T) {
+	var inFlight, maxInFlight int32
+	mutex := &sync.Mutex{}
+	approved := map[string]bool{}
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			fake.RequestAssertion{
+				Method: http.MethodGet,
+				Path:   developerAccountListResourceEndpoint,
+				Query:  map[string]string{"state": DeveloperAccountStatePending},
+			}.Assert(t, req)
+
+			list := DeveloperAccountList{}
+			for id := int64(1); id <= 10; id++ {
+				accountID := id
+				list.Items = append(list.Items, DeveloperAccount{Element: DeveloperAccountItem{ID: &accountID}})
+			}
+			return jsonTestResponse(t, http.StatusOK, list)
+		}
+
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		if req.Method != http.MethodPut {
+			t.Errorf("unexpected %s request", req.Method)
+		}
+
+		mutex.Lock()
+		approved[req.URL.Path] = true
+		mutex.Unlock()
+
+		if req.URL.Path == fmt.Sprintf(developerAccountApproveResourceEndpoint, 7) {
+			return jsonTestResponse(t, http.StatusUnprocessableEntity, map[string]string{"error": "cannot approve"})
+		}
+		var id int64
+		fmt.Sscanf(req.URL.Path, developerAccountApproveResourceEndpoint, &id)
+		state := DeveloperAccountStateApproved
+		return jsonTestResponse(t, http.StatusOK, DeveloperAccount{Element: DeveloperAccountItem{ID: &id, State: &state}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetBulkConcurrency(3)
+
+	results, err := c.ApprovePendingAccounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 10, len(results))
+	equals(t, 10, len(approved))
+	if maxInFlight > 3 {
+		t.Fatalf("expected at most 3 concurrent requests; got %d", maxInFlight)
+	}
+
+	for idx, result := range results {
+		equals(t, int64(idx+1), result.AccountID)
+		if result.AccountID == 7 {
+			if result.Err == nil || result.Account != nil {
+				t.Fatalf("expected approval failure; got %+v", result)
+			}
+			continue
+		}
+		equals(t, nil, result.Err)
+		equals(t, result.AccountID, *result.Account.Element.ID)
+		equals(t, DeveloperAccountStateApproved, *result.Account.Element.State)
+	}
+}
+
+func TestApprovePendingAccountsListError(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusForbidden, map[string]string{"error": "forbidden"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	results, err := c.ApprovePendingAccounts()
+	if err == nil {
+		t.Fatal("expected list error")
+	}
+	equals(t, 0, len(results))
+}
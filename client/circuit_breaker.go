@@ -0,0 +1,73 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned, without contacting 3scale, while the circuit breaker is open
+var ErrCircuitOpen = errors.New("3scale circuit breaker open")
+
+// circuitBreaker fails requests fast after consecutive failures of the Admin Portal
+type circuitBreaker struct {
+	mu           sync.Mutex
+	threshold    int
+	openDuration time.Duration
+	failures     int
+	openedAt     time.Time
+	// probing is set while the single request allowed after openDuration is in flight
+	probing bool
+	now     func() time.Time
+}
+
+// SetCircuitBreaker opens the circuit after "threshold" consecutive failures, i.e. transport errors
+// and 5xx responses. While open, requests fail with ErrCircuitOpen without contacting 3scale.
+// After openDuration a single request probes the Admin Portal, closing the circuit on success.
+// A threshold lower than 1 disables the circuit breaker, which is the default
+func (c *ThreeScaleClient) SetCircuitBreaker(threshold int, openDuration time.Duration) {
+	if threshold < 1 {
+		c.breaker = nil
+		return
+	}
+	c.breaker = &circuitBreaker{threshold: threshold, openDuration: openDuration, now: time.Now}
+}
+
+// allow fails with ErrCircuitOpen when the request must not be sent
+func (b *circuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.openDuration {
+		return ErrCircuitOpen
+	}
+	b.probing = true
+	return nil
+}
+
+// record tracks the outcome of a sent request
+func (b *circuitBreaker) record(resp *http.Response, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if err == nil && resp.StatusCode < http.StatusInternalServerError {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	requests := 0
+	status := http.StatusServiceUnavailable
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		return jsonTestResponse(t, status, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetCircuitBreaker(2, time.Minute)
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.ListProductsPerPage(); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("circuit opened before the threshold")
+		}
+	}
+
+	_, err := c.ListProductsPerPage()
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen; got %v", err)
+	}
+	equals(t, 2, requests)
+
+	// a failed probe opens the circuit again
+	now = now.Add(time.Minute)
+	if _, err := c.ListProductsPerPage(); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("probe not allowed after the open duration")
+	}
+	if _, err := c.ListProductsPerPage(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen after failed probe; got %v", err)
+	}
+	equals(t, 3, requests)
+
+	// a successful probe closes the circuit
+	now = now.Add(time.Minute)
+	status = http.StatusOK
+	for i := 0; i < 2; i++ {
+		if _, err := c.ListProductsPerPage(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	equals(t, 5, requests)
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusNotFound, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetCircuitBreaker(1, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if _, err := c.Product(1); !IsNotFound(err) {
+			t.Fatalf("expected not found; got %v", err)
+		}
+	}
+}
+
+func TestCircuitBreakerTransportErrors(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", &http.Client{Transport: failingTransport{errors.New("connection refused")}})
+	c.SetCircuitBreaker(1, time.Minute)
+
+	if _, err := c.Product(1); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("circuit opened before the first failure")
+	}
+	if _, err := c.Product(1); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen; got %v", err)
+	}
+
+	c.SetCircuitBreaker(0, 0)
+	if _, err := c.Product(1); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("circuit breaker not disabled")
+	}
+}
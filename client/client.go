@@ -4,6 +4,7 @@ package client
 // which is a subset of the Account Management API.
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
@@ -14,6 +15,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 const (
@@ -56,6 +58,45 @@ func NewAdminPortalFromStr(portaURL string) (*AdminPortal, error) {
 	}, nil
 }
 
+// NewAdminPortalFromURL returns the AdminPortal and credential of a URL embedding the credential,
+// i.e. https://TOKEN@tenant-admin.example.com as in the 3scale operator secrets.
+// The credential is the URL password when set, otherwise the username
+func NewAdminPortalFromURL(portaURL string) (*AdminPortal, string, error) {
+	parsed, err := verifyUrl(portaURL)
+	if err != nil {
+		return nil, "", err
+	}
+	if parsed.Host == "" {
+		return nil, "", fmt.Errorf("admin portal URL has no host")
+	}
+
+	credential := ""
+	if parsed.User != nil {
+		credential = parsed.User.Username()
+		if password, ok := parsed.User.Password(); ok {
+			credential = password
+		}
+		parsed.User = nil
+	}
+
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/")
+	parsed.RawPath = ""
+	return &AdminPortal{
+		rawURL: parsed.String(),
+		url:    parsed,
+	}, credential, nil
+}
+
+// NewThreeScaleFromURL creates a ThreeScaleClient from a URL embedding the credential, see NewAdminPortalFromURL.
+// If http Client is nil, the default http client will be used
+func NewThreeScaleFromURL(portaURL string, httpClient *http.Client) (*ThreeScaleClient, error) {
+	adminPortal, credential, err := NewAdminPortalFromURL(portaURL)
+	if err != nil {
+		return nil, err
+	}
+	return NewThreeScale(adminPortal, credential, httpClient), nil
+}
+
 // Creates a ThreeScaleClient to communicate with Account Management API.
 // If http Client is nil, the default http client will be used
 func NewThreeScale(backEnd *AdminPortal, credential string, httpClient *http.Client) *ThreeScaleClient {
@@ -81,6 +122,11 @@ func (p Params) AddParam(key string, value string) {
 // SetCredentials allow the user to set the client credentials
 func (c *ThreeScaleClient) SetCredentials(credential string) {
 	c.credential = credential
+	if c.credentials != nil {
+		c.credentials.mu.Lock()
+		c.credentials.refreshed = ""
+		c.credentials.mu.Unlock()
+	}
 }
 
 // SetHook sets the callback which gets invoked upon response from 3scale
@@ -91,7 +137,7 @@ func (c *ThreeScaleClient) SetHook(cb AfterResponseCB) {
 
 // Request builder for GET request to the provided endpoint
 func (c *ThreeScaleClient) buildGetReq(ep string) (*http.Request, error) {
-	req, err := http.NewRequest("GET", c.adminPortal.rawURL+ep, nil)
+	req, err := http.NewRequest("GET", c.endpointURL(ep), nil)
 	req.Header.Set("Accept", "application/xml")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
 	return req, err
@@ -99,7 +145,7 @@ func (c *ThreeScaleClient) buildGetReq(ep string) (*http.Request, error) {
 
 // Request builder for GET request to the provided endpoint for json payloads
 func (c *ThreeScaleClient) buildGetJSONReq(ep string) (*http.Request, error) {
-	req, err := http.NewRequest("GET", c.adminPortal.rawURL+ep, nil)
+	req, err := http.NewRequest("GET", c.endpointURL(ep), nil)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
 	return req, err
@@ -107,7 +153,7 @@ func (c *ThreeScaleClient) buildGetJSONReq(ep string) (*http.Request, error) {
 
 // Request builder for POST request to the provided endpoint
 func (c *ThreeScaleClient) buildPostReq(ep string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest("POST", c.adminPortal.rawURL+ep, body)
+	req, err := http.NewRequest("POST", c.endpointURL(ep), body)
 	req.Header.Set("Accept", "application/xml")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
@@ -116,7 +162,7 @@ func (c *ThreeScaleClient) buildPostReq(ep string, body io.Reader) (*http.Reques
 
 // Request builder for POST request to the provided endpoint
 func (c *ThreeScaleClient) buildPostJSONReq(ep string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest("POST", c.adminPortal.rawURL+ep, body)
+	req, err := http.NewRequest("POST", c.endpointURL(ep), body)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
@@ -125,7 +171,7 @@ func (c *ThreeScaleClient) buildPostJSONReq(ep string, body io.Reader) (*http.Re
 
 // Request builder for PUT request to the provided endpoint
 func (c *ThreeScaleClient) buildUpdateReq(ep string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest("PUT", c.adminPortal.rawURL+ep, body)
+	req, err := http.NewRequest("PUT", c.endpointURL(ep), body)
 	req.Header.Set("Accept", "application/xml")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
@@ -134,7 +180,7 @@ func (c *ThreeScaleClient) buildUpdateReq(ep string, body io.Reader) (*http.Requ
 
 // Request builder for PUT request to the provided endpoint with json content type
 func (c *ThreeScaleClient) buildUpdateJSONReq(ep string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest("PUT", c.adminPortal.rawURL+ep, body)
+	req, err := http.NewRequest("PUT", c.endpointURL(ep), body)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
@@ -143,7 +189,7 @@ func (c *ThreeScaleClient) buildUpdateJSONReq(ep string, body io.Reader) (*http.
 
 // Request builder for PATCH request to the provided endpoint with json content type
 func (c *ThreeScaleClient) buildPatchJSONReq(ep string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest("PATCH", c.adminPortal.rawURL+ep, body)
+	req, err := http.NewRequest("PATCH", c.endpointURL(ep), body)
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
@@ -152,7 +198,7 @@ func (c *ThreeScaleClient) buildPatchJSONReq(ep string, body io.Reader) (*http.R
 
 // Request builder for DELETE request to the provided endpoint
 func (c *ThreeScaleClient) buildDeleteReq(ep string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest("DELETE", c.adminPortal.rawURL+ep, body)
+	req, err := http.NewRequest("DELETE", c.endpointURL(ep), body)
 	req.Header.Set("Accept", "application/xml")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
@@ -161,13 +207,85 @@ func (c *ThreeScaleClient) buildDeleteReq(ep string, body io.Reader) (*http.Requ
 
 // Request builder for PUT request to the provided endpoint
 func (c *ThreeScaleClient) buildPutReq(ep string, body io.Reader) (*http.Request, error) {
-	req, err := http.NewRequest("PUT", c.adminPortal.rawURL+ep, body)
+	req, err := http.NewRequest("PUT", c.endpointURL(ep), body)
 	req.Header.Set("Accept", "application/xml")
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
 	return req, err
 }
 
+// Request builder for multipart/form-data requests to the provided endpoint for json payloads
+// contentType must carry the multipart boundary of the body
+func (c *ThreeScaleClient) buildMultipartReq(method, ep string, body io.Reader, contentType string) (*http.Request, error) {
+	req, err := http.NewRequest(method, c.endpointURL(ep), body)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
+	return req, err
+}
+
+// doRequest sends an HTTP request to 3scale using the configured http client
+func (c *ThreeScaleClient) doRequest(req *http.Request) (*http.Response, error) {
+	if c.parent != nil {
+		return c.doDerivedRequest(req)
+	}
+
+	if resp, planned := c.planRequest(req); planned {
+		return resp, nil
+	}
+
+	if c.credentialProvider != nil && req.Header.Get("Authorization") != "" {
+		if err := c.providedCredential(req); err != nil {
+			return nil, err
+		}
+	} else if c.credentials != nil && req.Header.Get("Authorization") != "" {
+		credential, err := c.validCredential()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Basic "+basicAuth("", credential))
+	}
+
+	c.setUserAgent(req)
+	acceptGzip(req)
+	cached := c.revalidateCached(req)
+	start := time.Now()
+	resp, err := c.sendAttempt(req)
+	resp, err = c.retryConflictingRead(req, resp, err)
+	c.notifyRateLimit(resp)
+	c.notifyDeprecation(req, resp)
+	c.cacheResponse(req, resp, cached)
+	if resp != nil && resp.Request == nil {
+		// custom transports may not fill in the originating request
+		resp.Request = req
+	}
+	if c.debugHistory != nil || c.requestLogger != nil {
+		summary := newRequestSummary(req, resp, err, time.Since(start))
+		c.recordDebugHistory(summary)
+		c.logRequest(summary)
+	}
+	return resp, err
+}
+
+// sendAttempt sends a single attempt of the request through the circuit breaker,
+// decompressing, limiting and dumping its response
+func (c *ThreeScaleClient) sendAttempt(req *http.Request) (*http.Response, error) {
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	c.dumpRequest(req)
+	resp, err := c.httpClient.Do(req)
+	// transport errors embed the request URL, which may carry credentials
+	err = redactErr(err)
+	c.breaker.record(resp, err)
+	resp, err = decompressResponse(resp, err)
+	// limit the body before the dump reads it in memory
+	c.limitResponseSize(resp)
+	c.dumpResponse(resp)
+	return resp, err
+}
+
 // Verifies a custom admin portal is valid
 func verifyUrl(urlToCheck string) (*url.URL, error) {
 	url2, err := url.ParseRequestURI(urlToCheck)
@@ -188,38 +306,43 @@ func verifyUrl(urlToCheck string) (*url.URL, error) {
 // if response code is unexpected or it fails to decode into the interface provided
 // by the caller, an error of type ApiErr is returned
 func handleXMLResp(resp *http.Response, expectCode int, decodeInto interface{}) error {
+	if isDryRunResponse(resp) {
+		return nil
+	}
+
 	if resp.StatusCode != expectCode {
-		return handleXMLErrResp(resp)
+		return statusErr(resp, handleXMLErrResp(resp))
 	}
 
 	if decodeInto == nil {
 		return nil
 	}
 
-	if err := xml.NewDecoder(resp.Body).Decode(decodeInto); err != nil {
-		return createApiErr(resp.StatusCode, createDecodingErrorMessage(err))
-
-	}
-	return nil
+	return decodeResp(resp, decodeInto, xmlDecoder)
 }
 
 // handleJsonResp takes a http response and validates it against an expected status code
 // if response code is unexpected or it fails to decode into the interface provided
 // by the caller, an error of type ApiErr is returned
 func handleJsonResp(resp *http.Response, expectCode int, decodeInto interface{}) error {
+	if isDryRunResponse(resp) {
+		return nil
+	}
+
 	if resp.StatusCode != expectCode {
-		return handleJsonErrResp(resp)
+		return statusErr(resp, handleJsonErrResp(resp))
 	}
 
 	if decodeInto == nil {
 		return nil
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(decodeInto); err != nil {
-		return createApiErr(resp.StatusCode, createDecodingErrorMessage(err))
-	}
+	return decodeResp(resp, decodeInto, jsonDecoder)
+}
 
-	return nil
+// statusErr converts the ApiErr of responses with a dedicated error type, i.e. 409 and 429
+func statusErr(resp *http.Response, err error) error {
+	return rateLimitedErr(resp, conflictErr(resp, err))
 }
 
 // handleXMLErrResp decodes an XML response from 3scale system
@@ -227,11 +350,18 @@ func handleJsonResp(resp *http.Response, expectCode int, decodeInto interface{})
 func handleXMLErrResp(resp *http.Response) error {
 	var errResp ErrorResp
 
-	if err := xml.NewDecoder(resp.Body).Decode(&errResp); err != nil {
-		return createApiErr(resp.StatusCode, createDecodingErrorMessage(err))
+	body, err := bufferBody(resp)
+	if err != nil {
+		return bodyReadErr(resp, err)
+	}
+
+	if err := decodeResp(resp, &errResp, xmlDecoder); err != nil {
+		return err
 	}
 
-	return ApiErr{resp.StatusCode, errResp.Text}
+	apiErr := responseApiErr(resp, errResp.Text)
+	apiErr.body = string(body)
+	return apiErr
 }
 
 // handleJsonErrResp decodes a JSON response from 3scale system
@@ -248,9 +378,11 @@ func handleJsonErrResp(resp *http.Response) error {
 func parseUnexpectedError(resp *http.Response) error {
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil
+		return bodyReadErr(resp, err)
 	}
-	return createApiErr(resp.StatusCode, string(body))
+	apiErr := responseApiErr(resp, string(body))
+	apiErr.body = string(body)
+	return apiErr
 }
 
 func parseUnprocessableEntityError(resp *http.Response) error {
@@ -258,16 +390,81 @@ func parseUnprocessableEntityError(resp *http.Response) error {
 		Errors map[string][]string `json:"errors"`
 	}{}
 
-	if err := json.NewDecoder(resp.Body).Decode(&errObj); err != nil {
-		return createApiErr(resp.StatusCode, createDecodingErrorMessage(err))
+	body, err := bufferBody(resp)
+	if err != nil {
+		return bodyReadErr(resp, err)
+	}
+
+	if err := decodeResp(resp, &errObj, jsonDecoder); err != nil {
+		return err
 	}
 
 	msg, err := json.Marshal(errObj.Errors)
 	if err != nil {
-		return createApiErr(resp.StatusCode, createDecodingErrorMessage(err))
+		return responseApiErr(resp, createDecodingErrorMessage(err))
 	}
 
-	return createApiErr(resp.StatusCode, string(msg))
+	apiErr := responseApiErr(resp, string(msg))
+	apiErr.body = string(body)
+	return apiErr
+}
+
+// bufferBody reads the response body, leaving it readable again for decoding
+func bufferBody(resp *http.Response) ([]byte, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// bodyReadErr creates the ApiErr of a response whose body could not be read
+func bodyReadErr(resp *http.Response, err error) ApiErr {
+	apiErr := responseApiErr(resp, fmt.Sprintf("reading response body - %s", err.Error()))
+	apiErr.cause = err
+	return apiErr
+}
+
+type decoder interface {
+	Decode(v interface{}) error
+}
+
+func jsonDecoder(r io.Reader) decoder {
+	return lenientJSONDecoder{r: r}
+}
+
+func xmlDecoder(r io.Reader) decoder {
+	return xml.NewDecoder(r)
+}
+
+// decodeResp decodes the response body into the interface provided by the caller
+// decoding failures are returned as ApiErr wrapping a DecodeError with details of the failed call
+func decodeResp(resp *http.Response, decodeInto interface{}, newDecoder func(io.Reader) decoder) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err == nil {
+		err = newDecoder(bytes.NewReader(body)).Decode(decodeInto)
+	}
+
+	if err != nil {
+		decodeErr := newDecodeError(resp, decodeInto, body, err)
+		apiErr := responseApiErr(resp, createDecodingErrorMessage(decodeErr))
+		apiErr.cause = decodeErr
+		return apiErr
+	}
+
+	return nil
+}
+
+// responseApiErr creates the ApiErr of a 3scale response, carrying its request ID and the failed call
+func responseApiErr(resp *http.Response, message string) ApiErr {
+	apiErr := createApiErr(resp.StatusCode, message)
+	apiErr.requestID = resp.Header.Get(requestIDHeader)
+	if resp.Request != nil {
+		apiErr.method = resp.Request.Method
+		apiErr.endpoint = resp.Request.URL.Path
+	}
+	return apiErr
 }
 
 func createApiErr(statusCode int, message string) ApiErr {
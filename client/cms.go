@@ -0,0 +1,155 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	cmsTemplateListResourceEndpoint        = "/admin/api/cms/templates.json"
+	cmsTemplateResourceEndpoint            = "/admin/api/cms/templates/%d.json"
+	cmsTemplatePublishResourceEndpoint     = "/admin/api/cms/templates/%d/publish.json"
+	CMS_TEMPLATES_PER_PAGE             int = 100
+)
+
+// CMS template types. Builtin templates and email templates can be updated and published, but not created or deleted
+const (
+	CMSTemplateTypePage           = "page"
+	CMSTemplateTypeLayout         = "layout"
+	CMSTemplateTypePartial        = "partial"
+	CMSTemplateTypeBuiltinPage    = "builtin_page"
+	CMSTemplateTypeBuiltinPartial = "builtin_partial"
+	CMSTemplateTypeEmailTemplate  = "email_template"
+)
+
+// ListCMSTemplates List developer portal templates
+// filterParams are sent as query params, i.e. "type" (see CMSTemplateType constants) or "section_id"
+func (c *ThreeScaleClient) ListCMSTemplates(filterParams Params, opts ...ListOptions) (*CMSTemplateList, error) {
+	list := &CMSTemplateList{}
+
+	err := c.fetchListPages(mergeListOptions(opts), CMS_TEMPLATES_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listCMSTemplatesPage(filterParams, options)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		return len(tmpList.Templates), tmpList.Metadata, func() {
+			list.Templates = append(list.Templates, tmpList.Templates...)
+			list.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ListCMSTemplatesPerPage List developer portal templates in a single page
+// paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
+// paginationValues[1] = Number of results per page. Default and max is 100 for the aPI, as the client will not send the per_page param.
+func (c *ThreeScaleClient) ListCMSTemplatesPerPage(filterParams Params, paginationValues ...int) (*CMSTemplateList, error) {
+	return c.listCMSTemplatesPage(filterParams, paginationOptions(paginationValues))
+}
+
+func (c *ThreeScaleClient) listCMSTemplatesPage(filterParams Params, options ListOptions) (*CMSTemplateList, error) {
+	req, err := c.buildGetJSONReq(cmsTemplateListResourceEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for k, v := range filterParams {
+		values.Add(k, v)
+	}
+	options.apply(values)
+	req.URL.RawQuery = values.Encode()
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	list := &CMSTemplateList{}
+	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
+	return list, err
+}
+
+// CMSTemplate Reads developer portal template
+func (c *ThreeScaleClient) CMSTemplate(id int64) (*CMSTemplate, error) {
+	endpoint := fmt.Sprintf(cmsTemplateResourceEndpoint, id)
+
+	return doGet[CMSTemplate](c, endpoint)
+}
+
+// CreateCMSTemplate Create developer portal page, layout or partial
+// The content is stored as draft until the template is published
+func (c *ThreeScaleClient) CreateCMSTemplate(template *CMSTemplate) (*CMSTemplate, error) {
+	if template == nil {
+		return nil, errors.New("CreateCMSTemplate needs not nil pointer")
+	}
+
+	bodyArr, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	body := bytes.NewReader(bodyArr)
+
+	req, err := c.buildPostJSONReq(cmsTemplateListResourceEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[CMSTemplate](c, req, http.StatusCreated)
+}
+
+// UpdateCMSTemplate Update existing developer portal template
+// Type cannot be updated
+func (c *ThreeScaleClient) UpdateCMSTemplate(template *CMSTemplate) (*CMSTemplate, error) {
+	if template == nil {
+		return nil, errors.New("UpdateCMSTemplate needs not nil pointer")
+	}
+
+	if template.ID == nil {
+		return nil, errors.New("UpdateCMSTemplate needs not nil ID")
+	}
+
+	endpoint := fmt.Sprintf(cmsTemplateResourceEndpoint, *template.ID)
+
+	bodyArr, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	body := bytes.NewReader(bodyArr)
+
+	req, err := c.buildUpdateJSONReq(endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[CMSTemplate](c, req, http.StatusOK)
+}
+
+// PublishCMSTemplate Publish the draft content of developer portal template
+func (c *ThreeScaleClient) PublishCMSTemplate(id int64) (*CMSTemplate, error) {
+	endpoint := fmt.Sprintf(cmsTemplatePublishResourceEndpoint, id)
+
+	req, err := c.buildUpdateJSONReq(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[CMSTemplate](c, req, http.StatusOK)
+}
+
+// DeleteCMSTemplate Delete existing developer portal template
+func (c *ThreeScaleClient) DeleteCMSTemplate(id int64) error {
+	endpoint := fmt.Sprintf(cmsTemplateResourceEndpoint, id)
+
+	return doDelete(c, endpoint)
+}
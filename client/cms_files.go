@@ -0,0 +1,180 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	cmsFileListResourceEndpoint     = "/admin/api/cms/files.json"
+	cmsFileResourceEndpoint         = "/admin/api/cms/files/%d.json"
+	CMS_FILES_PER_PAGE          int = 100
+)
+
+// ListCMSFiles List developer portal files
+// filterParams are sent as query params, i.e. "section_id"
+func (c *ThreeScaleClient) ListCMSFiles(filterParams Params, opts ...ListOptions) (*CMSFileList, error) {
+	list := &CMSFileList{}
+
+	err := c.fetchListPages(mergeListOptions(opts), CMS_FILES_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listCMSFilesPage(filterParams, options)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		return len(tmpList.Files), tmpList.Metadata, func() {
+			list.Files = append(list.Files, tmpList.Files...)
+			list.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ListCMSFilesPerPage List developer portal files in a single page
+// paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
+// paginationValues[1] = Number of results per page. Default and max is 100 for the aPI, as the client will not send the per_page param.
+func (c *ThreeScaleClient) ListCMSFilesPerPage(filterParams Params, paginationValues ...int) (*CMSFileList, error) {
+	return c.listCMSFilesPage(filterParams, paginationOptions(paginationValues))
+}
+
+func (c *ThreeScaleClient) listCMSFilesPage(filterParams Params, options ListOptions) (*CMSFileList, error) {
+	req, err := c.buildGetJSONReq(cmsFileListResourceEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for k, v := range filterParams {
+		values.Add(k, v)
+	}
+	options.apply(values)
+	req.URL.RawQuery = values.Encode()
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	list := &CMSFileList{}
+	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
+	return list, err
+}
+
+// CMSFile Reads developer portal file
+func (c *ThreeScaleClient) CMSFile(id int64) (*CMSFile, error) {
+	endpoint := fmt.Sprintf(cmsFileResourceEndpoint, id)
+
+	return doGet[CMSFile](c, endpoint)
+}
+
+// CreateCMSFile Upload a new developer portal file.
+// filename is used to detect the content type of the attachment
+func (c *ThreeScaleClient) CreateCMSFile(file *CMSFile, filename string, content io.Reader) (*CMSFile, error) {
+	if file == nil || content == nil {
+		return nil, errors.New("CreateCMSFile needs not nil file and content")
+	}
+
+	return c.uploadCMSFile(http.MethodPost, cmsFileListResourceEndpoint, http.StatusCreated, file, filename, content)
+}
+
+// UpdateCMSFile Update existing developer portal file.
+// The attachment is only replaced when content is not nil
+func (c *ThreeScaleClient) UpdateCMSFile(file *CMSFile, filename string, content io.Reader) (*CMSFile, error) {
+	if file == nil {
+		return nil, errors.New("UpdateCMSFile needs not nil pointer")
+	}
+
+	if file.ID == nil {
+		return nil, errors.New("UpdateCMSFile needs not nil ID")
+	}
+
+	endpoint := fmt.Sprintf(cmsFileResourceEndpoint, *file.ID)
+	return c.uploadCMSFile(http.MethodPut, endpoint, http.StatusOK, file, filename, content)
+}
+
+// DeleteCMSFile Delete existing developer portal file
+func (c *ThreeScaleClient) DeleteCMSFile(id int64) error {
+	endpoint := fmt.Sprintf(cmsFileResourceEndpoint, id)
+
+	return doDelete(c, endpoint)
+}
+
+func (c *ThreeScaleClient) uploadCMSFile(method, endpoint string, expectCode int, file *CMSFile, filename string, content io.Reader) (*CMSFile, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if err := file.writeFields(writer); err != nil {
+		return nil, err
+	}
+
+	if content != nil {
+		contentType := mime.TypeByExtension(filepath.Ext(filename))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="attachment"; filename=%q`, filepath.Base(filename)))
+		header.Set("Content-Type", contentType)
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(part, content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := c.buildMultipartReq(method, endpoint, body, writer.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respObj := &CMSFile{}
+	err = handleJsonResp(resp, expectCode, respObj)
+	return respObj, err
+}
+
+// writeFields adds the not nil file attributes as form fields, always in the same order
+func (f *CMSFile) writeFields(writer *multipart.Writer) error {
+	if f.SectionID != nil {
+		if err := writer.WriteField("section_id", strconv.FormatInt(*f.SectionID, 10)); err != nil {
+			return err
+		}
+	}
+	if f.Path != nil {
+		if err := writer.WriteField("path", *f.Path); err != nil {
+			return err
+		}
+	}
+	if f.Downloadable != nil {
+		if err := writer.WriteField("downloadable", strconv.FormatBool(*f.Downloadable)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
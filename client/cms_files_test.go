@@ -0,0 +1,212 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestListCMSFiles(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		// Will serve: 2 pages
+		// page 1 => CMS_FILES_PER_PAGE
+		// page 2 => 1
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   cmsFileListResourceEndpoint,
+			Query:  map[string]string{"per_page": strconv.Itoa(CMS_FILES_PER_PAGE)},
+		}.Assert(t, req)
+
+		n := 0
+		switch req.URL.Query().Get("page") {
+		case "1":
+			n = CMS_FILES_PER_PAGE
+		case "2":
+			n = 1
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", req.URL.Query().Get("page"))
+		}
+
+		list := CMSFileList{Files: make([]CMSFile, 0, n)}
+		for idx := 0; idx < n; idx++ {
+			id := int64(idx)
+			list.Files = append(list.Files, CMSFile{ID: &id})
+		}
+		return jsonTestResponse(t, http.StatusOK, list)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListCMSFiles(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, CMS_FILES_PER_PAGE+1, len(list.Files))
+}
+
+func TestCMSFile(t *testing.T) {
+	var (
+		id   int64 = 3
+		path       = "/images/logo.png"
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: fmt.Sprintf(cmsFileResourceEndpoint, id)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, CMSFile{ID: &id, Path: &path})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CMSFile(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, path, *obj.Path)
+}
+
+// readMultipart returns form fields and the attachment part of a multipart request
+func readMultipart(t *testing.T, req *http.Request) (map[string]string, *multipart.Part, string) {
+	t.Helper()
+
+	mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "multipart/form-data", mediaType)
+
+	fields := map[string]string{}
+	reader := multipart.NewReader(req.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return fields, nil, ""
+		}
+		data, err := ioutil.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if part.FormName() == "attachment" {
+			return fields, part, string(data)
+		}
+		fields[part.FormName()] = string(data)
+	}
+}
+
+func TestCMSFileFieldsOrder(t *testing.T) {
+	var (
+		sectionID    int64 = 1
+		path               = "/images/logo.png"
+		downloadable       = true
+	)
+	file := &CMSFile{SectionID: &sectionID, Path: &path, Downloadable: &downloadable}
+
+	for i := 0; i < 10; i++ {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		if err := file.writeFields(writer); err != nil {
+			t.Fatal(err)
+		}
+		writer.Close()
+
+		names := []string{}
+		reader := multipart.NewReader(body, writer.Boundary())
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			names = append(names, part.FormName())
+		}
+		equals(t, []string{"section_id", "path", "downloadable"}, names)
+	}
+}
+
+func TestCreateCMSFile(t *testing.T) {
+	var (
+		id           int64 = 3
+		sectionID    int64 = 1
+		path               = "/images/logo.png"
+		downloadable       = false
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPost, Path: cmsFileListResourceEndpoint}.Assert(t, req)
+
+		fields, attachment, content := readMultipart(t, req)
+		equals(t, map[string]string{"section_id": "1", "path": path, "downloadable": "false"}, fields)
+		if attachment == nil {
+			t.Fatal("attachment part missing")
+		}
+		equals(t, "logo.png", attachment.FileName())
+		equals(t, "image/png", attachment.Header.Get("Content-Type"))
+		equals(t, "PNG-DATA", content)
+
+		return jsonTestResponse(t, http.StatusCreated, CMSFile{ID: &id, Path: &path})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	file := &CMSFile{SectionID: &sectionID, Path: &path, Downloadable: &downloadable}
+	obj, err := c.CreateCMSFile(file, "assets/logo.png", strings.NewReader("PNG-DATA"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, id, *obj.ID)
+
+	if _, err := c.CreateCMSFile(file, "logo.png", nil); err == nil {
+		t.Fatal("expected error on nil content")
+	}
+}
+
+func TestUpdateCMSFile(t *testing.T) {
+	var (
+		id           int64 = 3
+		downloadable       = true
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPut, Path: fmt.Sprintf(cmsFileResourceEndpoint, id)}.Assert(t, req)
+
+		fields, attachment, _ := readMultipart(t, req)
+		equals(t, map[string]string{"downloadable": "true"}, fields)
+		if attachment != nil {
+			t.Fatal("attachment should not be sent")
+		}
+
+		return jsonTestResponse(t, http.StatusOK, CMSFile{ID: &id, Downloadable: &downloadable})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.UpdateCMSFile(&CMSFile{ID: &id, Downloadable: &downloadable}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, true, *obj.Downloadable)
+
+	if _, err := c.UpdateCMSFile(&CMSFile{}, "", nil); err == nil {
+		t.Fatal("expected error on nil ID")
+	}
+}
+
+func TestDeleteCMSFile(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodDelete, Path: fmt.Sprintf(cmsFileResourceEndpoint, 3)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, map[string]interface{}{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.DeleteCMSFile(3); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestListCMSTemplates(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		// Will serve: 2 pages
+		// page 1 => CMS_TEMPLATES_PER_PAGE
+		// page 2 => 3
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   cmsTemplateListResourceEndpoint,
+			Query: map[string]string{
+				"type":     CMSTemplateTypePartial,
+				"per_page": strconv.Itoa(CMS_TEMPLATES_PER_PAGE),
+			},
+		}.Assert(t, req)
+
+		n := 0
+		switch req.URL.Query().Get("page") {
+		case "1":
+			n = CMS_TEMPLATES_PER_PAGE
+		case "2":
+			n = 3
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", req.URL.Query().Get("page"))
+		}
+
+		list := CMSTemplateList{Templates: make([]CMSTemplate, 0, n)}
+		for idx := 0; idx < n; idx++ {
+			id := int64(idx)
+			list.Templates = append(list.Templates, CMSTemplate{ID: &id})
+		}
+		return jsonTestResponse(t, http.StatusOK, list)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListCMSTemplates(Params{"type": CMSTemplateTypePartial})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, CMS_TEMPLATES_PER_PAGE+3, len(list.Templates))
+}
+
+func TestCMSTemplate(t *testing.T) {
+	var (
+		id       int64 = 5
+		tmplType       = CMSTemplateTypeLayout
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: fmt.Sprintf(cmsTemplateResourceEndpoint, id)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, CMSTemplate{ID: &id, Type: &tmplType})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CMSTemplate(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, CMSTemplateTypeLayout, *obj.Type)
+}
+
+func TestCreateCMSTemplate(t *testing.T) {
+	var (
+		id       int64 = 6
+		tmplType       = CMSTemplateTypePage
+		path           = "/about"
+		draft          = "<h1>About</h1>"
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPost, Path: cmsTemplateListResourceEndpoint}.Assert(t, req)
+
+		bodyArr, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sent := CMSTemplate{}
+		if err := json.Unmarshal(bodyArr, &sent); err != nil {
+			t.Fatal(err)
+		}
+		equals(t, CMSTemplate{Type: &tmplType, Path: &path, Draft: &draft}, sent)
+
+		return jsonTestResponse(t, http.StatusCreated, CMSTemplate{ID: &id, Type: &tmplType, Path: &path, Draft: &draft})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CreateCMSTemplate(&CMSTemplate{Type: &tmplType, Path: &path, Draft: &draft})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, id, *obj.ID)
+
+	if _, err := c.CreateCMSTemplate(nil); err == nil {
+		t.Fatal("expected error on nil template")
+	}
+}
+
+func TestUpdateCMSTemplate(t *testing.T) {
+	var (
+		id    int64 = 6
+		draft       = "<h1>About us</h1>"
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPut, Path: fmt.Sprintf(cmsTemplateResourceEndpoint, id)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, CMSTemplate{ID: &id, Draft: &draft})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.UpdateCMSTemplate(&CMSTemplate{ID: &id, Draft: &draft})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, draft, *obj.Draft)
+
+	if _, err := c.UpdateCMSTemplate(&CMSTemplate{Draft: &draft}); err == nil {
+		t.Fatal("expected error on nil ID")
+	}
+}
+
+func TestPublishCMSTemplate(t *testing.T) {
+	var (
+		id        int64 = 6
+		published       = "<h1>About us</h1>"
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPut, Path: fmt.Sprintf(cmsTemplatePublishResourceEndpoint, id)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, CMSTemplate{ID: &id, Published: &published})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.PublishCMSTemplate(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, published, *obj.Published)
+}
+
+func TestDeleteCMSTemplate(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodDelete, Path: fmt.Sprintf(cmsTemplateResourceEndpoint, 6)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, map[string]interface{}{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.DeleteCMSTemplate(6); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// acceptGzip asks for gzip compressed responses unless the request sets its own Accept-Encoding.
+// Responses are decompressed by decompressResponse, whatever the transport, so large lists
+// travel compressed even through custom transports or with compression disabled on http.Transport
+func acceptGzip(req *http.Request) {
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+}
+
+// decompressResponse transparently decodes gzip encoded response bodies
+func decompressResponse(resp *http.Response, err error) (*http.Response, error) {
+	if err != nil || resp == nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, err
+	}
+
+	reader, gzErr := gzip.NewReader(resp.Body)
+	if gzErr != nil {
+		resp.Body.Close()
+		return nil, gzErr
+	}
+
+	resp.Body = &gzipBody{Reader: reader, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+// gzipBody closes both the gzip reader and the underlying body
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func gzipBytes(t *testing.T, data string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	writer := gzip.NewWriter(buf)
+	if _, err := writer.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestGzipResponse(t *testing.T) {
+	body := gzipBytes(t, `{"services":[{"service":{"id":1,"name":"compressed"}}]}`)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		equals(t, "gzip", req.Header.Get("Accept-Encoding"))
+
+		header := make(http.Header)
+		header.Set("Content-Encoding", "gzip")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(body)),
+			Header:     header,
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListProductsPerPage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, "compressed", list.Products[0].Element.Name)
+}
+
+func TestGzipResponseDisabledTransportCompression(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("expected gzip Accept-Encoding; got %q", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(gzipBytes(t, `{"services":[{"service":{"id":2}}]}`))
+	}))
+	defer server.Close()
+
+	ap, err := NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewThreeScale(ap, "someAccessToken", &http.Client{Transport: &http.Transport{DisableCompression: true}})
+
+	list, err := c.ListProductsPerPage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(2), list.Products[0].Element.ID)
+}
+
+func TestUncompressedResponse(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(bytes.NewBufferString("plain")),
+		Header:     make(http.Header),
+	}
+
+	decompressed, err := decompressResponse(resp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(decompressed.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "plain", string(body))
+}
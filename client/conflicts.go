@@ -0,0 +1,40 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// SetConflictRetries retries up to "retries" times, waiting backoff between attempts,
+// GET requests answered with 409 Conflict. Writes are never retried.
+// Canceling the request context, e.g. by WithTimeout, interrupts the backoff.
+// A retries value lower than 1 disables the retries
+func (c *ThreeScaleClient) SetConflictRetries(retries int, backoff time.Duration) {
+	if retries < 0 {
+		retries = 0
+	}
+	c.conflictRetries = retries
+	c.conflictBackoff = backoff
+}
+
+// retryConflictingRead sends the retries of a conflicting read through the same steps as the first attempt
+func (c *ThreeScaleClient) retryConflictingRead(req *http.Request, resp *http.Response, err error) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return resp, err
+	}
+
+	for attempt := 0; attempt < c.conflictRetries && err == nil && resp.StatusCode == http.StatusConflict; attempt++ {
+		resp.Body.Close()
+
+		timer := time.NewTimer(c.conflictBackoff)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		resp, err = c.sendAttempt(req)
+	}
+	return resp, err
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func conflictResponse() *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusConflict,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(`<?xml version="1.0" encoding="UTF-8"?><error>default plan changed concurrently</error>`)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestConflictErr(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return conflictResponse()
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	_, err := c.SetDefaultPlan("1", "2")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !IsConflict(err) {
+		t.Fatalf("expected conflict error; got %v", err)
+	}
+
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected error matching ErrConflict; got %v", err)
+	}
+
+	conflict, ok := err.(ConflictErr)
+	if !ok {
+		t.Fatalf("expected ConflictErr; got %T", err)
+	}
+	equals(t, http.MethodPut, conflict.Method)
+	equals(t, "/admin/api/services/1/application_plans/2/default.xml", conflict.Endpoint)
+
+	var apiErr ApiErr
+	if !errors.As(err, &apiErr) {
+		t.Fatal("expected ConflictErr to wrap ApiErr")
+	}
+	equals(t, http.StatusConflict, apiErr.Code())
+}
+
+func TestConflictRetries(t *testing.T) {
+	attempts := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		attempts++
+		if attempts < 3 {
+			return conflictResponse()
+		}
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetConflictRetries(2, 0)
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 3, attempts)
+}
+
+func TestConflictRetriesSkipWrites(t *testing.T) {
+	attempts := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		attempts++
+		return conflictResponse()
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetConflictRetries(3, 0)
+	_, err := c.SetDefaultPlan("1", "2")
+	if !IsConflict(err) {
+		t.Fatalf("expected conflict error; got %v", err)
+	}
+	equals(t, 1, attempts)
+}
+
+func TestConflictRetriesCanceled(t *testing.T) {
+	attempts := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		attempts++
+		return conflictResponse()
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetConflictRetries(3, time.Hour)
+
+	start := time.Now()
+	_, err := c.WithTimeout(50 * time.Millisecond).ListProducts()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error; got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("backoff not interrupted, took %s", elapsed)
+	}
+	equals(t, 1, attempts)
+}
+
+func TestConflictRetriesDumped(t *testing.T) {
+	attempts := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		attempts++
+		if attempts < 2 {
+			return conflictResponse()
+		}
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetConflictRetries(1, 0)
+	dump := &bytes.Buffer{}
+	c.EnableDebugDump(dump)
+
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 2, strings.Count(dump.String(), "GET /admin/api/services.json"))
+	equals(t, 1, strings.Count(dump.String(), "409 Conflict"))
+}
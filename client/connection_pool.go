@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+// defaultDialTimeout matches the dialer of http.DefaultTransport
+const defaultDialTimeout = 30 * time.Second
+
+// ConnectionPoolOptions tunes how the client transport reuses connections to 3scale.
+// Zero fields keep the current transport values, but for DisableKeepAlives
+type ConnectionPoolOptions struct {
+	// MaxIdleConns limits the idle connections kept across all hosts
+	MaxIdleConns int
+	// MaxIdleConnsPerHost limits the idle connections kept to the admin portal, 2 by default in net/http.
+	// Raise it to the concurrency of the sync jobs to avoid opening a connection, and a TLS handshake, per request
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost limits the connections to the admin portal, including the ones in use
+	MaxConnsPerHost int
+	// IdleConnTimeout closes connections idle for longer
+	IdleConnTimeout time.Duration
+	// KeepAlive is the TCP keep-alive period of new connections, negative disables TCP keep-alives.
+	// It applies on top of the transport dialer, keeping custom dialers
+	KeepAlive time.Duration
+	// DisableKeepAlives opens a connection per request, disabling HTTP keep-alives.
+	// Always applied, false enables HTTP keep-alives again
+	DisableKeepAlives bool
+}
+
+// SetConnectionPoolOptions configures the connection pool on a copy of the client transport.
+// Fails on negative limits or when the client was built with a custom http.RoundTripper
+func (c *ThreeScaleClient) SetConnectionPoolOptions(opts ConnectionPoolOptions) error {
+	if opts.MaxIdleConns < 0 || opts.MaxIdleConnsPerHost < 0 || opts.MaxConnsPerHost < 0 {
+		return errors.New("connection limits must not be negative")
+	}
+	if opts.IdleConnTimeout < 0 {
+		return errors.New("idle connection timeout must not be negative")
+	}
+
+	return c.configureTransport(func(transport *http.Transport) error {
+		if opts.MaxIdleConns > 0 {
+			transport.MaxIdleConns = opts.MaxIdleConns
+		}
+		if opts.MaxIdleConnsPerHost > 0 {
+			transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+		}
+		if opts.MaxConnsPerHost > 0 {
+			transport.MaxConnsPerHost = opts.MaxConnsPerHost
+		}
+		if opts.IdleConnTimeout > 0 {
+			transport.IdleConnTimeout = opts.IdleConnTimeout
+		}
+		if opts.KeepAlive != 0 {
+			transport.DialContext = keepAliveDialer(transport.DialContext, opts.KeepAlive)
+		}
+		transport.DisableKeepAlives = opts.DisableKeepAlives
+		return nil
+	})
+}
+
+// keepAliveDialer sets the TCP keep-alive period of the connections opened by dial.
+// Transports without dialer get one like the http.DefaultTransport one
+func keepAliveDialer(dial func(ctx context.Context, network, addr string) (net.Conn, error), keepAlive time.Duration) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	if dial == nil {
+		return (&net.Dialer{Timeout: defaultDialTimeout, KeepAlive: keepAlive}).DialContext
+	}
+
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		tcpConn, ok := conn.(*net.TCPConn)
+		if !ok {
+			// i.e. connections tunneled by custom dialers
+			return conn, nil
+		}
+		if keepAlive < 0 {
+			err = tcpConn.SetKeepAlive(false)
+		} else if err = tcpConn.SetKeepAlive(true); err == nil {
+			err = tcpConn.SetKeepAlivePeriod(keepAlive)
+		}
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return conn, nil
+	}
+}
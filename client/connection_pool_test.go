@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSetConnectionPoolOptions(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+
+	err := c.SetConnectionPoolOptions(ConnectionPoolOptions{
+		MaxIdleConns:        200,
+		MaxIdleConnsPerHost: 50,
+		MaxConnsPerHost:     100,
+		IdleConnTimeout:     time.Minute,
+		DisableKeepAlives:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	transport := c.httpClient.Transport.(*http.Transport)
+	equals(t, 200, transport.MaxIdleConns)
+	equals(t, 50, transport.MaxIdleConnsPerHost)
+	equals(t, 100, transport.MaxConnsPerHost)
+	equals(t, time.Minute, transport.IdleConnTimeout)
+	equals(t, true, transport.DisableKeepAlives)
+
+	defaultTransport := http.DefaultTransport.(*http.Transport)
+	equals(t, 0, defaultTransport.MaxIdleConnsPerHost)
+	equals(t, false, defaultTransport.DisableKeepAlives)
+}
+
+func TestSetConnectionPoolOptionsKeepsUnset(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", &http.Client{Transport: &http.Transport{MaxIdleConns: 10, IdleConnTimeout: time.Second}})
+
+	if err := c.SetConnectionPoolOptions(ConnectionPoolOptions{MaxIdleConnsPerHost: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	transport := c.httpClient.Transport.(*http.Transport)
+	equals(t, 10, transport.MaxIdleConns)
+	equals(t, 5, transport.MaxIdleConnsPerHost)
+	equals(t, time.Second, transport.IdleConnTimeout)
+}
+
+func TestSetConnectionPoolOptionsKeepAlive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer server.Close()
+
+	ap, err := NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewThreeScale(ap, "someAccessToken", &http.Client{Transport: &http.Transport{}})
+
+	if err := c.SetConnectionPoolOptions(ConnectionPoolOptions{KeepAlive: 15 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	if c.httpClient.Transport.(*http.Transport).DialContext == nil {
+		t.Fatal("expected keep-alive dialer")
+	}
+
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetConnectionPoolOptionsKeepsCustomDialer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer server.Close()
+
+	ap, err := NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dials := 0
+	dialer := &net.Dialer{}
+	transport := &http.Transport{DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dials++
+		return dialer.DialContext(ctx, network, addr)
+	}}
+	c := NewThreeScale(ap, "someAccessToken", &http.Client{Transport: transport})
+
+	if err := c.SetConnectionPoolOptions(ConnectionPoolOptions{KeepAlive: 15 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, dials)
+}
+
+func TestSetConnectionPoolOptionsReenableKeepAlives(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", &http.Client{Transport: &http.Transport{}})
+
+	if err := c.SetConnectionPoolOptions(ConnectionPoolOptions{DisableKeepAlives: true}); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, true, c.httpClient.Transport.(*http.Transport).DisableKeepAlives)
+
+	if err := c.SetConnectionPoolOptions(ConnectionPoolOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, false, c.httpClient.Transport.(*http.Transport).DisableKeepAlives)
+}
+
+func TestSetConnectionPoolOptionsInvalid(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+
+	for _, opts := range []ConnectionPoolOptions{{MaxIdleConns: -1}, {MaxIdleConnsPerHost: -1}, {MaxConnsPerHost: -1}, {IdleConnTimeout: -time.Second}} {
+		if err := c.SetConnectionPoolOptions(opts); err == nil {
+			t.Fatalf("options %+v: expected error", opts)
+		}
+	}
+}
+
+func TestSetConnectionPoolOptionsCustomTransport(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.SetConnectionPoolOptions(ConnectionPoolOptions{MaxIdleConnsPerHost: 10}); err == nil {
+		t.Fatal("expected unsupported transport error")
+	}
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CredentialProvider provides the access token authenticating each request,
+// e.g. a token fetched from Vault or rotated periodically.
+// Token is called with the request context before every authenticated request and must be safe for concurrent use
+type CredentialProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// StaticCredential is a CredentialProvider always returning the same token
+type StaticCredential string
+
+// Token returns the static credential
+func (s StaticCredential) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// NewThreeScaleWithCredentialProvider creates a ThreeScaleClient authenticating every request
+// with the token returned by provider. If http Client is nil, the default http client will be used
+func NewThreeScaleWithCredentialProvider(backEnd *AdminPortal, provider CredentialProvider, httpClient *http.Client) *ThreeScaleClient {
+	c := NewThreeScale(backEnd, "", httpClient)
+	c.SetCredentialProvider(provider)
+	return c
+}
+
+// SetCredentialProvider authenticates every request with the token returned by provider,
+// taking precedence over the credential set with SetCredentials and its expiry and refresher.
+// A nil provider restores the static credential
+func (c *ThreeScaleClient) SetCredentialProvider(provider CredentialProvider) {
+	c.credentialProvider = provider
+}
+
+// providedCredential authenticates req with the token of the credential provider
+func (c *ThreeScaleClient) providedCredential(req *http.Request) error {
+	token, err := c.credentialProvider.Token(req.Context())
+	if err != nil {
+		return fmt.Errorf("fetching 3scale credential: %w", err)
+	}
+	req.Header.Set("Authorization", "Basic "+basicAuth("", token))
+	return nil
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// rotatingCredential returns the current token, which tests rotate between requests
+type rotatingCredential struct {
+	mu    sync.Mutex
+	token string
+	err   error
+}
+
+func (r *rotatingCredential) Token(context.Context) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.token, r.err
+}
+
+func TestCredentialProviderRotation(t *testing.T) {
+	requests := 0
+	credential := "firstToken"
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		expected := "Basic " + basicAuth("", credential)
+		if req.Header.Get("Authorization") != expected {
+			t.Fatalf("Authorization does not match. Expected [%s]; got [%s]", expected, req.Header.Get("Authorization"))
+		}
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	provider := &rotatingCredential{token: "firstToken"}
+	c := NewThreeScaleWithCredentialProvider(NewTestAdminPortal(t), provider, httpClient)
+
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+
+	provider.token = "rotatedToken"
+	credential = "rotatedToken"
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+
+	// derived clients pick up the rotated token too
+	if _, err := c.WithHeaders(http.Header{"X-Request-Id": {"someID"}}).ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 3, requests)
+}
+
+func TestCredentialProviderError(t *testing.T) {
+	requests := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	providerErr := errors.New("vault sealed")
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetCredentialProvider(&rotatingCredential{err: providerErr})
+
+	_, err := c.ListProducts()
+	if !errors.Is(err, providerErr) {
+		t.Fatalf("expected provider error; got %v", err)
+	}
+	equals(t, 0, requests)
+
+	c.SetCredentialProvider(StaticCredential("someAccessToken"))
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, requests)
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCredentialExpired is returned, without contacting 3scale, when the credential expired and could not be refreshed
+var ErrCredentialExpired = errors.New("3scale credential expired")
+
+// DefaultCredentialRefreshLeeway is how long before the credential expiry the refresh callback is invoked
+const DefaultCredentialRefreshLeeway = time.Minute
+
+// credentialTracker holds the expiry of the client credential and how to refresh it
+type credentialTracker struct {
+	mu sync.Mutex
+	// refreshed is the credential returned by the last refresh, empty until then.
+	// Requests never write the client credential, read concurrently by the request builders
+	refreshed string
+	expiresAt time.Time
+	refresh   CredentialRefreshCB
+	leeway    time.Duration
+}
+
+// SetCredentialExpiry sets when the configured credential expires.
+// Once expired, requests fail with ErrCredentialExpired unless a refresh callback is set.
+// A zero expiresAt means the credential does not expire
+func (c *ThreeScaleClient) SetCredentialExpiry(expiresAt time.Time) {
+	tracker := c.credentialsTracker()
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.expiresAt = expiresAt
+}
+
+// SetCredentialRefresher sets the callback invoked when the credential is within leeway of its expiry.
+// The credential returned by the callback replaces the current one for every following request.
+// A leeway lower than 1 uses DefaultCredentialRefreshLeeway
+func (c *ThreeScaleClient) SetCredentialRefresher(cb CredentialRefreshCB, leeway time.Duration) {
+	if leeway < 1 {
+		leeway = DefaultCredentialRefreshLeeway
+	}
+	tracker := c.credentialsTracker()
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	tracker.refresh = cb
+	tracker.leeway = leeway
+}
+
+func (c *ThreeScaleClient) credentialsTracker() *credentialTracker {
+	if c.credentials == nil {
+		c.credentials = &credentialTracker{leeway: DefaultCredentialRefreshLeeway}
+	}
+	return c.credentials
+}
+
+// validCredential returns the credential to authenticate the next request, refreshing it when close to expiry
+func (c *ThreeScaleClient) validCredential() (string, error) {
+	tracker := c.credentials
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	current := tracker.refreshed
+	if current == "" {
+		current = c.credential
+	}
+
+	if tracker.expiresAt.IsZero() {
+		return current, nil
+	}
+
+	now := time.Now()
+	if now.Add(tracker.leeway).Before(tracker.expiresAt) {
+		return current, nil
+	}
+
+	if tracker.refresh == nil {
+		if now.Before(tracker.expiresAt) {
+			return current, nil
+		}
+		return "", ErrCredentialExpired
+	}
+
+	credential, expiresAt, err := tracker.refresh()
+	if err != nil {
+		if now.Before(tracker.expiresAt) {
+			// the current credential is still usable, try refreshing again on the next request
+			return current, nil
+		}
+		return "", fmt.Errorf("%w - refresh failed: %v", ErrCredentialExpired, err)
+	}
+
+	tracker.refreshed = credential
+	tracker.expiresAt = expiresAt
+	return credential, nil
+}
+
+// JWTExpiry returns the expiry time from the "exp" claim of a JWT credential.
+// The token signature is not verified
+func JWTExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("credential is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decoding JWT payload: %v", err)
+	}
+
+	var claims struct {
+		Exp *json.Number `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("decoding JWT claims: %v", err)
+	}
+	if claims.Exp == nil {
+		return time.Time{}, errors.New("JWT has no exp claim")
+	}
+
+	exp, err := claims.Exp.Float64()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decoding JWT exp claim: %v", err)
+	}
+	return time.Unix(int64(exp), 0), nil
+}
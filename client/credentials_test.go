@@ -0,0 +1,165 @@
+package client
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func credentialTestClient(t *testing.T, requests *int, expectedCredential *string) *ThreeScaleClient {
+	t.Helper()
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		*requests++
+		expected := "Basic " + basicAuth("", *expectedCredential)
+		if req.Header.Get("Authorization") != expected {
+			t.Fatalf("Authorization does not match. Expected [%s]; got [%s]", expected, req.Header.Get("Authorization"))
+		}
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+	return NewThreeScale(NewTestAdminPortal(t), "oldToken", httpClient)
+}
+
+func TestCredentialExpired(t *testing.T) {
+	requests := 0
+	credential := "oldToken"
+	c := credentialTestClient(t, &requests, &credential)
+	c.SetCredentialExpiry(time.Now().Add(-time.Second))
+
+	_, err := c.ListProducts()
+	if !errors.Is(err, ErrCredentialExpired) {
+		t.Fatalf("expected ErrCredentialExpired; got %v", err)
+	}
+	equals(t, 0, requests)
+}
+
+func TestCredentialNotExpired(t *testing.T) {
+	requests := 0
+	credential := "oldToken"
+	c := credentialTestClient(t, &requests, &credential)
+	c.SetCredentialExpiry(time.Now().Add(time.Hour))
+
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, requests)
+}
+
+func TestCredentialRefresh(t *testing.T) {
+	requests := 0
+	credential := "newToken"
+	c := credentialTestClient(t, &requests, &credential)
+	c.SetCredentialExpiry(time.Now().Add(30 * time.Second))
+
+	refreshes := 0
+	c.SetCredentialRefresher(func() (string, time.Time, error) {
+		refreshes++
+		return "newToken", time.Now().Add(time.Hour), nil
+	}, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.ListProducts(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	equals(t, 1, refreshes)
+	equals(t, 2, requests)
+}
+
+func TestCredentialRefreshConcurrentRequests(t *testing.T) {
+	var mutex sync.Mutex
+	authorizations := map[string]int{}
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		mutex.Lock()
+		authorizations[req.Header.Get("Authorization")]++
+		mutex.Unlock()
+		return jsonTestResponse(t, http.StatusOK, Product{})
+	})
+	c := NewThreeScale(NewTestAdminPortal(t), "oldToken", httpClient)
+	c.SetCredentialExpiry(time.Now().Add(30 * time.Second))
+	c.SetCredentialRefresher(func() (string, time.Time, error) {
+		return "newToken", time.Now().Add(time.Hour), nil
+	}, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Product(1); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	equals(t, map[string]int{"Basic " + basicAuth("", "newToken"): 10}, authorizations)
+	equals(t, "oldToken", c.credential)
+}
+
+func TestCredentialRefreshFailure(t *testing.T) {
+	refreshErr := errors.New("identity provider unavailable")
+
+	t.Run("credential still valid", func(subT *testing.T) {
+		requests := 0
+		credential := "oldToken"
+		c := credentialTestClient(subT, &requests, &credential)
+		c.SetCredentialExpiry(time.Now().Add(30 * time.Second))
+		c.SetCredentialRefresher(func() (string, time.Time, error) {
+			return "", time.Time{}, refreshErr
+		}, time.Minute)
+
+		if _, err := c.ListProducts(); err != nil {
+			subT.Fatal(err)
+		}
+		equals(subT, 1, requests)
+	})
+
+	t.Run("credential expired", func(subT *testing.T) {
+		requests := 0
+		credential := "oldToken"
+		c := credentialTestClient(subT, &requests, &credential)
+		c.SetCredentialExpiry(time.Now().Add(-time.Second))
+		c.SetCredentialRefresher(func() (string, time.Time, error) {
+			return "", time.Time{}, refreshErr
+		}, time.Minute)
+
+		_, err := c.ListProducts()
+		if !errors.Is(err, ErrCredentialExpired) {
+			subT.Fatalf("expected ErrCredentialExpired; got %v", err)
+		}
+		equals(subT, 0, requests)
+	})
+}
+
+func TestJWTExpiry(t *testing.T) {
+	encode := func(payload string) string {
+		return "eyJhbGciOiJIUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".c2lnbmF0dXJl"
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+	expiresAt, err := JWTExpiry(encode(fmt.Sprintf(`{"sub":"admin","exp":%d}`, exp)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, time.Unix(exp, 0), expiresAt)
+
+	errorTests := []struct {
+		Name  string
+		token string
+	}{
+		{"opaque token", "someAccessToken"},
+		{"no exp claim", encode(`{"sub":"admin"}`)},
+		{"invalid payload", "a.%%%.b"},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			if _, err := JWTExpiry(tt.token); err == nil {
+				subT.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// redactedBodyParams are url encoded params and JSON attributes of bodies that carry secrets and must never be dumped
+var redactedBodyParams = append([]string{"password", "password_confirmation", "client_secret", "service_token", "token"}, redactedQueryParams...)
+
+// debugDump writes full request and response dumps to the configured writer
+type debugDump struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// EnableDebugDump writes to w the full dump, headers and body, of every request sent to 3scale and its response.
+// Credentials are redacted from URLs, headers and url encoded or JSON bodies, see redactedBodyParams.
+// A nil writer disables the dumps.
+// Dumps are meant to diagnose rejected payloads and read every body in memory, do not enable it on production workloads
+func (c *ThreeScaleClient) EnableDebugDump(w io.Writer) {
+	if w == nil {
+		c.debugDump = nil
+		return
+	}
+	c.debugDump = &debugDump{w: w}
+}
+
+func (c *ThreeScaleClient) dumpRequest(req *http.Request) {
+	if c.debugDump == nil {
+		return
+	}
+
+	sanitized := req.Clone(req.Context())
+	sanitized.URL, _ = url.Parse(sanitizeURL(req.URL))
+	for _, key := range redactedHeaders {
+		if sanitized.Header.Get(key) != "" {
+			sanitized.Header.Set(key, "REDACTED")
+		}
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			c.debugDump.write("request", nil, err)
+			return
+		}
+		sanitizedBody := sanitizeBody(req.Header.Get("Content-Type"), body)
+		sanitized.Body = ioutil.NopCloser(bytes.NewReader(sanitizedBody))
+		sanitized.ContentLength = int64(len(sanitizedBody))
+	}
+
+	dump, err := httputil.DumpRequestOut(sanitized, true)
+	c.debugDump.write("request", dump, err)
+}
+
+func (c *ThreeScaleClient) dumpResponse(resp *http.Response) {
+	if c.debugDump == nil || resp == nil {
+		return
+	}
+
+	sanitized := *resp
+	sanitized.Header = resp.Header.Clone()
+	for _, key := range []string{"Set-Cookie"} {
+		if sanitized.Header.Get(key) != "" {
+			sanitized.Header.Set(key, "REDACTED")
+		}
+	}
+
+	if resp.Body != nil && resp.Body != http.NoBody {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			// hand the read bytes back to the caller, followed by the read error, i.e. ErrResponseTooLarge
+			resp.Body = &replayedBody{Reader: io.MultiReader(bytes.NewReader(body), errReader{err}), Closer: resp.Body}
+			c.debugDump.write("response", nil, err)
+			return
+		}
+		resp.Body = &replayedBody{Reader: bytes.NewReader(body), Closer: resp.Body}
+		sanitizedBody := sanitizeBody(resp.Header.Get("Content-Type"), body)
+		sanitized.Body = ioutil.NopCloser(bytes.NewReader(sanitizedBody))
+		sanitized.ContentLength = int64(len(sanitizedBody))
+	}
+
+	dump, err := httputil.DumpResponse(&sanitized, true)
+	c.debugDump.write("response", dump, err)
+}
+
+// replayedBody reads the bytes already drained from a body and closes the original one
+type replayedBody struct {
+	io.Reader
+	io.Closer
+}
+
+func (d *debugDump) write(kind string, dump []byte, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err != nil {
+		fmt.Fprintf(d.w, "---- %s dump failed: %v\n", kind, err)
+		return
+	}
+	fmt.Fprintf(d.w, "---- %s\n%s\n", kind, dump)
+}
+
+// sanitizeBody redacts secret params of url encoded bodies and secret attributes, at any depth, of JSON bodies.
+// Other bodies are returned as is
+func sanitizeBody(contentType string, body []byte) []byte {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return body
+		}
+		for _, param := range redactedBodyParams {
+			if values.Get(param) != "" {
+				values.Set(param, "REDACTED")
+			}
+		}
+		return []byte(values.Encode())
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	sanitized, err := json.Marshal(redactJSON(value))
+	if err != nil {
+		return body
+	}
+	return sanitized
+}
+
+// redactJSON replaces the values of secret attributes of objects nested in value
+func redactJSON(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if isRedactedBodyParam(key) {
+				typed[key] = "REDACTED"
+				continue
+			}
+			typed[key] = redactJSON(nested)
+		}
+	case []interface{}:
+		for i, nested := range typed {
+			typed[i] = redactJSON(nested)
+		}
+	}
+	return value
+}
+
+func isRedactedBodyParam(key string) bool {
+	for _, param := range redactedBodyParams {
+		if strings.EqualFold(key, param) {
+			return true
+		}
+	}
+	return false
+}
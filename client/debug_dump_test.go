@@ -0,0 +1,126 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDebugDump(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		// the dump must not alter the request sent
+		equals(t, "secret", req.PostForm.Get("password"))
+
+		resp := jsonTestResponse(t, http.StatusCreated, DeveloperAccount{Element: DeveloperAccountItem{ID: &[]int64{3}[0]}})
+		resp.Header.Set("Set-Cookie", "session=secret")
+		return resp
+	})
+
+	out := &bytes.Buffer{}
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.EnableDebugDump(out)
+
+	account, err := c.Signup(Params{"org_name": "ACME", "username": "john", "password": "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the dump must not alter the response body either
+	equals(t, int64(3), *account.Element.ID)
+
+	dump := out.String()
+	for _, expected := range []string{
+		"---- request", "POST /admin/api/signup.json", "org_name=ACME", "password=REDACTED",
+		"Authorization: REDACTED", "---- response", "201 Created", `"id":3`,
+	} {
+		if !strings.Contains(dump, expected) {
+			t.Fatalf("dump does not contain %q:\n%s", expected, dump)
+		}
+	}
+	for _, leaked := range []string{"secret", basicAuth("", "someAccessToken")} {
+		if strings.Contains(dump, leaked) {
+			t.Fatalf("dump leaks %q:\n%s", leaked, dump)
+		}
+	}
+}
+
+func TestDebugDumpJSONBodies(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(body), `"password":"secret"`) {
+			t.Fatalf("the dump must not alter the request sent; got %s", body)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body: ioutil.NopCloser(strings.NewReader(
+				`{"user":{"id":5,"username":"john","keys":[{"user_key":"secretkey"}],"client_secret":"secretclient"}}`)),
+		}
+	})
+
+	out := &bytes.Buffer{}
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.EnableDebugDump(out)
+
+	username, password := "john", "secret"
+	user, err := c.CreateDeveloperUser(1, &DeveloperUser{Element: DeveloperUserItem{Username: &username, Password: &password}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(5), *user.Element.ID)
+
+	dump := out.String()
+	for _, expected := range []string{`"password":"REDACTED"`, `"user_key":"REDACTED"`, `"client_secret":"REDACTED"`, `"id":5`} {
+		if !strings.Contains(dump, expected) {
+			t.Fatalf("dump does not contain %q:\n%s", expected, dump)
+		}
+	}
+	for _, leaked := range []string{`"secret"`, "secretkey", "secretclient"} {
+		if strings.Contains(dump, leaked) {
+			t.Fatalf("dump leaks %q:\n%s", leaked, dump)
+		}
+	}
+}
+
+func TestDebugDumpMaxResponseSize(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, Product{Element: ProductItem{Description: strings.Repeat("x", 1024)}})
+	})
+
+	out := &bytes.Buffer{}
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetMaxResponseSize(64)
+	c.EnableDebugDump(out)
+
+	if _, err := c.Product(1); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge; got %v", err)
+	}
+	if !strings.Contains(out.String(), "response dump failed") {
+		t.Fatalf("expected failed response dump:\n%s", out.String())
+	}
+}
+
+func TestDebugDumpDisabled(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	out := &bytes.Buffer{}
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.EnableDebugDump(out)
+	c.EnableDebugDump(nil)
+
+	if _, err := c.ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 0, out.Len())
+}
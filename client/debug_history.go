@@ -0,0 +1,128 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redactedQueryParams are query parameters that carry credentials and must never be recorded
+var redactedQueryParams = []string{"access_token", "provider_key", "user_key", "app_key"}
+
+// redactedHeaders are request headers that carry credentials and must never be recorded
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "X-Access-Token"}
+
+// debugHistory is a fixed size ring buffer of request summaries
+type debugHistory struct {
+	mu      sync.Mutex
+	entries []RequestSummary
+	next    int
+	full    bool
+}
+
+// EnableDebugHistory keeps in memory the summaries of the last "size" requests sent to 3scale,
+// retrievable via DebugHistory. A size lower than 1 disables the history.
+func (c *ThreeScaleClient) EnableDebugHistory(size int) {
+	if size < 1 {
+		c.debugHistory = nil
+		return
+	}
+	c.debugHistory = &debugHistory{entries: make([]RequestSummary, size)}
+}
+
+// DebugHistory returns the summaries of the last requests sent to 3scale, oldest first.
+// Returns nil when the debug history has not been enabled
+func (c *ThreeScaleClient) DebugHistory() []RequestSummary {
+	if c.debugHistory == nil {
+		return nil
+	}
+	return c.debugHistory.list()
+}
+
+func (c *ThreeScaleClient) recordDebugHistory(summary RequestSummary) {
+	if c.debugHistory == nil {
+		return
+	}
+	c.debugHistory.add(summary)
+}
+
+// newRequestSummary builds the sanitized summary of a request and its response
+func newRequestSummary(req *http.Request, resp *http.Response, err error, timeTaken time.Duration) RequestSummary {
+	summary := RequestSummary{
+		Time:     time.Now().Add(-timeTaken),
+		Method:   req.Method,
+		URL:      sanitizeURL(req.URL),
+		Header:   sanitizeHeader(req.Header),
+		Duration: timeTaken,
+	}
+	if resp != nil {
+		summary.StatusCode = resp.StatusCode
+	}
+	if err != nil {
+		summary.Error = err.Error()
+	}
+	return summary
+}
+
+func (h *debugHistory) add(summary RequestSummary) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.entries[h.next] = summary
+	h.next = (h.next + 1) % len(h.entries)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+func (h *debugHistory) list() []RequestSummary {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.full {
+		return append([]RequestSummary{}, h.entries[:h.next]...)
+	}
+
+	result := append([]RequestSummary{}, h.entries[h.next:]...)
+	return append(result, h.entries[:h.next]...)
+}
+
+// sanitizeURL returns the URL string without user info and with credential query params redacted
+func sanitizeURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	sanitized := *u
+	sanitized.User = nil
+
+	query := sanitized.Query()
+	for _, param := range redactedQueryParams {
+		if query.Get(param) != "" {
+			query.Set(param, "REDACTED")
+		}
+	}
+	sanitized.RawQuery = query.Encode()
+
+	return sanitized.String()
+}
+
+// sanitizeHeader flattens the request headers with credential headers redacted
+func sanitizeHeader(header http.Header) map[string]string {
+	if len(header) == 0 {
+		return nil
+	}
+
+	sanitized := make(map[string]string, len(header))
+	for key, values := range header {
+		sanitized[key] = strings.Join(values, ", ")
+	}
+	for _, key := range redactedHeaders {
+		if _, ok := sanitized[key]; ok {
+			sanitized[key] = "REDACTED"
+		}
+	}
+	return sanitized
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DeprecationWarning describes the Deprecation and Sunset headers of a 3scale response,
+// announcing the upcoming removal of an Admin API endpoint
+type DeprecationWarning struct {
+	Method   string
+	Endpoint string
+	// DeprecatedAt is the date of the Deprecation header, zero when it carries no date, i.e. "true"
+	DeprecatedAt time.Time
+	// Sunset is the removal date of the Sunset header, zero when not sent
+	Sunset time.Time
+	// Link is the documentation URL of the Link header with the deprecation or sunset relation
+	Link string
+}
+
+// DeprecationCB is invoked with the deprecation warning of every response carrying Deprecation or Sunset headers
+type DeprecationCB func(warning DeprecationWarning)
+
+// SetDeprecationHook sets the callback invoked when 3scale answers with Deprecation or Sunset headers,
+// so consumers learn about Admin API removals before they break. A nil callback disables it
+func (c *ThreeScaleClient) SetDeprecationHook(cb DeprecationCB) {
+	c.deprecationHook = cb
+}
+
+func (c *ThreeScaleClient) notifyDeprecation(req *http.Request, resp *http.Response) {
+	if c.deprecationHook == nil || resp == nil {
+		return
+	}
+	if warning, ok := parseDeprecation(resp.Header); ok {
+		warning.Method = req.Method
+		warning.Endpoint = req.URL.Path
+		c.deprecationHook(warning)
+	}
+}
+
+// parseDeprecation reads the Deprecation, Sunset and Link headers.
+// Returns false when neither Deprecation nor Sunset is set
+func parseDeprecation(header http.Header) (DeprecationWarning, bool) {
+	warning := DeprecationWarning{}
+	deprecation := strings.TrimSpace(header.Get("Deprecation"))
+	sunset := strings.TrimSpace(header.Get("Sunset"))
+	if deprecation == "" && sunset == "" {
+		return warning, false
+	}
+
+	if strings.HasPrefix(deprecation, "@") {
+		// structured date, seconds since the epoch
+		if seconds, err := strconv.ParseInt(deprecation[1:], 10, 64); err == nil {
+			warning.DeprecatedAt = time.Unix(seconds, 0).UTC()
+		}
+	} else if date, err := http.ParseTime(deprecation); err == nil {
+		warning.DeprecatedAt = date
+	}
+
+	if date, err := http.ParseTime(sunset); err == nil {
+		warning.Sunset = date
+	}
+
+	warning.Link = deprecationLink(header["Link"])
+	return warning, true
+}
+
+// deprecationLink returns the target of the first link with the deprecation or sunset relation
+func deprecationLink(links []string) string {
+	for _, value := range links {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				param = strings.TrimSpace(param)
+				if !strings.HasPrefix(strings.ToLower(param), "rel=") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(param[len("rel="):], `"`)) {
+					if strings.EqualFold(rel, "deprecation") || strings.EqualFold(rel, "sunset") {
+						return target[1 : len(target)-1]
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDeprecationHook(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		resp := jsonTestResponse(t, http.StatusOK, ProductList{})
+		resp.Header.Set("Deprecation", "@1688169599")
+		resp.Header.Set("Sunset", "Wed, 31 Dec 2025 23:59:59 GMT")
+		resp.Header.Add("Link", `<https://example.com/next>; rel="next", <https://example.com/migrate>; rel="deprecation"`)
+		return resp
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	warnings := []DeprecationWarning{}
+	c.SetDeprecationHook(func(warning DeprecationWarning) {
+		warnings = append(warnings, warning)
+	})
+
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, []DeprecationWarning{{
+		Method:       http.MethodGet,
+		Endpoint:     productListResourceEndpoint,
+		DeprecatedAt: time.Unix(1688169599, 0).UTC(),
+		Sunset:       time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC),
+		Link:         "https://example.com/migrate",
+	}}, warnings)
+}
+
+func TestParseDeprecation(t *testing.T) {
+	inputs := []struct {
+		Name     string
+		Header   http.Header
+		Expected DeprecationWarning
+		Found    bool
+	}{
+		{"none", http.Header{}, DeprecationWarning{}, false},
+		{"boolean", http.Header{"Deprecation": {"true"}}, DeprecationWarning{}, true},
+		{
+			"http date",
+			http.Header{"Deprecation": {"Sun, 01 Jan 2023 00:00:00 GMT"}},
+			DeprecationWarning{DeprecatedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+			true,
+		},
+		{
+			"sunset only",
+			http.Header{"Sunset": {"Sun, 01 Jan 2023 00:00:00 GMT"}, "Link": {`<https://example.com/sunset>;rel="sunset"`}},
+			DeprecationWarning{Sunset: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Link: "https://example.com/sunset"},
+			true,
+		},
+	}
+
+	for _, input := range inputs {
+		t.Run(input.Name, func(subTest *testing.T) {
+			warning, found := parseDeprecation(input.Header)
+			equals(subTest, input.Found, found)
+			equals(subTest, input.Expected, warning)
+		})
+	}
+}
@@ -7,33 +7,46 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
-	"strings"
 )
 
 const (
-	developerAccountListResourceEndpoint     = "/admin/api/accounts.json"
-	developerAccountResourceEndpoint         = "/admin/api/accounts/%d.json"
-	signupResourceEndpoint                   = "/admin/api/signup.json"
-	DEVELOPERACCOUNTS_PER_PAGE           int = 500
+	developerAccountListResourceEndpoint        = "/admin/api/accounts.json"
+	developerAccountResourceEndpoint            = "/admin/api/accounts/%d.json"
+	developerAccountApproveResourceEndpoint     = "/admin/api/accounts/%d/approve.json"
+	signupResourceEndpoint                      = "/admin/api/signup.json"
+	DEVELOPERACCOUNTS_PER_PAGE              int = 500
 )
 
-func (c *ThreeScaleClient) ListDeveloperAccounts() (*DeveloperAccountList, error) {
-	// Keep asking until the results length is lower than "per_page" param
-	currentPage := 1
+// Developer account states
+const (
+	DeveloperAccountStatePending  = "pending"
+	DeveloperAccountStateApproved = "approved"
+	DeveloperAccountStateRejected = "rejected"
+)
+
+// ListDeveloperAccounts List every developer account, fetching all pages.
+// Pages are fetched concurrently when enabled with SetPageConcurrency
+func (c *ThreeScaleClient) ListDeveloperAccounts(opts ...ListOptions) (*DeveloperAccountList, error) {
+	return c.ListDeveloperAccountsFiltered(nil, opts...)
+}
+
+// ListDeveloperAccountsFiltered List every developer account matching the filters, fetching all pages.
+// filterParams are sent as query params, i.e. "state" (see DeveloperAccountState constants)
+func (c *ThreeScaleClient) ListDeveloperAccountsFiltered(filterParams Params, opts ...ListOptions) (*DeveloperAccountList, error) {
 	list := &DeveloperAccountList{}
 
-	allResultsPerPage := false
-	for next := true; next; next = allResultsPerPage {
-		tmpList, err := c.ListDeveloperAccountsPerPage(currentPage, DEVELOPERACCOUNTS_PER_PAGE)
+	err := c.fetchListPages(mergeListOptions(opts), DEVELOPERACCOUNTS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listDeveloperAccountsPage(filterParams, options)
 		if err != nil {
-			return nil, err
+			return 0, nil, nil, err
 		}
-
-		list.Items = append(list.Items, tmpList.Items...)
-
-		allResultsPerPage = len(tmpList.Items) == DEVELOPERACCOUNTS_PER_PAGE
-		currentPage += 1
+		return len(tmpList.Items), tmpList.Metadata, func() {
+			list.Items = append(list.Items, tmpList.Items...)
+			list.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return list, nil
@@ -43,16 +56,23 @@ func (c *ThreeScaleClient) ListDeveloperAccounts() (*DeveloperAccountList, error
 // paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
 // paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
 func (c *ThreeScaleClient) ListDeveloperAccountsPerPage(paginationValues ...int) (*DeveloperAccountList, error) {
-	queryValues := url.Values{}
+	return c.ListDeveloperAccountsFilteredPerPage(nil, paginationValues...)
+}
 
-	if len(paginationValues) > 0 {
-		queryValues.Add("page", strconv.Itoa(paginationValues[0]))
-	}
+// ListDeveloperAccountsFilteredPerPage List developer accounts matching the filters for a given page.
+// See ListDeveloperAccountsFiltered for filterParams and ListDeveloperAccountsPerPage for paginationValues
+func (c *ThreeScaleClient) ListDeveloperAccountsFilteredPerPage(filterParams Params, paginationValues ...int) (*DeveloperAccountList, error) {
+	return c.listDeveloperAccountsPage(filterParams, paginationOptions(paginationValues))
+}
 
-	if len(paginationValues) > 1 {
-		queryValues.Add("per_page", strconv.Itoa(paginationValues[1]))
+func (c *ThreeScaleClient) listDeveloperAccountsPage(filterParams Params, options ListOptions) (*DeveloperAccountList, error) {
+	queryValues := url.Values{}
+	for k, v := range filterParams {
+		queryValues.Add(k, v)
 	}
 
+	options.apply(queryValues)
+
 	req, err := c.buildGetReq(developerAccountListResourceEndpoint)
 	if err != nil {
 		return nil, err
@@ -60,7 +80,7 @@ func (c *ThreeScaleClient) ListDeveloperAccountsPerPage(paginationValues ...int)
 
 	req.URL.RawQuery = queryValues.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -68,52 +88,20 @@ func (c *ThreeScaleClient) ListDeveloperAccountsPerPage(paginationValues ...int)
 
 	accountList := &DeveloperAccountList{}
 	err = handleJsonResp(resp, http.StatusOK, accountList)
+	accountList.Metadata = pageInfo(accountList.Metadata, resp.Header)
 	return accountList, err
 }
 
 // Account fetches 3scale developer account
 func (c *ThreeScaleClient) DeveloperAccount(accountID int64) (*DeveloperAccount, error) {
 	endpoint := fmt.Sprintf(developerAccountResourceEndpoint, accountID)
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	obj := &DeveloperAccount{}
-	err = handleJsonResp(resp, http.StatusOK, obj)
-	return obj, err
+	return doGet[DeveloperAccount](c, endpoint)
 }
 
 // Signup will create an Account, an Admin User for the account, and optionally an Application with its keys.
 // If the plan_id is not passed, the default plan will be used instead.
 func (c *ThreeScaleClient) Signup(params Params) (*DeveloperAccount, error) {
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-
-	req, err := c.buildPostReq(signupResourceEndpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperAccount{}
-	err = handleJsonResp(resp, http.StatusCreated, respObj)
-	return respObj, err
+	return doPost[DeveloperAccount](c, signupResourceEndpoint, params)
 }
 
 // UpdateDeveloperAccount Update existing developer account
@@ -139,31 +127,24 @@ func (c *ThreeScaleClient) UpdateDeveloperAccount(account *DeveloperAccount) (*D
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	return doReq[DeveloperAccount](c, req, http.StatusOK)
+}
+
+// ApproveDeveloperAccount approves the developer account from pending state
+func (c *ThreeScaleClient) ApproveDeveloperAccount(id int64) (*DeveloperAccount, error) {
+	endpoint := fmt.Sprintf(developerAccountApproveResourceEndpoint, id)
+
+	req, err := c.buildUpdateJSONReq(endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	respObj := &DeveloperAccount{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[DeveloperAccount](c, req, http.StatusOK)
 }
 
 // DeleteDeveloperAccount Delete existing developerAccount
 func (c *ThreeScaleClient) DeleteDeveloperAccount(id int64) error {
 	endpoint := fmt.Sprintf(developerAccountResourceEndpoint, id)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
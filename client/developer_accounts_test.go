@@ -10,6 +10,8 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
 )
 
 func developerAccount1() DeveloperAccount {
@@ -378,3 +380,48 @@ func TestDeleteDeveloperAccount(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestListDeveloperAccountsFiltered(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   developerAccountListResourceEndpoint,
+			Query: map[string]string{
+				"state":    DeveloperAccountStatePending,
+				"page":     "1",
+				"per_page": strconv.Itoa(DEVELOPERACCOUNTS_PER_PAGE),
+			},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, DeveloperAccountList{Items: []DeveloperAccount{developerAccount1()}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListDeveloperAccountsFiltered(Params{"state": DeveloperAccountStatePending})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, len(list.Items))
+}
+
+func TestApproveDeveloperAccount(t *testing.T) {
+	var (
+		accountID int64 = 1
+		state           = DeveloperAccountStateApproved
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPut, Path: fmt.Sprintf(developerAccountApproveResourceEndpoint, accountID)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, DeveloperAccount{Element: DeveloperAccountItem{ID: &accountID, State: &state}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.ApproveDeveloperAccount(accountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, DeveloperAccountStateApproved, *obj.Element.State)
+}
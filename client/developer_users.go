@@ -19,9 +19,24 @@ const (
 	developerUserUnsuspendResourceEndpoint = "/admin/api/accounts/%d/users/%d/unsuspend.json"
 )
 
-func (c *ThreeScaleClient) ListDeveloperUsers(accountID int64, filterParams Params) (*DeveloperUserList, error) {
+// Developer user states
+const (
+	DeveloperUserStatePending   = "pending"
+	DeveloperUserStateActive    = "active"
+	DeveloperUserStateSuspended = "suspended"
+)
+
+// Developer user roles
+const (
+	DeveloperUserRoleMember = "member"
+	DeveloperUserRoleAdmin  = "admin"
+)
+
+// ListDeveloperUsers List users of a given developer account
+// filterParams are sent as query params, i.e. "state" or "role"
+func (c *ThreeScaleClient) ListDeveloperUsers(accountID int64, filterParams Params, opts ...ListOptions) (*DeveloperUserList, error) {
 	endpoint := fmt.Sprintf(developerUserListResourceEndpoint, accountID)
-	req, err := c.buildGetReq(endpoint)
+	req, err := c.buildGetJSONReq(endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -32,33 +47,15 @@ func (c *ThreeScaleClient) ListDeveloperUsers(accountID int64, filterParams Para
 	}
 	req.URL.RawQuery = values.Encode()
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	applyListOptions(req, opts...)
 
-	userList := &DeveloperUserList{}
-	err = handleJsonResp(resp, http.StatusOK, userList)
-	return userList, err
+	return doReq[DeveloperUserList](c, req, http.StatusOK)
 }
 
+// DeveloperUser Read user of a given developer account
 func (c *ThreeScaleClient) DeveloperUser(accountID, userID int64) (*DeveloperUser, error) {
 	endpoint := fmt.Sprintf(developerUserResourceEndpoint, accountID, userID)
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	obj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusOK, obj)
-	return obj, err
+	return doGet[DeveloperUser](c, endpoint)
 }
 
 // UpdateDeveloperUser Update existing developer user
@@ -84,33 +81,14 @@ func (c *ThreeScaleClient) UpdateDeveloperUser(accountID int64, user *DeveloperU
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[DeveloperUser](c, req, http.StatusOK)
 }
 
 // DeleteDeveloperUser Delete existing developerUser
 func (c *ThreeScaleClient) DeleteDeveloperUser(accountID, userID int64) error {
 	endpoint := fmt.Sprintf(developerUserResourceEndpoint, accountID, userID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // ActivateDeveloperUser activates user of a given account from pending state to active
@@ -122,15 +100,7 @@ func (c *ThreeScaleClient) ActivateDeveloperUser(accountID, userID int64) (*Deve
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[DeveloperUser](c, req, http.StatusOK)
 }
 
 // CreateDeveloperUser creates a new developer user
@@ -155,15 +125,7 @@ func (c *ThreeScaleClient) CreateDeveloperUser(accountID int64, user *DeveloperU
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusCreated, respObj)
-	return respObj, err
+	return doReq[DeveloperUser](c, req, http.StatusCreated)
 }
 
 // ChangeRoleToMemberDeveloperUser sets user of a given account to member role
@@ -175,18 +137,10 @@ func (c *ThreeScaleClient) ChangeRoleToMemberDeveloperUser(accountID, userID int
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[DeveloperUser](c, req, http.StatusOK)
 }
 
-// ChangeRoleToAdminDeveloperUser sets user of a given account to member role
+// ChangeRoleToAdminDeveloperUser sets user of a given account to admin role
 func (c *ThreeScaleClient) ChangeRoleToAdminDeveloperUser(accountID, userID int64) (*DeveloperUser, error) {
 	endpoint := fmt.Sprintf(developerUserAdminResourceEndpoint, accountID, userID)
 
@@ -195,15 +149,7 @@ func (c *ThreeScaleClient) ChangeRoleToAdminDeveloperUser(accountID, userID int6
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[DeveloperUser](c, req, http.StatusOK)
 }
 
 // SuspendDeveloperUser suspends the user of a given account
@@ -215,15 +161,7 @@ func (c *ThreeScaleClient) SuspendDeveloperUser(accountID, userID int64) (*Devel
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[DeveloperUser](c, req, http.StatusOK)
 }
 
 // UnsuspendDeveloperUser unsuspends the user of a given account
@@ -235,13 +173,5 @@ func (c *ThreeScaleClient) UnsuspendDeveloperUser(accountID, userID int64) (*Dev
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &DeveloperUser{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[DeveloperUser](c, req, http.StatusOK)
 }
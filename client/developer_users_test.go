@@ -56,6 +56,10 @@ func TestListDeveloperUsers(t *testing.T) {
 			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
 		}
 
+		if req.Header.Get("Accept") != "application/json" {
+			t.Fatalf("Accept header does not match. Expected [application/json]; got [%s]", req.Header.Get("Accept"))
+		}
+
 		responseBodyBytes, err := json.Marshal(list)
 		if err != nil {
 			t.Fatal(err)
@@ -103,6 +107,10 @@ func TestDeveloperUser(t *testing.T) {
 			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
 		}
 
+		if req.Header.Get("Accept") != "application/json" {
+			t.Fatalf("Accept header does not match. Expected [application/json]; got [%s]", req.Header.Get("Accept"))
+		}
+
 		responseBodyBytes, err := json.Marshal(item)
 		if err != nil {
 			t.Fatal(err)
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// serverManagedAttributes are attributes 3scale sets on its own and ignores on updates
+var serverManagedAttributes = []string{"id", "created_at", "updated_at", "links", "lock_version", "error"}
+
+// AttributeChange is an attribute holding a different value in two versions of a resource.
+// Attribute is the API attribute name, From and To the values, nil for unset pointer fields
+type AttributeChange struct {
+	Attribute string
+	From      interface{}
+	To        interface{}
+}
+
+// DiffApplication returns the changed attributes between two versions of an application,
+// ignoring server managed ones like IDs, timestamps, traffic dates and the provider verification key.
+// Changed custom fields are reported after the known attributes
+func DiffApplication(from, to Application) []AttributeChange {
+	changes := diffAttributes(from, to, "first_traffic_at", "first_daily_traffic_at", "provider_verification_key")
+	return append(changes, diffExtra(from.Extra, to.Extra)...)
+}
+
+// DiffProduct returns the changed attributes between two versions of a product, ignoring IDs and timestamps
+func DiffProduct(from, to ProductItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffBackendApi returns the changed attributes between two versions of a backend, ignoring IDs and timestamps
+func DiffBackendApi(from, to BackendApiItem) []AttributeChange {
+	return diffAttributes(from, to, "account_id")
+}
+
+// DiffProxy returns the changed attributes between two versions of a product proxy,
+// ignoring timestamps and the lock version
+func DiffProxy(from, to ProxyItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffMappingRule returns the changed attributes between two versions of a mapping rule, ignoring IDs and timestamps
+func DiffMappingRule(from, to MappingRuleItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffMetric returns the changed attributes between two versions of a metric, ignoring IDs and timestamps
+func DiffMetric(from, to MetricItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffMethod returns the changed attributes between two versions of a method, ignoring IDs and timestamps
+func DiffMethod(from, to MethodItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffApplicationPlan returns the changed attributes between two versions of an application plan, ignoring IDs and timestamps
+func DiffApplicationPlan(from, to ApplicationPlanItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffApplicationPlanLimit returns the changed attributes between two versions of a limit, ignoring IDs and timestamps
+func DiffApplicationPlanLimit(from, to ApplicationPlanLimitItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffApplicationPlanPricingRule returns the changed attributes between two versions of a pricing rule, ignoring IDs and timestamps
+func DiffApplicationPlanPricingRule(from, to ApplicationPlanPricingRuleItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffActiveDoc returns the changed attributes between two versions of an ActiveDocs entry, ignoring IDs and timestamps
+func DiffActiveDoc(from, to ActiveDocItem) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// DiffPolicyConfig returns the changed attributes between two versions of a policy
+func DiffPolicyConfig(from, to PolicyConfig) []AttributeChange {
+	return diffAttributes(from, to)
+}
+
+// diffAttributes compares the fields of two structs of the same type, in declaration order,
+// skipping server managed attributes and the given ones. Attributes are named after their json tag
+func diffAttributes(from, to interface{}, ignored ...string) []AttributeChange {
+	skip := map[string]bool{}
+	for _, attr := range append(serverManagedAttributes, ignored...) {
+		skip[attr] = true
+	}
+
+	fromValue, toValue := reflect.ValueOf(from), reflect.ValueOf(to)
+	structType := fromValue.Type()
+
+	var changes []AttributeChange
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		attr := attributeName(field)
+		if attr == "-" || skip[attr] {
+			continue
+		}
+
+		fromAttr, toAttr := attributeValue(fromValue.Field(i)), attributeValue(toValue.Field(i))
+		if !reflect.DeepEqual(fromAttr, toAttr) {
+			changes = append(changes, AttributeChange{Attribute: attr, From: fromAttr, To: toAttr})
+		}
+	}
+	return changes
+}
+
+// diffExtra compares the custom fields of two versions of a resource, sorted by name
+func diffExtra(from, to map[string]interface{}) []AttributeChange {
+	names := make([]string, 0, len(from)+len(to))
+	for name := range from {
+		names = append(names, name)
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []AttributeChange
+	for _, name := range names {
+		if !reflect.DeepEqual(from[name], to[name]) {
+			changes = append(changes, AttributeChange{Attribute: name, From: from[name], To: to[name]})
+		}
+	}
+	return changes
+}
+
+func attributeName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// attributeValue dereferences pointer fields, unset pointers and empty maps or slices being nil
+func attributeValue(value reflect.Value) interface{} {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	case reflect.Map, reflect.Slice:
+		if value.Len() == 0 {
+			return nil
+		}
+	}
+	return value.Interface()
+}
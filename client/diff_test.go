@@ -0,0 +1,84 @@
+package client
+
+import "testing"
+
+func TestDiffApplication(t *testing.T) {
+	from := Application{
+		ID: 1, CreatedAt: "t1", UpdatedAt: "t1", State: "live", AppName: "app", Description: "first",
+		PlanID: 10, FirstTrafficAt: "t1", ProviderVerificationKey: "key1",
+	}
+	to := from
+	to.ID = 2
+	to.UpdatedAt = "t2"
+	to.FirstTrafficAt = "t2"
+	to.ProviderVerificationKey = "key2"
+
+	equals(t, 0, len(DiffApplication(from, to)))
+
+	to.Description = "second"
+	to.PlanID = 11
+	equals(t, []AttributeChange{
+		{Attribute: "plan_id", From: int64(10), To: int64(11)},
+		{Attribute: "description", From: "first", To: "second"},
+	}, DiffApplication(from, to))
+
+	from.Extra = map[string]interface{}{"department": "sales", "tier": "gold"}
+	to = from
+	to.Extra = map[string]interface{}{"department": "sales", "region": "eu"}
+	equals(t, []AttributeChange{
+		{Attribute: "region", From: nil, To: "eu"},
+		{Attribute: "tier", From: "gold", To: nil},
+	}, DiffApplication(from, to))
+}
+
+func TestDiffProxy(t *testing.T) {
+	from := ProxyItem{ServiceID: 1, Endpoint: "https://a.example.com", LockVersion: 1, UpdatedAt: "t1"}
+	to := ProxyItem{ServiceID: 1, Endpoint: "https://b.example.com", LockVersion: 2, UpdatedAt: "t2"}
+
+	equals(t, []AttributeChange{
+		{Attribute: "endpoint", From: "https://a.example.com", To: "https://b.example.com"},
+	}, DiffProxy(from, to))
+}
+
+func TestDiffMappingRule(t *testing.T) {
+	from := MappingRuleItem{ID: 1, MetricID: 2, Pattern: "/", HTTPMethod: "GET", Delta: 1}
+	to := MappingRuleItem{ID: 3, MetricID: 2, Pattern: "/", HTTPMethod: "GET", Delta: 1, CreatedAt: "t1"}
+	equals(t, 0, len(DiffMappingRule(from, to)))
+
+	to.Last = true
+	equals(t, []AttributeChange{{Attribute: "last", From: false, To: true}}, DiffMappingRule(from, to))
+}
+
+func TestDiffApplicationPlan(t *testing.T) {
+	from := ApplicationPlanItem{ID: 1, Name: "Basic", SetupFee: 1, State: "hidden"}
+	to := ApplicationPlanItem{ID: 1, Name: "Basic", SetupFee: 2.5, State: "published"}
+
+	equals(t, []AttributeChange{
+		{Attribute: "state", From: "hidden", To: "published"},
+		{Attribute: "setup_fee", From: float64(1), To: 2.5},
+	}, DiffApplicationPlan(from, to))
+}
+
+func TestDiffActiveDoc(t *testing.T) {
+	var id int64 = 1
+	name, other := "docs", "new docs"
+	published := true
+	from := ActiveDocItem{ID: &id, Name: &name}
+	to := ActiveDocItem{Name: &other, Published: &published}
+
+	equals(t, []AttributeChange{
+		{Attribute: "name", From: "docs", To: "new docs"},
+		{Attribute: "published", From: nil, To: true},
+	}, DiffActiveDoc(from, to))
+}
+
+func TestDiffPolicyConfig(t *testing.T) {
+	from := PolicyConfig{Name: "apicast", Version: "builtin", Enabled: true}
+	to := PolicyConfig{Name: "apicast", Version: "builtin", Enabled: true, Configuration: map[string]interface{}{}}
+	equals(t, 0, len(DiffPolicyConfig(from, to)))
+
+	to.Configuration["key"] = "value"
+	equals(t, []AttributeChange{
+		{Attribute: "configuration", From: nil, To: map[string]interface{}{"key": "value"}},
+	}, DiffPolicyConfig(from, to))
+}
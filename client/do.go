@@ -0,0 +1,126 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Do sends a request to an Account Management API endpoint not wrapped by this client yet.
+// params are added to the query string of endpoint for GET, HEAD, DELETE and OPTIONS requests
+// and sent as form body for every other method, i.e. POST, PUT or PATCH.
+// The response body is decoded into decodeInto, as JSON or XML depending on the endpoint extension.
+// A nil decodeInto discards the response body
+func (c *ThreeScaleClient) Do(method, endpoint string, params Params, expectedStatus int, decodeInto interface{}) error {
+	values := url.Values{}
+	for k, v := range params {
+		values.Add(k, v)
+	}
+
+	var body io.Reader
+	if !isQueryMethod(method) {
+		body = strings.NewReader(values.Encode())
+	}
+
+	req, err := c.NewRequest(method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("building %s %s request: %w", method, endpoint, err)
+	}
+
+	if body == nil && len(values) > 0 {
+		query := req.URL.Query()
+		for k, v := range values {
+			query[k] = v
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+
+	return c.DoRequest(req, expectedStatus, decodeInto)
+}
+
+// isQueryMethod reports whether requests of the method carry their params in the query string
+func isQueryMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// NewRequest builds an authenticated request to the given Account Management API path, i.e. "/admin/api/services.json".
+// The Accept header follows the path extension. Bodies are sent as form params,
+// set the Content-Type header of the returned request for other encodings
+func (c *ThreeScaleClient) NewRequest(method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, c.endpointURL(path), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if isJSONEndpoint(req) {
+		req.Header.Set("Accept", "application/json")
+	} else {
+		req.Header.Set("Accept", "application/xml")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	req.Header.Set("Authorization", "Basic "+basicAuth("", c.credential))
+	return req, nil
+}
+
+// DoRequest sends a request built by NewRequest, applying the client hooks and retries.
+// Responses not matching expectedStatus are returned as ApiErr errors.
+// The response body is decoded into decodeInto, as JSON or XML depending on the request path extension.
+// A nil decodeInto discards the response body
+func (c *ThreeScaleClient) DoRequest(req *http.Request, expectedStatus int, decodeInto interface{}) error {
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if isJSONEndpoint(req) {
+		return handleJsonResp(resp, expectedStatus, decodeInto)
+	}
+	return handleXMLResp(resp, expectedStatus, decodeInto)
+}
+
+// doGet reads endpoint, decoding the response into a new T
+func doGet[T any](c *ThreeScaleClient, endpoint string) (*T, error) {
+	return doParams[T](c, http.MethodGet, endpoint, nil, http.StatusOK)
+}
+
+// doPost creates a resource on endpoint from the form params, decoding the response into a new T
+func doPost[T any](c *ThreeScaleClient, endpoint string, params Params) (*T, error) {
+	return doParams[T](c, http.MethodPost, endpoint, params, http.StatusCreated)
+}
+
+// doPut updates the resource of endpoint with the form params, decoding the response into a new T
+func doPut[T any](c *ThreeScaleClient, endpoint string, params Params) (*T, error) {
+	return doParams[T](c, http.MethodPut, endpoint, params, http.StatusOK)
+}
+
+// doDelete deletes the resource of endpoint, discarding the response body
+func doDelete(c *ThreeScaleClient, endpoint string) error {
+	return c.Do(http.MethodDelete, endpoint, nil, http.StatusOK, nil)
+}
+
+func doParams[T any](c *ThreeScaleClient, method, endpoint string, params Params, expectedStatus int) (*T, error) {
+	obj := new(T)
+	err := c.Do(method, endpoint, params, expectedStatus, obj)
+	return obj, err
+}
+
+// doReq sends a request built by the caller, for bodies and headers not covered by doGet, doPost and doPut,
+// decoding the response into a new T. Like the other helpers, the new T is returned along with errors
+func doReq[T any](c *ThreeScaleClient, req *http.Request, expectedStatus int) (*T, error) {
+	obj := new(T)
+	err := c.DoRequest(req, expectedStatus, obj)
+	return obj, err
+}
+
+func isJSONEndpoint(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, ".json")
+}
@@ -0,0 +1,262 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestDoJSON(t *testing.T) {
+	const endpoint = "/admin/api/services/1/proxy/oidc_configuration.json"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodPut {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPut, req.Method)
+		}
+
+		if req.Header.Get("Accept") != "application/json" {
+			t.Fatalf("Accept header does not match. Expected [%s]; got [%s]", "application/json", req.Header.Get("Accept"))
+		}
+
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		equals(t, "true", req.PostForm.Get("standard_flow_enabled"))
+
+		return jsonTestResponse(t, http.StatusOK, OIDCConfiguration{
+			Element: OIDCConfigurationItem{StandardFlowEnabled: true},
+		})
+	})
+
+	params, err := ParamsFromStruct(struct {
+		StandardFlow bool `param:"standard_flow_enabled"`
+	}{true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj := &OIDCConfiguration{}
+	if err := c.Do(http.MethodPut, endpoint, params, http.StatusOK, obj); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, true, obj.Element.StandardFlowEnabled)
+}
+
+func TestDoXMLQuery(t *testing.T) {
+	const endpoint = "/admin/api/services/1/metrics.xml"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		equals(t, "page=2", req.URL.RawQuery)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`<metrics><metric><id>3</id><name>hits</name></metric></metrics>`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	metrics := MetricList{}
+	if err := c.Do(http.MethodGet, endpoint, Params{"page": "2"}, http.StatusOK, &metrics); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, len(metrics.Metrics))
+	equals(t, "3", metrics.Metrics[0].ID)
+}
+
+func TestDoPatch(t *testing.T) {
+	const endpoint = "/admin/api/services/1.json"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPatch,
+			Path:   endpoint,
+			Form:   map[string]string{"name": "someName"},
+		}.Assert(t, req)
+		return jsonTestResponse(t, http.StatusOK, Product{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.Do(http.MethodPatch, endpoint, Params{"name": "someName"}, http.StatusOK, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDoKeepsEndpointQuery(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   "/admin/api/services.json",
+			Query:  map[string]string{"state": "live", "page": "2"},
+		}.Assert(t, req)
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.Do(http.MethodGet, "/admin/api/services.json?state=live", Params{"page": "2"}, http.StatusOK, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDoInvalidRequest(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	err := c.Do(http.MethodGet, "/admin/api/services.json\x7f", nil, http.StatusOK, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if errors.Is(err, httpReqError) {
+		t.Fatalf("expected the request building error; got %v", err)
+	}
+}
+
+func TestNewRequestDoRequest(t *testing.T) {
+	const endpoint = "/admin/api/services/1/proxy/oidc_configuration.json"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPatch, Path: endpoint}.Assert(t, req)
+		equals(t, "application/json", req.Header.Get("Accept"))
+		equals(t, "application/json", req.Header.Get("Content-Type"))
+		equals(t, "Basic "+basicAuth("", "someAccessToken"), req.Header.Get("Authorization"))
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equals(t, `{"implicit_flow_enabled":true}`, string(body))
+
+		return jsonTestResponse(t, http.StatusOK, OIDCConfiguration{
+			Element: OIDCConfigurationItem{ImplicitFlowEnabled: true},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	req, err := c.NewRequest(http.MethodPatch, endpoint, bytes.NewBufferString(`{"implicit_flow_enabled":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	obj := &OIDCConfiguration{}
+	if err := c.DoRequest(req, http.StatusOK, obj); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, true, obj.Element.ImplicitFlowEnabled)
+}
+
+func TestDoRequestUnexpectedStatus(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		equals(t, "application/xml", req.Header.Get("Accept"))
+		equals(t, "", req.Header.Get("Content-Type"))
+
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`<?xml version="1.0" encoding="UTF-8"?><error>Not found</error>`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	req, err := c.NewRequest(http.MethodGet, "/admin/api/services/1/metrics.xml", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.DoRequest(req, http.StatusOK, &MetricList{})
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+}
+
+func TestDoGetGeneric(t *testing.T) {
+	const endpoint = "/admin/api/services/1.json"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: endpoint}.Assert(t, req)
+		equals(t, "application/json", req.Header.Get("Accept"))
+
+		return jsonTestResponse(t, http.StatusOK, Product{Element: ProductItem{ID: 1, Name: "api"}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := doGet[Product](c, endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, "api", obj.Element.Name)
+}
+
+func TestDoPostPutGeneric(t *testing.T) {
+	const endpoint = "/admin/api/services/1/metrics.json"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		equals(t, endpoint, req.URL.Path)
+		equals(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+		equals(t, "hits", req.PostForm.Get("friendly_name"))
+
+		status := http.StatusOK
+		if req.Method == http.MethodPost {
+			status = http.StatusCreated
+		}
+		return jsonTestResponse(t, status, MetricJSON{Element: MetricItem{ID: 2, Name: req.Method}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	created, err := doPost[MetricJSON](c, endpoint, Params{"friendly_name": "hits"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, http.MethodPost, created.Element.Name)
+
+	updated, err := doPut[MetricJSON](c, endpoint, Params{"friendly_name": "hits"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, http.MethodPut, updated.Element.Name)
+}
+
+func TestDoGenericUnexpectedStatus(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	obj, err := doGet[Product](c, "/admin/api/services/1.json")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if obj == nil {
+		t.Fatal("expected zero object along with the error")
+	}
+	apiErr, ok := err.(ApiErr)
+	if !ok {
+		t.Fatalf("expected ApiErr; got %T", err)
+	}
+	equals(t, http.StatusNotFound, apiErr.Code())
+
+	if err := doDelete(c, "/admin/api/services/1.json"); !IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+}
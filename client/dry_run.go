@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// PlannedChange is a mutating request recorded, and not sent, in dry-run mode
+type PlannedChange struct {
+	Method   string
+	Endpoint string
+	// Body is the request body, credentials being redacted from url encoded bodies
+	Body string
+}
+
+// dryRun records the mutating requests of a client in dry-run mode
+type dryRun struct {
+	mu      sync.Mutex
+	changes []PlannedChange
+}
+
+// SetDryRun enables or disables the dry-run mode. In dry-run mode mutating requests, i.e. every method
+// but GET and HEAD, are built, logged and recorded as PlannedChange without being sent to 3scale.
+// They are answered with a synthesized empty successful response, so the calls return zero valued objects.
+// Read requests are still sent. Enabling the dry-run mode again discards the recorded changes
+func (c *ThreeScaleClient) SetDryRun(enabled bool) {
+	if !enabled {
+		c.dryRun = nil
+		return
+	}
+	c.dryRun = &dryRun{}
+}
+
+// PlannedChanges returns the mutating requests recorded in dry-run mode, in the order they were made
+func (c *ThreeScaleClient) PlannedChanges() []PlannedChange {
+	if c.parent != nil {
+		return c.parent.PlannedChanges()
+	}
+	if c.dryRun == nil {
+		return nil
+	}
+	c.dryRun.mu.Lock()
+	defer c.dryRun.mu.Unlock()
+	return append([]PlannedChange{}, c.dryRun.changes...)
+}
+
+// planRequest records the mutating request, returning the synthesized response.
+// Returns false for read requests, which must be sent
+func (c *ThreeScaleClient) planRequest(req *http.Request) (*http.Response, bool) {
+	if c.dryRun == nil || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return nil, false
+	}
+
+	change := PlannedChange{Method: req.Method, Endpoint: req.URL.Path}
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err == nil {
+			change.Body = string(sanitizeBody(req.Header.Get("Content-Type"), body))
+		}
+	}
+
+	c.dryRun.mu.Lock()
+	c.dryRun.changes = append(c.dryRun.changes, change)
+	c.dryRun.mu.Unlock()
+
+	statusCode := http.StatusOK
+	if req.Method == http.MethodPost {
+		statusCode = http.StatusCreated
+	}
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:     make(http.Header),
+		Body:       http.NoBody,
+		Request:    req.WithContext(context.WithValue(req.Context(), dryRunResponseKey{}, true)),
+	}
+
+	if c.debugHistory != nil || c.requestLogger != nil {
+		summary := newRequestSummary(req, resp, nil, 0)
+		c.recordDebugHistory(summary)
+		c.logRequest(summary)
+	}
+	return resp, true
+}
+
+// dryRunResponseKey marks the request of synthesized dry-run responses. Body wrappers, like the one
+// of WithTimeout, would hide a marker carried by the response body type
+type dryRunResponseKey struct{}
+
+// isDryRunResponse reports whether resp was synthesized in dry-run mode,
+// such responses match any expected status and decode into zero values
+func isDryRunResponse(resp *http.Response) bool {
+	return resp.Request != nil && resp.Request.Context().Value(dryRunResponseKey{}) != nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDryRun(t *testing.T) {
+	requests := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		if req.Method != http.MethodGet {
+			t.Fatalf("mutating %s request sent in dry-run mode", req.Method)
+		}
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetDryRun(true)
+
+	summaries := []RequestSummary{}
+	c.SetRequestLogger(func(summary RequestSummary) {
+		summaries = append(summaries, summary)
+	})
+
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+	product, err := c.CreateProduct("someProduct", Params{"system_name": "some_product"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(0), product.Element.ID)
+	if err := c.DeleteProduct(3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.WithHeaders(http.Header{"X-Request-Id": {"someID"}}).CreateTenant("org", "admin", "admin@example.com", "secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, requests)
+	equals(t, []PlannedChange{
+		{Method: http.MethodPost, Endpoint: "/admin/api/services.json", Body: "name=someProduct&system_name=some_product"},
+		{Method: http.MethodDelete, Endpoint: "/admin/api/services/3.json"},
+		{Method: http.MethodPost, Endpoint: "/master/api/providers.json", Body: "email=admin%40example.com&org_name=org&password=REDACTED&username=admin"},
+	}, c.PlannedChanges())
+	equals(t, 4, len(summaries))
+	equals(t, http.StatusCreated, summaries[1].StatusCode)
+
+	c.SetDryRun(false)
+	equals(t, 0, len(c.PlannedChanges()))
+}
+
+func TestDryRunWithTimeout(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		t.Fatalf("%s request sent in dry-run mode", req.Method)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetDryRun(true)
+	timed := c.WithTimeout(time.Minute)
+
+	if _, err := timed.CreateProduct("someProduct", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := timed.TriggerAccountBilling(3, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(c.PlannedChanges()))
+}
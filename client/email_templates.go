@@ -0,0 +1,75 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// System names of some of the builtin email templates sent to developers
+const (
+	EmailTemplateSignupInvitation  = "user_signup_invitation"
+	EmailTemplateAccountActivation = "account_activation"
+	EmailTemplateAccountApproved   = "account_approved"
+	EmailTemplatePasswordReset     = "password_reset"
+	EmailTemplateInvoice           = "invoice_email"
+)
+
+// ListEmailTemplates List builtin email templates of the developer portal
+func (c *ThreeScaleClient) ListEmailTemplates(opts ...ListOptions) (*CMSTemplateList, error) {
+	return c.ListCMSTemplates(Params{"type": CMSTemplateTypeEmailTemplate}, opts...)
+}
+
+// EmailTemplate Reads builtin email template by system name
+// Returns not found error when there is no email template with the given system name
+func (c *ThreeScaleClient) EmailTemplate(systemName string) (*CMSTemplate, error) {
+	list, err := c.ListEmailTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range list.Templates {
+		template := list.Templates[idx]
+		if template.SystemName != nil && *template.SystemName == systemName {
+			if template.ID == nil {
+				return &template, nil
+			}
+			// Read the template to make sure draft and published content are included
+			return c.CMSTemplate(*template.ID)
+		}
+	}
+
+	return nil, createApiErr(http.StatusNotFound, fmt.Sprintf("email template with system_name %q not found", systemName))
+}
+
+// UpdateEmailTemplate Update the draft content of builtin email template by system name
+// When publish is true, the draft is published right after the update
+func (c *ThreeScaleClient) UpdateEmailTemplate(systemName, draft string, publish bool) (*CMSTemplate, error) {
+	if draft == "" {
+		return nil, errors.New("UpdateEmailTemplate needs not empty draft")
+	}
+
+	current, err := c.EmailTemplate(systemName)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.ID == nil {
+		return nil, fmt.Errorf("email template with system_name %q has no ID", systemName)
+	}
+	id := *current.ID
+
+	// Unchanged drafts are not sent again, so deploying the same content twice only publishes
+	if current.Draft == nil || *current.Draft != draft {
+		current, err = c.UpdateCMSTemplate(&CMSTemplate{ID: &id, Draft: &draft})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !publish {
+		return current, nil
+	}
+
+	return c.PublishCMSTemplate(id)
+}
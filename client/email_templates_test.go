@@ -0,0 +1,110 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func emailTemplatesTestClient(t *testing.T, draft string, writes *[]string) *ThreeScaleClient {
+	var (
+		id         int64 = 8
+		otherID    int64 = 9
+		systemName       = EmailTemplateSignupInvitation
+		otherName        = EmailTemplateInvoice
+		tmplType         = CMSTemplateTypeEmailTemplate
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		switch req.Method + " " + req.URL.Path {
+		case http.MethodGet + " " + cmsTemplateListResourceEndpoint:
+			fake.RequestAssertion{
+				Method: http.MethodGet,
+				Path:   cmsTemplateListResourceEndpoint,
+				Query:  map[string]string{"type": CMSTemplateTypeEmailTemplate},
+			}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusOK, CMSTemplateList{Templates: []CMSTemplate{
+				{ID: &otherID, Type: &tmplType, SystemName: &otherName},
+				{ID: &id, Type: &tmplType, SystemName: &systemName},
+			}})
+		case http.MethodGet + " " + fmt.Sprintf(cmsTemplateResourceEndpoint, id):
+			return jsonTestResponse(t, http.StatusOK, CMSTemplate{ID: &id, Type: &tmplType, SystemName: &systemName, Draft: &draft})
+		case http.MethodPut + " " + fmt.Sprintf(cmsTemplateResourceEndpoint, id):
+			bodyArr, err := ioutil.ReadAll(req.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sent := CMSTemplate{}
+			if err := json.Unmarshal(bodyArr, &sent); err != nil {
+				t.Fatal(err)
+			}
+			*writes = append(*writes, "update "+*sent.Draft)
+			return jsonTestResponse(t, http.StatusOK, CMSTemplate{ID: &id, SystemName: &systemName, Draft: sent.Draft})
+		case http.MethodPut + " " + fmt.Sprintf(cmsTemplatePublishResourceEndpoint, id):
+			*writes = append(*writes, "publish")
+			return jsonTestResponse(t, http.StatusOK, CMSTemplate{ID: &id, SystemName: &systemName, Published: &draft})
+		}
+
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil
+	})
+
+	return NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+}
+
+func TestEmailTemplate(t *testing.T) {
+	c := emailTemplatesTestClient(t, "Welcome {{ user.display_name }}", &[]string{})
+
+	obj, err := c.EmailTemplate(EmailTemplateSignupInvitation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(8), *obj.ID)
+	equals(t, "Welcome {{ user.display_name }}", *obj.Draft)
+
+	_, err = c.EmailTemplate("unknown")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestUpdateEmailTemplate(t *testing.T) {
+	inputs := []struct {
+		Name           string
+		CurrentDraft   string
+		Draft          string
+		Publish        bool
+		ExpectedWrites []string
+	}{
+		{"update and publish", "old", "new", true, []string{"update new", "publish"}},
+		{"update only", "old", "new", false, []string{"update new"}},
+		{"unchanged draft is only published", "new", "new", true, []string{"publish"}},
+		{"unchanged draft without publish", "new", "new", false, nil},
+	}
+
+	for _, input := range inputs {
+		t.Run(input.Name, func(subTest *testing.T) {
+			var writes []string
+			c := emailTemplatesTestClient(subTest, input.CurrentDraft, &writes)
+
+			obj, err := c.UpdateEmailTemplate(EmailTemplateSignupInvitation, input.Draft, input.Publish)
+			if err != nil {
+				subTest.Fatal(err)
+			}
+			equals(subTest, int64(8), *obj.ID)
+			equals(subTest, input.ExpectedWrites, writes)
+		})
+	}
+
+	c := emailTemplatesTestClient(t, "old", &[]string{})
+	if _, err := c.UpdateEmailTemplate(EmailTemplateSignupInvitation, "", true); err == nil {
+		t.Fatal("expected error on empty draft")
+	}
+	if _, err := c.UpdateEmailTemplate("unknown", "new", true); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package client
+
+// EnsureAccount Returns the developer account whose admin user has the given username,
+// signing up a new account with params (i.e. "org_name", "email", "password") when missing.
+// Existing accounts are returned untouched, signup params do not apply to them
+func (c *ThreeScaleClient) EnsureAccount(username string, params Params) (*DeveloperAccount, error) {
+	account, err := c.FindAccount(username)
+	if err == nil {
+		return c.DeveloperAccount(account.ID)
+	}
+
+	if !IsNotFound(err) {
+		return nil, err
+	}
+
+	signupParams := Params{}
+	for k, v := range params {
+		signupParams.AddParam(k, v)
+	}
+	signupParams.AddParam("username", username)
+
+	return c.Signup(signupParams)
+}
+
+// EnsureApplicationPlan Returns the application plan of the product with the given system name,
+// creating it with params when missing. Existing plans are updated with params when not empty.
+// The plan name defaults to the system name on creation
+func (c *ThreeScaleClient) EnsureApplicationPlan(productID int64, systemName string, params Params) (*ApplicationPlan, error) {
+	plans, err := c.ListApplicationPlansByProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range plans.Plans {
+		plan := &plans.Plans[idx]
+		if plan.Element.SystemName != systemName {
+			continue
+		}
+
+		if len(params) == 0 {
+			return plan, nil
+		}
+		return c.UpdateApplicationPlan(productID, plan.Element.ID, params)
+	}
+
+	createParams := Params{"name": systemName}
+	for k, v := range params {
+		createParams.AddParam(k, v)
+	}
+	createParams.AddParam("system_name", systemName)
+
+	return c.CreateApplicationPlan(productID, createParams)
+}
+
+// EnsureApplication Returns the application of the account with the given name, creating it on planID when missing.
+// Existing applications are moved to planID when subscribed to another plan.
+// Both new and existing applications are updated with params when not empty
+func (c *ThreeScaleClient) EnsureApplication(accountID, planID int64, name string, params Params) (*Application, error) {
+	apps, err := c.ListApplications(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var app *Application
+	for idx := range apps.Applications {
+		if apps.Applications[idx].Application.AppName == name {
+			app = &apps.Applications[idx].Application
+			break
+		}
+	}
+
+	updateParams := params
+	if app == nil {
+		app, err = c.CreateApplication(accountID, planID, name, Params{"description": params["description"]})
+		if err != nil {
+			return nil, err
+		}
+
+		// description was already sent on creation
+		updateParams = Params{}
+		for k, v := range params {
+			if k != "description" {
+				updateParams.AddParam(k, v)
+			}
+		}
+	} else if app.PlanID != planID {
+		app, err = c.ChangeApplicationPlan(accountID, app.ID, planID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(updateParams) == 0 {
+		return app, nil
+	}
+	return c.UpdateApplication(accountID, app.ID, updateParams)
+}
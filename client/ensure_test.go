@@ -0,0 +1,225 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestEnsureAccountExisting(t *testing.T) {
+	var accountID int64 = 3
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.Path {
+		case findAccount:
+			fake.RequestAssertion{Method: http.MethodGet, Query: map[string]string{"username": "john"}}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusOK, AccountElem{Account: Account{ID: accountID}})
+		case fmt.Sprintf(developerAccountResourceEndpoint, accountID):
+			fake.RequestAssertion{Method: http.MethodGet}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusOK, DeveloperAccount{Element: DeveloperAccountItem{ID: &accountID}})
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	account, err := c.EnsureAccount("john", Params{"org_name": "ACME"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, accountID, *account.Element.ID)
+}
+
+func TestEnsureAccountMissing(t *testing.T) {
+	var accountID int64 = 4
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.Path {
+		case findAccount:
+			return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+		case signupResourceEndpoint:
+			fake.RequestAssertion{
+				Method: http.MethodPost,
+				Form:   map[string]string{"username": "john", "org_name": "ACME", "email": "john@example.com"},
+			}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusCreated, DeveloperAccount{Element: DeveloperAccountItem{ID: &accountID}})
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	account, err := c.EnsureAccount("john", Params{"org_name": "ACME", "email": "john@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, accountID, *account.Element.ID)
+}
+
+func TestEnsureAccountError(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != findAccount {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return jsonTestResponse(t, http.StatusForbidden, map[string]string{"error": "Access Denied"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if _, err := c.EnsureAccount("john", nil); !IsForbidden(err) {
+		t.Fatalf("expected forbidden error; got %v", err)
+	}
+}
+
+func TestEnsureApplicationPlan(t *testing.T) {
+	var productID int64 = 10
+
+	plans := ApplicationPlanJSONList{Plans: []ApplicationPlan{
+		{Element: ApplicationPlanItem{ID: 1, SystemName: "basic"}},
+	}}
+
+	tests := []struct {
+		Name       string
+		systemName string
+		params     Params
+		expected   *fake.RequestAssertion
+	}{
+		{"existing untouched", "basic", nil, nil},
+		{"existing updated", "basic", Params{"approval_required": "true"}, &fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   fmt.Sprintf(appPlanResourceEndpoint, productID, 1),
+			Form:   map[string]string{"approval_required": "true"},
+		}},
+		{"missing", "pro", Params{"cost_per_month": "10"}, &fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf(appPlanListResourceEndpoint, productID),
+			Form:   map[string]string{"name": "pro", "system_name": "pro", "cost_per_month": "10"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subTest *testing.T) {
+			writes := 0
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				if req.Method == http.MethodGet {
+					fake.RequestAssertion{Path: fmt.Sprintf(appPlanListResourceEndpoint, productID)}.Assert(subTest, req)
+					return jsonTestResponse(subTest, http.StatusOK, plans)
+				}
+
+				writes++
+				if tt.expected == nil {
+					subTest.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+				}
+				tt.expected.Assert(subTest, req)
+
+				status := http.StatusOK
+				if req.Method == http.MethodPost {
+					status = http.StatusCreated
+				}
+				return jsonTestResponse(subTest, status, ApplicationPlan{Element: ApplicationPlanItem{ID: 2, SystemName: tt.systemName}})
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subTest), "someAccessToken", httpClient)
+			plan, err := c.EnsureApplicationPlan(productID, tt.systemName, tt.params)
+			if err != nil {
+				subTest.Fatal(err)
+			}
+
+			equals(subTest, tt.systemName, plan.Element.SystemName)
+			if tt.expected != nil {
+				equals(subTest, 1, writes)
+			}
+		})
+	}
+}
+
+func TestEnsureApplicationMissing(t *testing.T) {
+	var (
+		accountID int64 = 3
+		planID    int64 = 7
+		appID     int64 = 20
+	)
+
+	var requests []string
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method {
+		case http.MethodGet:
+			fake.RequestAssertion{Path: fmt.Sprintf(appList, accountID)}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusOK, ApplicationList{})
+		case http.MethodPost:
+			fake.RequestAssertion{
+				Path: fmt.Sprintf(appCreate, "3"),
+				Form: map[string]string{"plan_id": "7", "name": "myapp", "description": "Webshop"},
+			}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusCreated, ApplicationElem{Application: Application{ID: appID, PlanID: planID, AppName: "myapp"}})
+		case http.MethodPut:
+			fake.RequestAssertion{
+				Path: fmt.Sprintf(appUpdate, accountID, appID),
+				Form: map[string]string{"tier": "gold"},
+			}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusOK, ApplicationElem{Application: Application{ID: appID, PlanID: planID, AppName: "myapp"}})
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	app, err := c.EnsureApplication(accountID, planID, "myapp", Params{"description": "Webshop", "tier": "gold"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, appID, app.ID)
+	equals(t, []string{
+		"GET " + fmt.Sprintf(appList, accountID),
+		"POST " + fmt.Sprintf(appCreate, "3"),
+		"PUT " + fmt.Sprintf(appUpdate, accountID, appID),
+	}, requests)
+}
+
+func TestEnsureApplicationExisting(t *testing.T) {
+	var (
+		accountID int64 = 3
+		appID     int64 = 20
+	)
+
+	var requests []string
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.URL.Path {
+		case fmt.Sprintf(appList, accountID):
+			return jsonTestResponse(t, http.StatusOK, ApplicationList{Applications: []ApplicationElem{
+				{Application: Application{ID: 19, AppName: "other", PlanID: 7}},
+				{Application: Application{ID: appID, AppName: "myapp", PlanID: 7}},
+			}})
+		case fmt.Sprintf(appChangePlan, accountID, appID):
+			fake.RequestAssertion{Method: http.MethodPut, Form: map[string]string{"plan_id": "8"}}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusOK, ApplicationElem{Application: Application{ID: appID, AppName: "myapp", PlanID: 8}})
+		case fmt.Sprintf(appUpdate, accountID, appID):
+			fake.RequestAssertion{Method: http.MethodPut, Form: map[string]string{"description": "Webshop"}}.Assert(t, req)
+			return jsonTestResponse(t, http.StatusOK, ApplicationElem{Application: Application{ID: appID, AppName: "myapp", PlanID: 8, Description: "Webshop"}})
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	app, err := c.EnsureApplication(accountID, 8, "myapp", Params{"description": "Webshop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, int64(8), app.PlanID)
+	equals(t, "Webshop", app.Description)
+	equals(t, []string{
+		"GET " + fmt.Sprintf(appList, accountID),
+		"PUT " + fmt.Sprintf(appChangePlan, accountID, appID),
+		"PUT " + fmt.Sprintf(appUpdate, accountID, appID),
+	}, requests)
+}
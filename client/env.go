@@ -0,0 +1,90 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables read by NewFromEnv
+const (
+	// EnvPortalEndpoint is the admin portal URL, it may embed the access token, see NewAdminPortalFromURL
+	EnvPortalEndpoint = "THREESCALE_PORTAL_ENDPOINT"
+	// EnvAccessToken is the access token, it takes precedence over a token embedded in EnvPortalEndpoint
+	EnvAccessToken = "THREESCALE_ACCESS_TOKEN"
+	// EnvTLSInsecure disables the server certificate verification when true
+	EnvTLSInsecure = "THREESCALE_TLS_INSECURE"
+	// EnvCACertFile is the path of a PEM bundle of CA certificates trusted on top of the system ones
+	EnvCACertFile = "THREESCALE_CA_CERT_FILE"
+	// EnvHTTPProxy is the proxy URL requests are sent through, see SetHTTPProxy
+	EnvHTTPProxy = "THREESCALE_HTTP_PROXY"
+	// EnvTimeout is the request timeout as a duration, e.g. 30s
+	EnvTimeout = "THREESCALE_TIMEOUT"
+)
+
+// NewFromEnv creates a ThreeScaleClient configured from the THREESCALE_* environment variables,
+// see EnvPortalEndpoint and the following constants. Only THREESCALE_PORTAL_ENDPOINT is required
+func NewFromEnv() (*ThreeScaleClient, error) {
+	return newFromLookup(os.LookupEnv)
+}
+
+func newFromLookup(lookup func(string) (string, bool)) (*ThreeScaleClient, error) {
+	endpoint, _ := lookup(EnvPortalEndpoint)
+	if endpoint == "" {
+		return nil, fmt.Errorf("%s is not set", EnvPortalEndpoint)
+	}
+
+	adminPortal, credential, err := NewAdminPortalFromURL(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", EnvPortalEndpoint, err)
+	}
+	if token, _ := lookup(EnvAccessToken); token != "" {
+		credential = token
+	}
+	if credential == "" {
+		return nil, errors.New(EnvAccessToken + " is not set")
+	}
+
+	httpClient := &http.Client{}
+	if value, _ := lookup(EnvTimeout); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", EnvTimeout, err)
+		}
+		httpClient.Timeout = timeout
+	}
+
+	c := NewThreeScale(adminPortal, credential, httpClient)
+
+	tlsOptions := TLSOptions{}
+	if value, _ := lookup(EnvTLSInsecure); value != "" {
+		insecure, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", EnvTLSInsecure, err)
+		}
+		tlsOptions.InsecureSkipVerify = insecure
+	}
+	if path, _ := lookup(EnvCACertFile); path != "" {
+		tlsOptions.CACertificates, err = ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", EnvCACertFile, err)
+		}
+	}
+	if tlsOptions.InsecureSkipVerify || len(tlsOptions.CACertificates) > 0 {
+		if err := c.SetTLSOptions(tlsOptions); err != nil {
+			return nil, fmt.Errorf("TLS options: %v", err)
+		}
+	}
+
+	if proxyURL, _ := lookup(EnvHTTPProxy); proxyURL != "" {
+		if err := c.SetHTTPProxy(proxyURL); err != nil {
+			return nil, fmt.Errorf("%s: %v", EnvHTTPProxy, err)
+		}
+	}
+
+	return c, nil
+}
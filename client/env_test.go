@@ -0,0 +1,105 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func envLookup(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func TestNewFromEnv(t *testing.T) {
+	c, err := newFromLookup(envLookup(map[string]string{
+		EnvPortalEndpoint: "https://embeddedToken@tenant-admin.example.com",
+		EnvAccessToken:    "someAccessToken",
+		EnvTLSInsecure:    "true",
+		EnvHTTPProxy:      "http://proxy.example.com:3128",
+		EnvTimeout:        "30s",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, "https://tenant-admin.example.com", c.adminPortal.rawURL)
+	equals(t, "someAccessToken", c.credential)
+	equals(t, 30*time.Second, c.httpClient.Timeout)
+
+	transport := c.httpClient.Transport.(*http.Transport)
+	equals(t, true, transport.TLSClientConfig.InsecureSkipVerify)
+
+	proxy, err := transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "tenant-admin.example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "proxy.example.com:3128", proxy.Host)
+}
+
+func TestNewFromEnvEmbeddedToken(t *testing.T) {
+	c, err := newFromLookup(envLookup(map[string]string{
+		EnvPortalEndpoint: "https://embeddedToken@tenant-admin.example.com",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, "embeddedToken", c.credential)
+	equals(t, (*tls.Config)(nil), tlsConfigOf(c))
+}
+
+func TestNewFromEnvErrors(t *testing.T) {
+	inputs := []struct {
+		Name string
+		Env  map[string]string
+	}{
+		{"missing endpoint", map[string]string{EnvAccessToken: "someAccessToken"}},
+		{"missing token", map[string]string{EnvPortalEndpoint: "https://tenant-admin.example.com"}},
+		{"invalid endpoint", map[string]string{EnvPortalEndpoint: "ftp://token@tenant-admin.example.com"}},
+		{"invalid insecure", map[string]string{EnvPortalEndpoint: "https://token@tenant-admin.example.com", EnvTLSInsecure: "maybe"}},
+		{"invalid timeout", map[string]string{EnvPortalEndpoint: "https://token@tenant-admin.example.com", EnvTimeout: "soon"}},
+		{"missing CA file", map[string]string{EnvPortalEndpoint: "https://token@tenant-admin.example.com", EnvCACertFile: "/nonexistent/ca.pem"}},
+	}
+
+	for _, input := range inputs {
+		t.Run(input.Name, func(subTest *testing.T) {
+			if _, err := newFromLookup(envLookup(input.Env)); err == nil {
+				subTest.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestNewFromEnvInvalidCACertificates(t *testing.T) {
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := newFromLookup(envLookup(map[string]string{
+		EnvPortalEndpoint: "https://token@tenant-admin.example.com",
+		EnvCACertFile:     caFile,
+	}))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.HasPrefix(err.Error(), "TLS options: ") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func tlsConfigOf(c *ThreeScaleClient) *tls.Config {
+	transport, ok := c.httpClient.Transport.(*http.Transport)
+	if !ok {
+		return nil
+	}
+	return transport.TLSClientConfig
+}
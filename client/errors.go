@@ -1,28 +1,229 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
+// requestIDHeader identifies the request in the 3scale system logs
+const requestIDHeader = "X-Request-Id"
+
 type ApiErr struct {
-	code int
-	err  string
+	code      int
+	err       string
+	cause     error
+	requestID string
+	method    string
+	endpoint  string
+	body      string
 }
 
 func (e ApiErr) Error() string {
-	return fmt.Sprintf("error calling 3scale system - reason: %s - code: %d", e.err, e.code)
+	msg := fmt.Sprintf("error calling 3scale system - reason: %s - code: %d", e.err, e.code)
+	if e.endpoint != "" {
+		msg = fmt.Sprintf("%s - endpoint: %s %s", msg, e.method, e.endpoint)
+	}
+	if e.requestID != "" {
+		msg = fmt.Sprintf("%s - request id: %s", msg, e.requestID)
+	}
+	return msg
 }
 
 func (e ApiErr) Code() int {
 	return e.code
 }
 
+// RequestID returns the X-Request-Id of the failed 3scale response, to be handed to support.
+// It is empty when 3scale did not send one
+func (e ApiErr) RequestID() string {
+	return e.requestID
+}
+
+// Method returns the HTTP method of the failed request
+func (e ApiErr) Method() string {
+	return e.method
+}
+
+// Endpoint returns the path of the failed request
+func (e ApiErr) Endpoint() string {
+	return e.endpoint
+}
+
+// Body returns the body of the failed 3scale response
+func (e ApiErr) Body() string {
+	return e.body
+}
+
+// Unwrap returns the underlying error, i.e. *DecodeError when the response could not be decoded
+// or the transport error when the response body could not be read
+func (e ApiErr) Unwrap() error {
+	return e.cause
+}
+
+// decodeErrorSnippetSize is the maximum number of body bytes kept in DecodeError
+const decodeErrorSnippetSize = 256
+
+// DecodeError holds the details of a 3scale response that could not be decoded
+type DecodeError struct {
+	Method   string
+	Endpoint string
+	Type     string
+	Snippet  string
+	Err      error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s - endpoint: %s %s - type: %s - body: %q", e.Err.Error(), e.Method, e.Endpoint, e.Type, e.Snippet)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func newDecodeError(resp *http.Response, decodeInto interface{}, body []byte, err error) *DecodeError {
+	decodeErr := &DecodeError{
+		Type: fmt.Sprintf("%T", decodeInto),
+		Err:  err,
+	}
+
+	if resp.Request != nil {
+		decodeErr.Method = resp.Request.Method
+		decodeErr.Endpoint = resp.Request.URL.Path
+	}
+
+	if len(body) > decodeErrorSnippetSize {
+		decodeErr.Snippet = string(body[:decodeErrorSnippetSize]) + "..."
+	} else {
+		decodeErr.Snippet = string(body)
+	}
+
+	return decodeErr
+}
+
+// ErrServiceSubscriptionRequired matches, using errors.Is, every ServiceSubscriptionRequiredErr
+var ErrServiceSubscriptionRequired = errors.New("3scale service subscription required")
+
+// ServiceSubscriptionRequiredErr is returned when an application cannot be created
+// because service plans are enabled on the tenant and the account is not subscribed to the service
+type ServiceSubscriptionRequiredErr struct {
+	AccountID string
+	PlanID    string
+	apiErr    ApiErr
+}
+
+func (e ServiceSubscriptionRequiredErr) Error() string {
+	return fmt.Sprintf("account %s requires a service subscription to use application plan %s - %s", e.AccountID, e.PlanID, e.apiErr.Error())
+}
+
+func (e ServiceSubscriptionRequiredErr) Code() int {
+	return e.apiErr.Code()
+}
+
+func (e ServiceSubscriptionRequiredErr) Unwrap() error {
+	return e.apiErr
+}
+
+// Is reports whether target is ErrServiceSubscriptionRequired
+func (e ServiceSubscriptionRequiredErr) Is(target error) bool {
+	return target == ErrServiceSubscriptionRequired
+}
+
+// ErrConflict matches, using errors.Is, every ConflictErr
+var ErrConflict = errors.New("3scale resource conflict")
+
+// ConflictErr is returned when 3scale answers 409 Conflict,
+// i.e. when the resource was concurrently modified
+type ConflictErr struct {
+	Method   string
+	Endpoint string
+	apiErr   ApiErr
+}
+
+func (e ConflictErr) Error() string {
+	return fmt.Sprintf("conflict on %s %s - %s", e.Method, e.Endpoint, e.apiErr.Error())
+}
+
+func (e ConflictErr) Code() int {
+	return e.apiErr.Code()
+}
+
+func (e ConflictErr) Unwrap() error {
+	return e.apiErr
+}
+
+// Is reports whether target is ErrConflict
+func (e ConflictErr) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// ErrResponseTooLarge is returned, wrapped in ApiErr, when a response body exceeds the limit set with SetMaxResponseSize
+var ErrResponseTooLarge = errors.New("3scale response body too large")
+
+// ErrRateLimited matches, using errors.Is, every RateLimitedErr
+var ErrRateLimited = errors.New("3scale rate limit exceeded")
+
+// RateLimitedErr is returned when 3scale answers 429 Too Many Requests
+type RateLimitedErr struct {
+	RateLimit RateLimit
+	apiErr    ApiErr
+}
+
+func (e RateLimitedErr) Error() string {
+	if e.RateLimit.RetryAfter >= 0 {
+		return fmt.Sprintf("rate limited, retry after %s - %s", e.RateLimit.RetryAfter, e.apiErr.Error())
+	}
+	return fmt.Sprintf("rate limited - %s", e.apiErr.Error())
+}
+
+func (e RateLimitedErr) Code() int {
+	return e.apiErr.Code()
+}
+
+func (e RateLimitedErr) Unwrap() error {
+	return e.apiErr
+}
+
+// Is reports whether target is ErrRateLimited
+func (e RateLimitedErr) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// BulkFailure is an item of a bulk operation that failed
+type BulkFailure struct {
+	ID  int64
+	Err error
+}
+
+// BulkErr is returned by bulk helpers, i.e. DeleteApplications, when some of the items failed
+type BulkErr struct {
+	// Total is the number of items of the bulk operation
+	Total    int
+	Failures []BulkFailure
+}
+
+func (e BulkErr) Error() string {
+	msgs := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		msgs = append(msgs, fmt.Sprintf("%d: %s", failure.ID, failure.Err.Error()))
+	}
+	return fmt.Sprintf("%d of %d operations failed - %s", len(e.Failures), e.Total, strings.Join(msgs, "; "))
+}
+
 // codeForError returns the HTTP status for a particular error.
 func codeForError(err error) int {
 	switch t := err.(type) {
 	case ApiErr:
 		return t.Code()
+	case ServiceSubscriptionRequiredErr:
+		return t.Code()
+	case ConflictErr:
+		return t.Code()
+	case RateLimitedErr:
+		return t.Code()
 	}
 	// Unknown
 	return -1
@@ -49,3 +250,97 @@ func IsUnauthorized(err error) bool {
 func IsForbidden(err error) bool {
 	return codeForError(err) == http.StatusForbidden
 }
+
+// IsConflict determines if err is an error which indicates that the request conflicts
+// with the current state of the resource.
+func IsConflict(err error) bool {
+	return codeForError(err) == http.StatusConflict
+}
+
+// IsRateLimited determines if err is an error which indicates that too many requests were sent.
+func IsRateLimited(err error) bool {
+	return codeForError(err) == http.StatusTooManyRequests
+}
+
+// IsServiceSubscriptionRequired determines if err is an error which indicates that the account
+// must be subscribed to the service before creating the application.
+func IsServiceSubscriptionRequired(err error) bool {
+	var subscriptionErr ServiceSubscriptionRequiredErr
+	return errors.As(err, &subscriptionErr)
+}
+
+// serviceSubscriptionRequiredMessage is the "base" error 3scale answers, with 422 Unprocessable Entity,
+// when the account of a new application is not subscribed to the service of the application plan
+const serviceSubscriptionRequiredMessage = "You need to subscribe to the service first"
+
+// serviceSubscriptionErr converts the error returned by 3scale when creating an application
+// for an account not subscribed to the service into ServiceSubscriptionRequiredErr
+func serviceSubscriptionErr(accountID, planID string, err error) error {
+	var apiErr ApiErr
+	if !errors.As(err, &apiErr) || apiErr.Code() != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	errObj := struct {
+		Errors map[string][]string `json:"errors"`
+	}{}
+	if json.Unmarshal([]byte(apiErr.Body()), &errObj) != nil {
+		return err
+	}
+
+	for _, msg := range errObj.Errors["base"] {
+		if strings.EqualFold(strings.TrimSuffix(strings.TrimSpace(msg), "."), serviceSubscriptionRequiredMessage) {
+			return ServiceSubscriptionRequiredErr{AccountID: accountID, PlanID: planID, apiErr: apiErr}
+		}
+	}
+	return err
+}
+
+// conflictErr converts the ApiErr of a 409 Conflict response into ConflictErr
+func conflictErr(resp *http.Response, err error) error {
+	apiErr, ok := err.(ApiErr)
+	if !ok || apiErr.Code() != http.StatusConflict {
+		return err
+	}
+
+	conflict := ConflictErr{apiErr: apiErr}
+	if resp.Request != nil {
+		conflict.Method = resp.Request.Method
+		conflict.Endpoint = resp.Request.URL.Path
+	}
+	return conflict
+}
+
+// rateLimitedErr converts the ApiErr of a 429 Too Many Requests response into RateLimitedErr
+func rateLimitedErr(resp *http.Response, err error) error {
+	apiErr, ok := err.(ApiErr)
+	if !ok || apiErr.Code() != http.StatusTooManyRequests {
+		return err
+	}
+
+	limit, _ := parseRateLimit(resp.Header, time.Now())
+	return RateLimitedErr{RateLimit: limit, apiErr: apiErr}
+}
+
+// ReferenceError is a reference to a resource missing from a TenantConfig
+type ReferenceError struct {
+	Kind  string
+	ID    int64
+	Field string
+	RefID int64
+}
+
+func (e ReferenceError) Error() string {
+	return fmt.Sprintf("%s %d: %s references missing id %d", e.Kind, e.ID, e.Field, e.RefID)
+}
+
+// ReferenceErrors holds every dangling reference found in a TenantConfig
+type ReferenceErrors []ReferenceError
+
+func (e ReferenceErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, refErr := range e {
+		msgs = append(msgs, refErr.Error())
+	}
+	return fmt.Sprintf("%d dangling references: %s", len(e), strings.Join(msgs, "; "))
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Custom fields defined by the provider are not known to the client types.
+// DeveloperAccountItem, DeveloperUserItem and Application keep them in their Extra map
+// when decoded, and send them along with the known fields when encoded.
+
+// UnmarshalJSON implements json.Unmarshaler, keeping the custom fields in Extra
+func (in *DeveloperAccountItem) UnmarshalJSON(data []byte) error {
+	type plain DeveloperAccountItem
+	return unmarshalWithExtra(data, (*plain)(in), &in.Extra)
+}
+
+// MarshalJSON implements json.Marshaler, adding the custom fields of Extra
+func (in DeveloperAccountItem) MarshalJSON() ([]byte, error) {
+	type plain DeveloperAccountItem
+	return marshalWithExtra(plain(in), in.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping the custom fields in Extra
+func (in *DeveloperUserItem) UnmarshalJSON(data []byte) error {
+	type plain DeveloperUserItem
+	return unmarshalWithExtra(data, (*plain)(in), &in.Extra)
+}
+
+// MarshalJSON implements json.Marshaler, adding the custom fields of Extra
+func (in DeveloperUserItem) MarshalJSON() ([]byte, error) {
+	type plain DeveloperUserItem
+	return marshalWithExtra(plain(in), in.Extra)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, keeping the custom fields in Extra
+func (in *Application) UnmarshalJSON(data []byte) error {
+	type plain Application
+	return unmarshalWithExtra(data, (*plain)(in), &in.Extra)
+}
+
+// MarshalJSON implements json.Marshaler, adding the custom fields of Extra
+func (in Application) MarshalJSON() ([]byte, error) {
+	type plain Application
+	return marshalWithExtra(plain(in), in.Extra)
+}
+
+// unmarshalWithExtra decodes data into obj, a pointer to struct,
+// storing the object members not matching any obj field into extra
+func unmarshalWithExtra(data []byte, obj interface{}, extra *map[string]interface{}) error {
+	if err := unmarshalLenient(data, obj); err != nil {
+		return err
+	}
+
+	members := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// keep numbers as sent, i.e. big IDs
+	decoder.UseNumber()
+	if err := decoder.Decode(&members); err != nil {
+		// not an object, i.e. null
+		*extra = nil
+		return nil
+	}
+
+	for _, name := range jsonFieldNames(reflect.TypeOf(obj).Elem()) {
+		delete(members, name)
+	}
+	if len(members) == 0 {
+		members = nil
+	}
+	*extra = members
+	return nil
+}
+
+// marshalWithExtra encodes obj adding the members of extra.
+// Known fields take precedence over extra members of the same name
+func marshalWithExtra(obj interface{}, extra map[string]interface{}) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	members := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	known := map[string]bool{}
+	for _, name := range jsonFieldNames(reflect.TypeOf(obj)) {
+		known[name] = true
+	}
+
+	for name, value := range extra {
+		if known[name] {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		members[name] = raw
+	}
+	return json.Marshal(members)
+}
+
+// jsonFieldNames returns the JSON member names of the fields of struct type t
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+		tag := field.Tag.Get("json")
+		if tag == "-" || field.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestDeveloperAccountItemExtraFields(t *testing.T) {
+	data := []byte(`{"id":1,"org_name":"ACME","vip":true,"crm_id":12345678901234567,"tags":["a","b"]}`)
+
+	item := DeveloperAccountItem{}
+	if err := json.Unmarshal(data, &item); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(1), *item.ID)
+	equals(t, "ACME", *item.OrgName)
+	equals(t, map[string]interface{}{
+		"vip":    true,
+		"crm_id": json.Number("12345678901234567"),
+		"tags":   []interface{}{"a", "b"},
+	}, item.Extra)
+
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, `{"crm_id":12345678901234567,"id":1,"org_name":"ACME","tags":["a","b"],"vip":true}`, string(out))
+}
+
+func TestExtraFieldsWithoutCustomFields(t *testing.T) {
+	item := DeveloperUserItem{}
+	if err := json.Unmarshal([]byte(`{"id":3,"username":"john"}`), &item); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, map[string]interface{}(nil), item.Extra)
+
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, `{"id":3,"username":"john"}`, string(out))
+}
+
+func TestExtraFieldsDoNotOverrideKnownFields(t *testing.T) {
+	app := Application{ID: 4, AppName: "app", Extra: map[string]interface{}{"name": "other", "department": "sales"}}
+
+	out, err := json.Marshal(app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := map[string]interface{}{}
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "app", decoded["name"])
+	equals(t, "sales", decoded["department"])
+
+	list := ApplicationList{}
+	if err := json.Unmarshal([]byte(`{"applications":[{"application":{"id":4,"name":"app","department":"sales"}}]}`), &list); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, map[string]interface{}{"department": "sales"}, list.Applications[0].Application.Extra)
+}
+
+func TestUpdateDeveloperAccountSendsExtraFields(t *testing.T) {
+	var accountID int64 = 1
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPut, Path: fmt.Sprintf(developerAccountResourceEndpoint, accountID)}.Assert(t, req)
+
+		bodyArr, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		equals(t, `{"id":1,"vip":"gold"}`, string(bodyArr))
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"account":{"id":1,"vip":"gold"}}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	account, err := c.UpdateDeveloperAccount(&DeveloperAccount{Element: DeveloperAccountItem{ID: &accountID, Extra: map[string]interface{}{"vip": "gold"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, map[string]interface{}{"vip": "gold"}, account.Element.Extra)
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestFakeFixturesDecodeJSON(t *testing.T) {
+	tests := []struct {
+		Name    string
+		fixture interface{ JSON() string }
+		into    interface{}
+	}{
+		{"account", fake.NewAccount(), &DeveloperAccount{}},
+		{"accountList", fake.NewAccountList(3), &DeveloperAccountList{}},
+		{"application", fake.NewApplication(), &ApplicationElem{}},
+		{"applicationList", fake.NewApplicationList(3), &ApplicationList{}},
+		{"applicationPlan", fake.NewApplicationPlan(), &ApplicationPlan{}},
+		{"applicationPlanList", fake.NewApplicationPlanList(3), &ApplicationPlanJSONList{}},
+		{"product", fake.NewProduct(), &Product{}},
+		{"productList", fake.NewProductList(3), &ProductList{}},
+		{"backendAPI", fake.NewBackendAPI(), &BackendApi{}},
+		{"backendAPIList", fake.NewBackendAPIList(3), &BackendApiList{}},
+		{"backendUsage", fake.NewBackendUsage(), &BackendAPIUsage{}},
+		{"backendUsageList", fake.NewBackendUsageList(3), &BackendAPIUsageList{}},
+		{"metric", fake.NewMetric(), &MetricJSON{}},
+		{"metricList", fake.NewMetricList(3), &MetricJSONList{}},
+		{"method", fake.NewMethod(), &Method{}},
+		{"methodList", fake.NewMethodList(3), &MethodList{}},
+		{"mappingRule", fake.NewMappingRule(), &MappingRuleJSON{}},
+		{"mappingRuleList", fake.NewMappingRuleList(3), &MappingRuleJSONList{}},
+		{"proxy", fake.NewProxy(), &ProxyJSON{}},
+		{"limit", fake.NewLimit(), &ApplicationPlanLimit{}},
+		{"limitList", fake.NewLimitList(3), &ApplicationPlanLimitList{}},
+		{"pricingRule", fake.NewPricingRule(), &ApplicationPlanPricingRule{}},
+		{"pricingRuleList", fake.NewPricingRuleList(3), &ApplicationPlanPricingRuleList{}},
+		{"developerUser", fake.NewDeveloperUser(), &DeveloperUser{}},
+		{"developerUserList", fake.NewDeveloperUserList(3), &DeveloperUserList{}},
+		{"activeDoc", fake.NewActiveDoc(), &ActiveDoc{}},
+		{"activeDocList", fake.NewActiveDocList(3), &ActiveDocList{}},
+		{"feature", fake.NewFeature(), &Feature{}},
+		{"featureList", fake.NewFeatureList(3), &FeatureList{}},
+		{"invoice", fake.NewInvoice(), &Invoice{}},
+		{"invoiceList", fake.NewInvoiceList(3), &InvoiceList{}},
+		{"alert", fake.NewAlert(), &Alert{}},
+		{"alertList", fake.NewAlertList(3), &AlertList{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subTest *testing.T) {
+			if err := json.Unmarshal([]byte(tt.fixture.JSON()), tt.into); err != nil {
+				subTest.Fatal(err)
+			}
+
+			// a wrapping key not matching the client type leaves the object empty
+			empty := reflect.New(reflect.TypeOf(tt.into).Elem()).Interface()
+			if reflect.DeepEqual(empty, tt.into) {
+				subTest.Fatalf("fixture %s decoded into empty %T", tt.fixture.JSON(), tt.into)
+			}
+		})
+	}
+}
+
+func TestFakeFixturesOptions(t *testing.T) {
+	list := fake.NewApplicationList(2, fake.WithAttr("service_id", 7), fake.WithoutAttr("description"))
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return list.Response(http.StatusOK)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	apps, err := c.ListApplications(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(apps.Applications))
+	equals(t, int64(2), apps.Applications[1].Application.ID)
+	equals(t, int64(7), apps.Applications[0].Application.ServiceID)
+	equals(t, "", apps.Applications[0].Application.Description)
+
+	app := &ApplicationElem{}
+	if err := json.Unmarshal([]byte(fake.NewApplication(fake.WithID(42)).JSON()), app); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(42), app.Application.ID)
+}
+
+func TestFakeFixturesXML(t *testing.T) {
+	metrics := &MetricList{}
+	if err := xml.Unmarshal([]byte(fake.NewMetricList(2).XML()), metrics); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 2, len(metrics.Metrics))
+	equals(t, "metric_2", metrics.Metrics[1].SystemName)
+
+	plans := &ApplicationPlansList{}
+	if err := xml.Unmarshal([]byte(fake.NewApplicationPlanList(1, fake.WithAttr("name", "Gold & Silver")).XML()), plans); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "Gold & Silver", plans.Plans[0].PlanName)
+}
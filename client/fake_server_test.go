@@ -0,0 +1,123 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func newFakeServerClient(t *testing.T, server *fake.Server, token string) *ThreeScaleClient {
+	t.Helper()
+	ap, err := NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewThreeScale(ap, token, server.Client())
+}
+
+func TestFakeServerLifecycle(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	c := newFakeServerClient(t, server, "someAccessToken")
+
+	product, err := c.CreateProduct("My API", Params{"description": "desc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "my_api", product.Element.SystemName)
+
+	if _, err := c.CreateProduct("Other", Params{"system_name": "my_api"}); err == nil {
+		t.Fatal("expected duplicated system_name error")
+	}
+
+	plan, err := c.CreateApplicationPlan(product.Element.ID, Params{"name": "Basic", "setup_fee": "1.5", "state_event": "publish"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "published", plan.Element.State)
+	equals(t, 1.5, plan.Element.SetupFee)
+
+	account, err := c.Signup(Params{"org_name": "ACME", "username": "john", "email": "john@example.com", "password": "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountID := *account.Element.ID
+
+	found, err := c.FindAccount("john")
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, accountID, found.ID)
+
+	app, err := c.Applications().Create(accountID, plan.Element.ID, "app", "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, product.Element.ID, app.ServiceID)
+	equals(t, ApplicationStateLive, app.AppState())
+
+	if _, err := c.UpdateApplication(accountID, app.ID, Params{"description": "updated"}); err != nil {
+		t.Fatal(err)
+	}
+	read, err := c.Application(accountID, app.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "updated", read.Description)
+
+	if err := c.DeleteDeveloperAccount(accountID); err != nil {
+		t.Fatal(err)
+	}
+	apps, err := c.ListAllApplications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 0, len(apps.Applications))
+
+	if _, err := c.DeveloperAccount(accountID); !IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+}
+
+func TestFakeServerPagination(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	c := newFakeServerClient(t, server, "someAccessToken")
+
+	for _, name := range []string{"one", "two", "three"} {
+		if _, err := c.CreateProduct(name, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	page, err := c.ListProductsPerPage(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, len(page.Products))
+	equals(t, "three", page.Products[0].Element.Name)
+
+	all, err := c.ListProducts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 3, len(all.Products))
+}
+
+func TestFakeServerAccessToken(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	server.AccessToken = "valid"
+
+	_, err := newFakeServerClient(t, server, "invalid").ListProducts()
+	apiErr, ok := err.(ApiErr)
+	if !ok {
+		t.Fatalf("expected ApiErr; got %v", err)
+	}
+	equals(t, http.StatusForbidden, apiErr.Code())
+
+	if _, err := newFakeServerClient(t, server, "valid").ListProducts(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	appPlanFeatureListResourceEndpoint = "/admin/api/application_plans/%d/features.json"
+	serviceFeatureListResourceEndpoint = "/admin/api/services/%d/features.json"
+	serviceFeatureResourceEndpoint     = "/admin/api/services/%d/features/%d.json"
+)
+
+// Plan types a service feature can be enabled on, sent as the "scope" param
+const (
+	FeatureScopeApplicationPlan = "ApplicationPlan"
+	FeatureScopeServicePlan     = "ServicePlan"
+)
+
+// ListApplicationPlanFeatures List features enabled on a given application plan
+func (c *ThreeScaleClient) ListApplicationPlanFeatures(planID int64, opts ...ListOptions) (*FeatureList, error) {
+	endpoint := fmt.Sprintf(appPlanFeatureListResourceEndpoint, planID)
+
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	applyListOptions(req, opts...)
+
+	return doReq[FeatureList](c, req, http.StatusOK)
+}
+
+// ListServiceFeatures List the features defined on a given product, whatever the plans enabling them
+func (c *ThreeScaleClient) ListServiceFeatures(productID int64, opts ...ListOptions) (*FeatureList, error) {
+	endpoint := fmt.Sprintf(serviceFeatureListResourceEndpoint, productID)
+
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	applyListOptions(req, opts...)
+
+	return doReq[FeatureList](c, req, http.StatusOK)
+}
+
+// CreateServiceFeature Create a feature on a given product. Valid params keys and their purpose are as follows:
+// "name"        - Name of the feature. Required
+// "system_name" - System name of the feature
+// "description" - Description of the feature
+// "scope"       - Plan type the feature is meant for, one of the FeatureScope constants. Defaults to ApplicationPlan
+func (c *ThreeScaleClient) CreateServiceFeature(productID int64, params Params) (*Feature, error) {
+	endpoint := fmt.Sprintf(serviceFeatureListResourceEndpoint, productID)
+
+	return doPost[Feature](c, endpoint, params)
+}
+
+// ServiceFeature Read a feature of a given product
+func (c *ThreeScaleClient) ServiceFeature(productID, featureID int64) (*Feature, error) {
+	endpoint := fmt.Sprintf(serviceFeatureResourceEndpoint, productID, featureID)
+
+	return doGet[Feature](c, endpoint)
+}
+
+// UpdateServiceFeature Update a feature of a given product. Valid params keys are "name", "description" and "scope"
+func (c *ThreeScaleClient) UpdateServiceFeature(productID, featureID int64, params Params) (*Feature, error) {
+	endpoint := fmt.Sprintf(serviceFeatureResourceEndpoint, productID, featureID)
+
+	return doPut[Feature](c, endpoint, params)
+}
+
+// DeleteServiceFeature Delete a feature of a given product, disabling it on every plan
+func (c *ThreeScaleClient) DeleteServiceFeature(productID, featureID int64) error {
+	endpoint := fmt.Sprintf(serviceFeatureResourceEndpoint, productID, featureID)
+
+	return doDelete(c, endpoint)
+}
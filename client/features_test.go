@@ -0,0 +1,125 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestListApplicationPlanFeatures(t *testing.T) {
+	var (
+		planID   int64 = 97
+		endpoint       = fmt.Sprintf(appPlanFeatureListResourceEndpoint, planID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodGet {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
+		}
+
+		list := FeatureList{
+			Features: []Feature{
+				{Element: FeatureItem{ID: 1, Name: "Feature 1", SystemName: "feature1", Scope: "application_plan"}},
+				{Element: FeatureItem{ID: 2, Name: "Feature 2", SystemName: "feature2", Scope: "application_plan"}},
+			},
+		}
+
+		responseBodyBytes, err := json.Marshal(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(responseBodyBytes)),
+			Header:     make(http.Header),
+		}
+	})
+
+	credential := "someAccessToken"
+	c := NewThreeScale(NewTestAdminPortal(t), credential, httpClient)
+	list, err := c.ListApplicationPlanFeatures(planID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if list == nil {
+		t.Fatal("list returned nil")
+	}
+
+	if len(list.Features) != 2 {
+		t.Fatalf("# list items does not match. Expected [%d]; got [%d]", 2, len(list.Features))
+	}
+}
+
+func TestServiceFeaturesCRUD(t *testing.T) {
+	var (
+		productID int64 = 12
+		featureID int64 = 5
+		listPath        = fmt.Sprintf(serviceFeatureListResourceEndpoint, productID)
+		itemPath        = fmt.Sprintf(serviceFeatureResourceEndpoint, productID, featureID)
+		feature         = Feature{Element: FeatureItem{ID: featureID, Name: "SLA", SystemName: "sla", Scope: "service_plan"}}
+	)
+
+	tests := []struct {
+		Name      string
+		assertion fake.RequestAssertion
+		status    int
+		call      func(c *ThreeScaleClient) error
+	}{
+		{"list", fake.RequestAssertion{Method: http.MethodGet, Path: listPath}, http.StatusOK, func(c *ThreeScaleClient) error {
+			list, err := c.ListServiceFeatures(productID)
+			if err == nil {
+				equals(t, 1, len(list.Features))
+			}
+			return err
+		}},
+		{"create", fake.RequestAssertion{Method: http.MethodPost, Path: listPath, Form: map[string]string{"name": "SLA", "scope": FeatureScopeServicePlan}}, http.StatusCreated, func(c *ThreeScaleClient) error {
+			created, err := c.CreateServiceFeature(productID, Params{"name": "SLA", "scope": FeatureScopeServicePlan})
+			if err == nil {
+				equals(t, featureID, created.Element.ID)
+			}
+			return err
+		}},
+		{"read", fake.RequestAssertion{Method: http.MethodGet, Path: itemPath}, http.StatusOK, func(c *ThreeScaleClient) error {
+			read, err := c.ServiceFeature(productID, featureID)
+			if err == nil {
+				equals(t, "sla", read.Element.SystemName)
+			}
+			return err
+		}},
+		{"update", fake.RequestAssertion{Method: http.MethodPut, Path: itemPath, Form: map[string]string{"description": "99.9%"}}, http.StatusOK, func(c *ThreeScaleClient) error {
+			_, err := c.UpdateServiceFeature(productID, featureID, Params{"description": "99.9%"})
+			return err
+		}},
+		{"delete", fake.RequestAssertion{Method: http.MethodDelete, Path: itemPath}, http.StatusOK, func(c *ThreeScaleClient) error {
+			return c.DeleteServiceFeature(productID, featureID)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				tt.assertion.Assert(subT, req)
+				if tt.Name == "list" {
+					return jsonTestResponse(subT, tt.status, FeatureList{Features: []Feature{feature}})
+				}
+				return jsonTestResponse(subT, tt.status, feature)
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", httpClient)
+			if err := tt.call(c); err != nil {
+				subT.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GatewayConfiguration is the configuration a self-managed APIcast of an environment boots from:
+// the latest proxy config of every product deployed to that environment, sorted by product ID
+type GatewayConfiguration struct {
+	Environment string
+	Services    []ProxyConfig
+}
+
+// StagingGatewayConfiguration returns the configuration loaded by staging APIcast gateways
+func (c *ThreeScaleClient) StagingGatewayConfiguration() (*GatewayConfiguration, error) {
+	return c.GatewayConfiguration(ProxyConfigEnvSandbox)
+}
+
+// ProductionGatewayConfiguration returns the configuration loaded by production APIcast gateways
+func (c *ThreeScaleClient) ProductionGatewayConfiguration() (*GatewayConfiguration, error) {
+	return c.GatewayConfiguration(ProxyConfigEnvProduction)
+}
+
+// GatewayConfiguration returns the configuration loaded by APIcast gateways of the environment.
+// env parameter should be one of 'sandbox', 'production'
+func (c *ThreeScaleClient) GatewayConfiguration(env string) (*GatewayConfiguration, error) {
+	if err := validateProxyConfigEnv(env); err != nil {
+		return nil, err
+	}
+
+	version := "latest"
+	list, err := c.ListAccountProxyConfigs(env, &version, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := map[int64]ProxyConfig{}
+	for _, element := range list.ProxyConfigs {
+		config := element.ProxyConfig
+		if current, ok := latest[config.Content.ID]; !ok || config.Version > current.Version {
+			latest[config.Content.ID] = config
+		}
+	}
+
+	gateway := &GatewayConfiguration{Environment: env, Services: make([]ProxyConfig, 0, len(latest))}
+	for _, config := range latest {
+		gateway.Services = append(gateway.Services, config)
+	}
+	sort.Slice(gateway.Services, func(i, j int) bool {
+		return gateway.Services[i].Content.ID < gateway.Services[j].Content.ID
+	})
+	return gateway, nil
+}
+
+// Service returns the proxy config of the product, nil when the product is not part of the configuration
+func (g *GatewayConfiguration) Service(productID int64) *ProxyConfig {
+	for idx := range g.Services {
+		if g.Services[idx].Content.ID == productID {
+			return &g.Services[idx]
+		}
+	}
+	return nil
+}
+
+// Validate reports the problems APIcast would hit serving the configuration:
+// products without hosts or backend endpoint, which cannot be routed, and hosts claimed by several products,
+// served by whichever product APIcast loads first
+func (g *GatewayConfiguration) Validate() error {
+	var problems []string
+	hostOwners := map[string]int64{}
+
+	for _, config := range g.Services {
+		productID := config.Content.ID
+		proxy := config.Content.Proxy
+		if len(proxy.Hosts) == 0 {
+			problems = append(problems, fmt.Sprintf("product %d has no hosts", productID))
+		}
+		if proxy.Backend.Endpoint == "" {
+			problems = append(problems, fmt.Sprintf("product %d has no backend endpoint", productID))
+		}
+		for _, host := range proxy.Hosts {
+			if owner, ok := hostOwners[host]; ok && owner != productID {
+				problems = append(problems, fmt.Sprintf("host %q is served by products %d and %d", host, owner, productID))
+				continue
+			}
+			hostOwners[host] = productID
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid %s gateway configuration: %s", g.Environment, strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func gatewayProxyConfig(productID int64, version int, env string, hosts ...string) ProxyConfigElement {
+	return ProxyConfigElement{ProxyConfig: ProxyConfig{
+		Version:     version,
+		Environment: env,
+		Content: Content{ID: productID, Proxy: ContentProxy{
+			Hosts:   hosts,
+			Backend: Backend{Endpoint: "https://su1.3scale.net"},
+		}},
+	}}
+}
+
+func TestStagingGatewayConfiguration(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   fmt.Sprintf(accountProxyConfigGet, ProxyConfigEnvSandbox),
+			Query:  map[string]string{"version": "latest"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, ProxyConfigList{ProxyConfigs: []ProxyConfigElement{
+			gatewayProxyConfig(7, 3, ProxyConfigEnvSandbox, "b.example.com"),
+			gatewayProxyConfig(2, 1, ProxyConfigEnvSandbox, "a.example.com"),
+			gatewayProxyConfig(7, 4, ProxyConfigEnvSandbox, "b.example.com"),
+		}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	gateway, err := c.StagingGatewayConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, ProxyConfigEnvSandbox, gateway.Environment)
+	equals(t, 2, len(gateway.Services))
+	equals(t, int64(2), gateway.Services[0].Content.ID)
+	equals(t, 4, gateway.Service(7).Version)
+	if gateway.Service(9) != nil {
+		t.Fatal("expected no config for unknown product")
+	}
+	equals(t, nil, gateway.Validate())
+}
+
+func TestGatewayConfigurationInvalidEnv(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		t.Fatalf("unexpected request to %s", req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if _, err := c.GatewayConfiguration("staging"); err == nil {
+		t.Fatal("expected invalid environment error")
+	}
+}
+
+func TestGatewayConfigurationValidate(t *testing.T) {
+	noBackend := gatewayProxyConfig(3, 1, ProxyConfigEnvProduction, "c.example.com").ProxyConfig
+	noBackend.Content.Proxy.Backend.Endpoint = ""
+
+	gateway := &GatewayConfiguration{Environment: ProxyConfigEnvProduction, Services: []ProxyConfig{
+		gatewayProxyConfig(1, 1, ProxyConfigEnvProduction, "a.example.com").ProxyConfig,
+		gatewayProxyConfig(2, 1, ProxyConfigEnvProduction, "a.example.com", "b.example.com").ProxyConfig,
+		noBackend,
+		gatewayProxyConfig(4, 1, ProxyConfigEnvProduction).ProxyConfig,
+	}}
+
+	err := gateway.Validate()
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, problem := range []string{
+		`host "a.example.com" is served by products 1 and 2`,
+		"product 3 has no backend endpoint",
+		"product 4 has no hosts",
+	} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Fatalf("expected %q in %q", problem, err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"net/http"
+)
+
+// WithHeaders returns a client sending header, e.g. X-Request-ID or audit headers, on top of
+// the headers set by the client on every request:
+//
+//	c.WithHeaders(http.Header{"X-Request-Id": {requestID}}).CreateProduct(name, params)
+//
+// The returned client sends its requests through c, sharing its configuration, credentials and hooks.
+// Configuration changes must be made on c. Headers of nested WithHeaders calls are merged, the latest winning
+func (c *ThreeScaleClient) WithHeaders(header http.Header) *ThreeScaleClient {
+	derived := c.derive()
+	derived.header = c.header.Clone()
+	if derived.header == nil {
+		derived.header = http.Header{}
+	}
+	for key, values := range header {
+		derived.header[http.CanonicalHeaderKey(key)] = append([]string{}, values...)
+	}
+	return derived
+}
+
+// derive returns a copy of c sending its requests through the root client
+func (c *ThreeScaleClient) derive() *ThreeScaleClient {
+	derived := *c
+	derived.parent = c
+	if c.parent != nil {
+		derived.parent = c.parent
+	}
+	return &derived
+}
+
+// doDerivedRequest adds the derived client headers and sends the request through the parent client
+func (c *ThreeScaleClient) doDerivedRequest(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "" {
+		// the credential may have changed on the parent since the client was derived
+		req.Header.Set("Authorization", "Basic "+basicAuth("", c.parent.credential))
+	}
+	for key, values := range c.header {
+		req.Header[key] = append([]string{}, values...)
+	}
+	if c.timeout > 0 {
+		return c.doTimedRequest(req)
+	}
+	return c.parent.doRequest(req)
+}
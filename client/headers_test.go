@@ -0,0 +1,48 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestWithHeaders(t *testing.T) {
+	var requests []*http.Request
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests = append(requests, req)
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.EnableDebugHistory(10)
+
+	traced := c.WithHeaders(http.Header{"x-request-id": {"abc"}, "X-Audit": {"first"}})
+	if _, err := traced.WithHeaders(http.Header{"X-Audit": {"second"}}).ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(requests))
+	equals(t, "abc", requests[0].Header.Get("X-Request-Id"))
+	equals(t, []string{"second"}, requests[0].Header["X-Audit"])
+	equals(t, "", requests[1].Header.Get("X-Request-Id"))
+
+	// the derived client shares the parent debug history
+	equals(t, 2, len(c.DebugHistory()))
+}
+
+func TestWithHeadersParentCredential(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		equals(t, "Basic "+basicAuth("", "newAccessToken"), req.Header.Get("Authorization"))
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	traced := c.WithHeaders(http.Header{"X-Request-Id": {"abc"}})
+	c.SetCredentials("newAccessToken")
+
+	if _, err := traced.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -27,3 +29,26 @@ func fetchBasicAuthHeader(req *http.Request) (string, error) {
 
 	return auth[1], nil
 }
+
+func jsonTestResponse(t *testing.T, statusCode int, obj interface{}) *http.Response {
+	t.Helper()
+	responseBodyBytes, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       ioutil.NopCloser(bytes.NewBuffer(responseBodyBytes)),
+		Header:     make(http.Header),
+	}
+}
+
+// failingTransport fails every request with err, as a connection failure would
+type failingTransport struct {
+	err error
+}
+
+func (f failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, f.err
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSetHTTPProxy(t *testing.T) {
+	var proxied *http.Request
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer proxy.Close()
+
+	ap, err := NewAdminPortalFromStr("http://3scale-admin.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewThreeScale(ap, "someAccessToken", &http.Client{Transport: &http.Transport{}})
+
+	proxyURL := "http://user:secret@" + proxy.Listener.Addr().String()
+	if err := c.SetHTTPProxy(proxyURL); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+
+	if proxied == nil {
+		t.Fatal("request did not go through the proxy")
+	}
+	equals(t, "3scale-admin.example.com", proxied.Host)
+	equals(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:secret")), proxied.Header.Get("Proxy-Authorization"))
+}
+
+func TestSetHTTPProxyInvalid(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+
+	for _, proxyURL := range []string{"ftp://proxy.corp", "http://", "://proxy"} {
+		if err := c.SetHTTPProxy(proxyURL); err == nil {
+			t.Fatalf("proxy URL %q: expected error", proxyURL)
+		}
+	}
+}
+
+func TestSetHTTPProxyCustomTransport(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.SetHTTPProxy("http://proxy.corp:3128"); err == nil {
+		t.Fatal("expected unsupported transport error")
+	}
+}
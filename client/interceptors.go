@@ -0,0 +1,55 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Interceptor wraps the transport sending the client requests, e.g. to add caching, telemetry or fault injection:
+//
+//	func(next http.RoundTripper) http.RoundTripper {
+//		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+//			start := time.Now()
+//			resp, err := next.RoundTrip(req)
+//			observe(req, time.Since(start))
+//			return resp, err
+//		})
+//	}
+type Interceptor func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts a function to http.RoundTripper
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req)
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Use registers interceptors around the transport of the http client.
+// Interceptors run in registration order, the first registered seeing the request first and the response last.
+// The http client passed to NewThreeScale is not modified
+func (c *ThreeScaleClient) Use(interceptors ...Interceptor) {
+	if len(interceptors) == 0 {
+		return
+	}
+
+	if c.interceptors == nil {
+		c.baseTransport = c.httpClient.Transport
+		if c.baseTransport == nil {
+			c.baseTransport = http.DefaultTransport
+		}
+	}
+	c.interceptors = append(c.interceptors, interceptors...)
+	c.rebuildTransport()
+}
+
+// rebuildTransport wraps baseTransport with the registered interceptors
+func (c *ThreeScaleClient) rebuildTransport() {
+	transport := c.baseTransport
+	for idx := len(c.interceptors) - 1; idx >= 0; idx-- {
+		transport = c.interceptors[idx](transport)
+	}
+
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestInterceptorsOrder(t *testing.T) {
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				resp, err := next.RoundTrip(req)
+				calls = append(calls, name+" response")
+				return resp, err
+			})
+		}
+	}
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		calls = append(calls, "transport")
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.Use(record("first"), record("second"))
+	c.Use(record("third"))
+
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, []string{
+		"first request", "second request", "third request",
+		"transport",
+		"third response", "second response", "first response",
+	}, calls)
+
+	// the caller http client is left untouched
+	if _, wrapped := httpClient.Transport.(RoundTripperFunc); wrapped {
+		t.Fatal("caller http client transport was replaced")
+	}
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		t.Fatal("request must not reach the transport")
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.Use(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("chaos")
+		})
+	})
+
+	if _, err := c.ListProductsPerPage(); err == nil {
+		t.Fatal("expected error")
+	}
+}
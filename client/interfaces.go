@@ -0,0 +1,157 @@
+package client
+
+// Resource scoped views of ThreeScaleClient.
+// Code depending on one of these interfaces instead of *ThreeScaleClient
+// can be unit tested with a stub implementing only the resource it uses.
+
+// AccountsAPI Developer accounts management
+type AccountsAPI interface {
+	ListDeveloperAccounts(opts ...ListOptions) (*DeveloperAccountList, error)
+	ListDeveloperAccountsPerPage(paginationValues ...int) (*DeveloperAccountList, error)
+	ListDeveloperAccountsFiltered(filterParams Params, opts ...ListOptions) (*DeveloperAccountList, error)
+	ListDeveloperAccountsFilteredPerPage(filterParams Params, paginationValues ...int) (*DeveloperAccountList, error)
+	DeveloperAccount(accountID int64) (*DeveloperAccount, error)
+	FindAccount(username string) (*Account, error)
+	Signup(params Params) (*DeveloperAccount, error)
+	UpdateDeveloperAccount(account *DeveloperAccount) (*DeveloperAccount, error)
+	ApproveDeveloperAccount(id int64) (*DeveloperAccount, error)
+	DeleteDeveloperAccount(id int64) error
+	ApprovePendingAccounts() ([]AccountApprovalResult, error)
+	EnsureAccount(username string, params Params) (*DeveloperAccount, error)
+}
+
+// ApplicationsAPI Applications management
+type ApplicationsAPI interface {
+	ListApplications(accountID int64, opts ...ListOptions) (*ApplicationList, error)
+	ListAllApplications(opts ...ListOptions) (*ApplicationList, error)
+	ListAllApplicationsPerPage(paginationValues ...int) (*ApplicationList, error)
+	ListApplicationsFiltered(accountID int64, filterParams Params, opts ...ListOptions) (*ApplicationList, error)
+	ListAllApplicationsFiltered(filterParams Params, opts ...ListOptions) (*ApplicationList, error)
+	ListAllApplicationsFilteredPerPage(filterParams Params, paginationValues ...int) (*ApplicationList, error)
+	Application(accountID, id int64) (*Application, error)
+	CreateApp(accountID, planID, name, description string) (Application, error)
+	CreateApplication(accountID, planID int64, name string, params Params) (*Application, error)
+	CreateAppWithServiceSubscription(accountID, productID, planID int64, name, description string) (Application, error)
+	UpdateApplication(accountID, id int64, params Params) (*Application, error)
+	DeleteApplication(accountID, id int64) error
+	ChangeApplicationPlan(accountID, id, planID int64) (*Application, error)
+	CreateApplicationCustomPlan(accountID, id int64) (*ApplicationPlanItem, error)
+	DeleteApplicationCustomPlan(accountID, id int64) error
+	ApplicationSuspend(accountID, id int64) (*Application, error)
+	ApplicationResume(accountID, id int64) (*Application, error)
+	EnsureApplication(accountID, planID int64, name string, params Params) (*Application, error)
+}
+
+// ApplicationPlansAPI Application plans management
+type ApplicationPlansAPI interface {
+	ListApplicationPlansByProduct(productID int64, opts ...ListOptions) (*ApplicationPlanJSONList, error)
+	ListAllApplicationPlans(opts ...ListOptions) (*ApplicationPlanJSONList, error)
+	ApplicationPlan(productID, id int64) (*ApplicationPlan, error)
+	CreateApplicationPlan(productID int64, params Params) (*ApplicationPlan, error)
+	UpdateApplicationPlan(productID, id int64, params Params) (*ApplicationPlan, error)
+	DeleteApplicationPlan(productID, id int64) error
+	EnsureApplicationPlan(productID int64, systemName string, params Params) (*ApplicationPlan, error)
+}
+
+// ProductsAPI Products management, including their methods, metrics and mapping rules
+type ProductsAPI interface {
+	ListProducts(opts ...ListOptions) (*ProductList, error)
+	ListProductsPerPage(paginationValues ...int) (*ProductList, error)
+	Product(id int64) (*Product, error)
+	CreateProduct(name string, params Params) (*Product, error)
+	UpdateProduct(id int64, params Params) (*Product, error)
+	DeleteProduct(id int64) error
+
+	ListProductMethods(productID, hitsID int64, opts ...ListOptions) (*MethodList, error)
+	ProductMethod(productID, hitsID, methodID int64) (*Method, error)
+	CreateProductMethod(productID, hitsID int64, params Params) (*Method, error)
+	UpdateProductMethod(productID, hitsID, methodID int64, params Params) (*Method, error)
+	DeleteProductMethod(productID, hitsID, methodID int64) error
+
+	ListProductMetrics(productID int64, opts ...ListOptions) (*MetricJSONList, error)
+	ProductMetric(productID, metricID int64) (*MetricJSON, error)
+	CreateProductMetric(productID int64, params Params) (*MetricJSON, error)
+	UpdateProductMetric(productID, metricID int64, params Params) (*MetricJSON, error)
+	DeleteProductMetric(productID, metricID int64) error
+
+	ListProductMappingRules(productID int64, opts ...ListOptions) (*MappingRuleJSONList, error)
+	ProductMappingRule(productID, itemID int64) (*MappingRuleJSON, error)
+	CreateProductMappingRule(productID int64, params Params) (*MappingRuleJSON, error)
+	UpdateProductMappingRule(productID, itemID int64, params Params) (*MappingRuleJSON, error)
+	DeleteProductMappingRule(productID, itemID int64) error
+}
+
+// ProxiesAPI Product proxy and proxy configurations management
+type ProxiesAPI interface {
+	ProductProxy(productID int64) (*ProxyJSON, error)
+	UpdateProductProxy(productID int64, params Params) (*ProxyJSON, error)
+	DeployProductProxy(productID int64) (*ProxyJSON, error)
+
+	GetProxyConfig(svcID string, env string, version string) (ProxyConfigElement, error)
+	GetLatestProxyConfig(svcID string, env string) (ProxyConfigElement, error)
+	ListProxyConfig(svcID string, env string, opts ...ListOptions) (ProxyConfigList, error)
+	ListProxyConfigPerPage(svcID string, env string, paginationValues ...int) (ProxyConfigList, error)
+	PromoteProxyConfig(svcID string, env string, version string, toEnv string) (ProxyConfigElement, error)
+	ListAccountProxyConfigs(env string, version, host *string, opts ...ListOptions) (*ProxyConfigList, error)
+	ListAccountProxyConfigsPerPage(env string, version, host *string, paginationValues ...int) (*ProxyConfigList, error)
+}
+
+// BackendsAPI Backend APIs management, including their methods, metrics, mapping rules and usages
+type BackendsAPI interface {
+	ListBackendApis(opts ...ListOptions) (*BackendApiList, error)
+	ListBackendApisPerPage(paginationValues ...int) (*BackendApiList, error)
+	BackendApi(id int64) (*BackendApi, error)
+	CreateBackendApi(params Params) (*BackendApi, error)
+	UpdateBackendApi(id int64, params Params) (*BackendApi, error)
+	DeleteBackendApi(id int64) error
+
+	ListBackendapiMethods(backendapiID, hitsID int64, opts ...ListOptions) (*MethodList, error)
+	ListBackendapiMethodsPerPage(backendapiID, hitsID int64, paginationValues ...int) (*MethodList, error)
+	BackendApiMethod(backendapiID, hitsID, methodID int64) (*Method, error)
+	CreateBackendApiMethod(backendapiID, hitsID int64, params Params) (*Method, error)
+	UpdateBackendApiMethod(backendapiID, hitsID, methodID int64, params Params) (*Method, error)
+	DeleteBackendApiMethod(backendapiID, hitsID, methodID int64) error
+
+	ListBackendapiMetrics(backendapiID int64, opts ...ListOptions) (*MetricJSONList, error)
+	ListBackendapiMetricsPerPage(backendapiID int64, paginationValues ...int) (*MetricJSONList, error)
+	BackendApiMetric(backendapiID, metricID int64) (*MetricJSON, error)
+	CreateBackendApiMetric(backendapiID int64, params Params) (*MetricJSON, error)
+	UpdateBackendApiMetric(backendapiID, metricID int64, params Params) (*MetricJSON, error)
+	DeleteBackendApiMetric(backendapiID, metricID int64) error
+
+	ListBackendapiMappingRules(backendapiID int64, opts ...ListOptions) (*MappingRuleJSONList, error)
+	ListBackendapiMappingRulesPerPage(backendapiID int64, paginationValues ...int) (*MappingRuleJSONList, error)
+	BackendapiMappingRule(backendapiID, mrID int64) (*MappingRuleJSON, error)
+	CreateBackendapiMappingRule(backendapiID int64, params Params) (*MappingRuleJSON, error)
+	UpdateBackendapiMappingRule(backendapiID, mrID int64, params Params) (*MappingRuleJSON, error)
+	DeleteBackendapiMappingRule(backendapiID, mrID int64) error
+
+	ListBackendapiUsages(productID int64, opts ...ListOptions) (BackendAPIUsageList, error)
+	BackendapiUsage(productID, backendUsageID int64) (*BackendAPIUsage, error)
+	CreateBackendapiUsage(productID int64, params Params) (*BackendAPIUsage, error)
+	UpdateBackendapiUsage(productID, backendUsageID int64, params Params) (*BackendAPIUsage, error)
+	DeleteBackendapiUsage(productID, backendUsageID int64) error
+}
+
+// ServicesAPI Services management
+type ServicesAPI interface {
+	ListServices(opts ...ListOptions) (ServiceList, error)
+	ListServicesPerPage(paginationValues ...int) (ServiceList, error)
+	FindServiceBySystemName(systemName string) (*Service, error)
+	CreateService(name string) (Service, error)
+	UpdateService(id string, params Params) (Service, error)
+	DeleteService(id string) error
+}
+
+// ThreeScaleAPI Aggregates every resource scoped interface implemented by ThreeScaleClient
+type ThreeScaleAPI interface {
+	AccountsAPI
+	ApplicationsAPI
+	ApplicationPlansAPI
+	ProductsAPI
+	ProxiesAPI
+	BackendsAPI
+	ServicesAPI
+}
+
+var _ ThreeScaleAPI = &ThreeScaleClient{}
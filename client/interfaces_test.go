@@ -0,0 +1,47 @@
+package client
+
+import (
+	"testing"
+)
+
+// applicationsStub implements only the ApplicationsAPI methods exercised by the test,
+// calling any other method panics on the nil embedded interface.
+type applicationsStub struct {
+	ApplicationsAPI
+	apps map[int64]ApplicationList
+}
+
+func (s *applicationsStub) ListApplications(accountID int64, opts ...ListOptions) (*ApplicationList, error) {
+	list := s.apps[accountID]
+	return &list, nil
+}
+
+func countLiveApplications(api ApplicationsAPI, accountID int64) (int, error) {
+	list, err := api.ListApplications(accountID)
+	if err != nil {
+		return 0, err
+	}
+
+	live := 0
+	for _, app := range list.Applications {
+		if app.Application.AppState() == ApplicationStateLive {
+			live++
+		}
+	}
+	return live, nil
+}
+
+func TestApplicationsAPIStub(t *testing.T) {
+	stub := &applicationsStub{apps: map[int64]ApplicationList{
+		3: {Applications: []ApplicationElem{
+			{Application: Application{ID: 1, State: "live"}},
+			{Application: Application{ID: 2, State: "suspended"}},
+		}},
+	}}
+
+	live, err := countLiveApplications(stub, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, live)
+}
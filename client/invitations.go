@@ -0,0 +1,54 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	providerInvitationListResourceEndpoint = "/admin/api/invitations.json"
+	providerInvitationResourceEndpoint     = "/admin/api/invitations/%d.json"
+)
+
+// ListProviderInvitations List invitations sent to join the admin portal
+func (c *ThreeScaleClient) ListProviderInvitations(opts ...ListOptions) (*InvitationList, error) {
+	req, err := c.buildGetJSONReq(providerInvitationListResourceEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	applyListOptions(req, opts...)
+
+	return doReq[InvitationList](c, req, http.StatusOK)
+}
+
+// CreateProviderInvitation Invite a new member to the admin portal.
+// 3scale emails the invitation to the given address
+func (c *ThreeScaleClient) CreateProviderInvitation(email string) (*Invitation, error) {
+	values := url.Values{}
+	values.Add("email", email)
+
+	body := strings.NewReader(values.Encode())
+	req, err := c.buildPostReq(providerInvitationListResourceEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[Invitation](c, req, http.StatusCreated)
+}
+
+// ReadProviderInvitation Reads admin portal invitation
+func (c *ThreeScaleClient) ReadProviderInvitation(id int64) (*Invitation, error) {
+	endpoint := fmt.Sprintf(providerInvitationResourceEndpoint, id)
+
+	return doGet[Invitation](c, endpoint)
+}
+
+// DeleteProviderInvitation Delete admin portal invitation. Pending invitations can no longer be accepted
+func (c *ThreeScaleClient) DeleteProviderInvitation(id int64) error {
+	endpoint := fmt.Sprintf(providerInvitationResourceEndpoint, id)
+
+	return doDelete(c, endpoint)
+}
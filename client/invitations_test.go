@@ -0,0 +1,78 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestListProviderInvitations(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: providerInvitationListResourceEndpoint}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, InvitationList{Invitations: []Invitation{
+			{Element: InvitationItem{ID: 1, Email: "alice@example.com", Accepted: true}},
+			{Element: InvitationItem{ID: 2, Email: "bob@example.com"}},
+		}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListProviderInvitations()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(list.Invitations))
+	equals(t, "bob@example.com", list.Invitations[1].Element.Email)
+}
+
+func TestCreateProviderInvitation(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   providerInvitationListResourceEndpoint,
+			Form:   map[string]string{"email": "carol@example.com"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusCreated, Invitation{Element: InvitationItem{ID: 3, Email: "carol@example.com"}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CreateProviderInvitation("carol@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, int64(3), obj.Element.ID)
+}
+
+func TestReadProviderInvitation(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: fmt.Sprintf(providerInvitationResourceEndpoint, 3)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, Invitation{Element: InvitationItem{ID: 3, Email: "carol@example.com"}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.ReadProviderInvitation(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, "carol@example.com", obj.Element.Email)
+}
+
+func TestDeleteProviderInvitation(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodDelete, Path: fmt.Sprintf(providerInvitationResourceEndpoint, 3)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, map[string]interface{}{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.DeleteProviderInvitation(3); err != nil {
+		t.Fatal(err)
+	}
+}
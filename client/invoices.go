@@ -0,0 +1,242 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	invoiceListResourceEndpoint             = "/api/invoices.json"
+	accountInvoiceListResourceEndpoint      = "/api/accounts/%d/invoices.json"
+	invoiceResourceEndpoint                 = "/api/invoices/%d.json"
+	invoiceStateResourceEndpoint            = "/api/invoices/%d/state.json"
+	invoiceLineItemListResourceEndpoint     = "/api/invoices/%d/line_items.json"
+	invoiceLineItemResourceEndpoint         = "/api/invoices/%d/line_items/%d.json"
+	invoiceChargeResourceEndpoint           = "/api/invoices/%d/charge.json"
+	INVOICES_PER_PAGE                   int = 500
+)
+
+// Invoice states accepted by UpdateInvoiceState
+const (
+	InvoiceStateOpen      = "open"
+	InvoiceStatePending   = "pending"
+	InvoiceStateUnpaid    = "unpaid"
+	InvoiceStatePaid      = "paid"
+	InvoiceStateFailed    = "failed"
+	InvoiceStateCancelled = "cancelled"
+)
+
+// ListInvoices List invoices of every developer account
+// filterParams are sent as query params, i.e. "month" (YYYY-MM) or "state"
+func (c *ThreeScaleClient) ListInvoices(filterParams Params, opts ...ListOptions) (*InvoiceList, error) {
+	return c.listAllInvoices(invoiceListResourceEndpoint, filterParams, opts...)
+}
+
+// ListInvoicesPerPage List invoices of every developer account in a single page
+// paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
+// paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
+func (c *ThreeScaleClient) ListInvoicesPerPage(filterParams Params, paginationValues ...int) (*InvoiceList, error) {
+	return c.listInvoicesPage(invoiceListResourceEndpoint, filterParams, paginationOptions(paginationValues))
+}
+
+// ListAccountInvoices List invoices of a developer account
+// filterParams are sent as query params, i.e. "month" (YYYY-MM) or "state"
+func (c *ThreeScaleClient) ListAccountInvoices(accountID int64, filterParams Params, opts ...ListOptions) (*InvoiceList, error) {
+	return c.listAllInvoices(fmt.Sprintf(accountInvoiceListResourceEndpoint, accountID), filterParams, opts...)
+}
+
+func (c *ThreeScaleClient) listAllInvoices(endpoint string, filterParams Params, opts ...ListOptions) (*InvoiceList, error) {
+	list := &InvoiceList{}
+
+	err := c.fetchListPages(mergeListOptions(opts), INVOICES_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listInvoicesPage(endpoint, filterParams, options)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		return len(tmpList.Invoices), tmpList.Metadata, func() {
+			list.Invoices = append(list.Invoices, tmpList.Invoices...)
+			list.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (c *ThreeScaleClient) listInvoicesPage(endpoint string, filterParams Params, options ListOptions) (*InvoiceList, error) {
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for k, v := range filterParams {
+		values.Add(k, v)
+	}
+	options.apply(values)
+	req.URL.RawQuery = values.Encode()
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	list := &InvoiceList{}
+	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
+	return list, err
+}
+
+// ReadInvoice Reads 3scale invoice
+func (c *ThreeScaleClient) ReadInvoice(id int64) (*Invoice, error) {
+	endpoint := fmt.Sprintf(invoiceResourceEndpoint, id)
+
+	return doGet[Invoice](c, endpoint)
+}
+
+// CreateInvoice Create 3scale invoice for a developer account
+// Accepted params are "period" (YYYY-MM) and "name"
+func (c *ThreeScaleClient) CreateInvoice(accountID int64, params Params) (*Invoice, error) {
+	endpoint := fmt.Sprintf(accountInvoiceListResourceEndpoint, accountID)
+
+	return doPost[Invoice](c, endpoint, params)
+}
+
+// UpdateInvoiceState Transitions 3scale invoice to the given state
+func (c *ThreeScaleClient) UpdateInvoiceState(id int64, state string) (*Invoice, error) {
+	endpoint := fmt.Sprintf(invoiceStateResourceEndpoint, id)
+
+	values := url.Values{}
+	values.Add("state", state)
+
+	body := strings.NewReader(values.Encode())
+	req, err := c.buildUpdateReq(endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[Invoice](c, req, http.StatusOK)
+}
+
+// IssueInvoice Issues 3scale invoice, moving it from open to pending
+func (c *ThreeScaleClient) IssueInvoice(id int64) (*Invoice, error) {
+	return c.UpdateInvoiceState(id, InvoiceStatePending)
+}
+
+// MarkInvoicePaid Marks 3scale invoice as paid
+func (c *ThreeScaleClient) MarkInvoicePaid(id int64) (*Invoice, error) {
+	return c.UpdateInvoiceState(id, InvoiceStatePaid)
+}
+
+// CancelInvoice Cancels 3scale invoice
+func (c *ThreeScaleClient) CancelInvoice(id int64) (*Invoice, error) {
+	return c.UpdateInvoiceState(id, InvoiceStateCancelled)
+}
+
+// ListInvoiceLineItems List line items of 3scale invoice
+func (c *ThreeScaleClient) ListInvoiceLineItems(invoiceID int64, opts ...ListOptions) (*InvoiceLineItemList, error) {
+	endpoint := fmt.Sprintf(invoiceLineItemListResourceEndpoint, invoiceID)
+
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	applyListOptions(req, opts...)
+
+	return doReq[InvoiceLineItemList](c, req, http.StatusOK)
+}
+
+// CreateInvoiceLineItem Add a line item to 3scale invoice
+func (c *ThreeScaleClient) CreateInvoiceLineItem(invoiceID int64, item *InvoiceLineItemItem) (*InvoiceLineItem, error) {
+	if item == nil {
+		return nil, errors.New("CreateInvoiceLineItem needs not nil pointer")
+	}
+
+	endpoint := fmt.Sprintf(invoiceLineItemListResourceEndpoint, invoiceID)
+
+	values := url.Values{}
+	values.Add("name", item.Name)
+	values.Add("description", item.Description)
+	values.Add("quantity", strconv.Itoa(item.Quantity))
+	values.Add("cost", strconv.FormatFloat(item.Cost, 'f', -1, 64))
+
+	body := strings.NewReader(values.Encode())
+	req, err := c.buildPostReq(endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[InvoiceLineItem](c, req, http.StatusCreated)
+}
+
+// DeleteInvoiceLineItem Delete a line item of 3scale invoice
+func (c *ThreeScaleClient) DeleteInvoiceLineItem(invoiceID, lineItemID int64) error {
+	endpoint := fmt.Sprintf(invoiceLineItemResourceEndpoint, invoiceID, lineItemID)
+
+	return doDelete(c, endpoint)
+}
+
+// ChargeInvoice Charges 3scale invoice to the developer account credit card
+func (c *ThreeScaleClient) ChargeInvoice(id int64) (*Invoice, error) {
+	endpoint := fmt.Sprintf(invoiceChargeResourceEndpoint, id)
+
+	req, err := c.buildPostReq(endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[Invoice](c, req, http.StatusOK)
+}
+
+// InvoicePDF returns the content of the PDF generated for 3scale invoice.
+// Credentials are only sent when the PDF is served by the admin portal host over the admin portal scheme
+func (c *ThreeScaleClient) InvoicePDF(id int64) ([]byte, error) {
+	invoice, err := c.ReadInvoice(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Element.PDFURL == "" {
+		return nil, fmt.Errorf("invoice %d has no PDF", id)
+	}
+
+	pdfURL, err := c.adminPortal.url.Parse(invoice.Element.PDFURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var req *http.Request
+	if pdfURL.Scheme == c.adminPortal.url.Scheme && pdfURL.Host == c.adminPortal.url.Host {
+		req, err = c.buildGetReq(pdfURL.RequestURI())
+		if err == nil {
+			req.URL = pdfURL
+		}
+	} else {
+		req, err = http.NewRequest(http.MethodGet, pdfURL.String(), nil)
+	}
+	if err != nil {
+		return nil, httpReqError
+	}
+	req.Header.Set("Accept", "application/pdf")
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, parseUnexpectedError(resp)
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
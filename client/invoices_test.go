@@ -0,0 +1,304 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestListInvoices(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		// Will serve: 2 pages
+		// page 1 => INVOICES_PER_PAGE
+		// page 2 => 2
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   invoiceListResourceEndpoint,
+			Query: map[string]string{
+				"month":    "2020-03",
+				"state":    InvoiceStatePending,
+				"per_page": strconv.Itoa(INVOICES_PER_PAGE),
+			},
+		}.Assert(t, req)
+
+		n := 0
+		switch req.URL.Query().Get("page") {
+		case "1":
+			n = INVOICES_PER_PAGE
+		case "2":
+			n = 2
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", req.URL.Query().Get("page"))
+		}
+
+		list := InvoiceList{Invoices: make([]Invoice, 0, n)}
+		for idx := 0; idx < n; idx++ {
+			list.Invoices = append(list.Invoices, Invoice{Element: InvoiceItem{ID: int64(idx)}})
+		}
+		return jsonTestResponse(t, http.StatusOK, list)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListInvoices(Params{"month": "2020-03", "state": InvoiceStatePending})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, INVOICES_PER_PAGE+2, len(list.Invoices))
+}
+
+func TestListAccountInvoices(t *testing.T) {
+	var (
+		accountID int64 = 3
+		endpoint        = fmt.Sprintf(accountInvoiceListResourceEndpoint, accountID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: endpoint}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, InvoiceList{Invoices: []Invoice{
+			{Element: InvoiceItem{ID: 1, AccountID: accountID}},
+		}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListAccountInvoices(accountID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, len(list.Invoices))
+	equals(t, accountID, list.Invoices[0].Element.AccountID)
+}
+
+func TestReadInvoice(t *testing.T) {
+	var (
+		invoiceID int64 = 5
+		endpoint        = fmt.Sprintf(invoiceResourceEndpoint, invoiceID)
+		invoice         = Invoice{Element: InvoiceItem{
+			ID:         invoiceID,
+			FriendlyID: "2020-00000001",
+			State:      InvoiceStatePaid,
+			Cost:       121,
+			VATRate:    21,
+			Period:     InvoicePeriod{Start: "2020-03-01", End: "2020-03-31"},
+		}}
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: endpoint}.Assert(t, req)
+		return jsonTestResponse(t, http.StatusOK, invoice)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.ReadInvoice(invoiceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, invoice, *obj)
+}
+
+func TestCreateInvoice(t *testing.T) {
+	var (
+		accountID int64 = 3
+		endpoint        = fmt.Sprintf(accountInvoiceListResourceEndpoint, accountID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   endpoint,
+			Form:   map[string]string{"period": "2020-03"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusCreated, Invoice{Element: InvoiceItem{ID: 9, AccountID: accountID, State: InvoiceStateOpen}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CreateInvoice(accountID, Params{"period": "2020-03"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, InvoiceStateOpen, obj.Element.State)
+}
+
+func TestInvoiceStateTransitions(t *testing.T) {
+	const invoiceID int64 = 5
+
+	tests := []struct {
+		Name  string
+		state string
+		call  func(c *ThreeScaleClient) (*Invoice, error)
+	}{
+		{"issue", InvoiceStatePending, func(c *ThreeScaleClient) (*Invoice, error) { return c.IssueInvoice(invoiceID) }},
+		{"mark as paid", InvoiceStatePaid, func(c *ThreeScaleClient) (*Invoice, error) { return c.MarkInvoicePaid(invoiceID) }},
+		{"cancel", InvoiceStateCancelled, func(c *ThreeScaleClient) (*Invoice, error) { return c.CancelInvoice(invoiceID) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				fake.RequestAssertion{
+					Method: http.MethodPut,
+					Path:   fmt.Sprintf(invoiceStateResourceEndpoint, invoiceID),
+					Form:   map[string]string{"state": tt.state},
+				}.Assert(subT, req)
+
+				return jsonTestResponse(subT, http.StatusOK, Invoice{Element: InvoiceItem{ID: invoiceID, State: tt.state}})
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", httpClient)
+			obj, err := tt.call(c)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			equals(subT, tt.state, obj.Element.State)
+		})
+	}
+}
+
+func TestListInvoiceLineItems(t *testing.T) {
+	const invoiceID int64 = 5
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: fmt.Sprintf(invoiceLineItemListResourceEndpoint, invoiceID)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, InvoiceLineItemList{LineItems: []InvoiceLineItem{
+			{Element: InvoiceLineItemItem{ID: 1, Name: "Fixed fee", Quantity: 1, Cost: 100}},
+			{Element: InvoiceLineItemItem{ID: 2, Name: "Support", Quantity: 3, Cost: 7.5}},
+		}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListInvoiceLineItems(invoiceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(list.LineItems))
+	equals(t, 7.5, list.LineItems[1].Element.Cost)
+}
+
+func TestCreateInvoiceLineItem(t *testing.T) {
+	const invoiceID int64 = 5
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf(invoiceLineItemListResourceEndpoint, invoiceID),
+			Form: map[string]string{
+				"name":        "Support",
+				"description": "Premium support hours",
+				"quantity":    "3",
+				"cost":        "7.5",
+			},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusCreated, InvoiceLineItem{Element: InvoiceLineItemItem{ID: 2, Name: "Support", Quantity: 3, Cost: 7.5}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CreateInvoiceLineItem(invoiceID, &InvoiceLineItemItem{
+		Name:        "Support",
+		Description: "Premium support hours",
+		Quantity:    3,
+		Cost:        7.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, int64(2), obj.Element.ID)
+
+	if _, err := c.CreateInvoiceLineItem(invoiceID, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestDeleteInvoiceLineItem(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodDelete, Path: fmt.Sprintf(invoiceLineItemResourceEndpoint, 5, 2)}.Assert(t, req)
+		return jsonTestResponse(t, http.StatusOK, struct{}{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.DeleteInvoiceLineItem(5, 2); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChargeInvoice(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPost, Path: fmt.Sprintf(invoiceChargeResourceEndpoint, 5)}.Assert(t, req)
+		return jsonTestResponse(t, http.StatusOK, Invoice{Element: InvoiceItem{ID: 5, State: InvoiceStatePaid}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.ChargeInvoice(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, InvoiceStatePaid, obj.Element.State)
+}
+
+func TestInvoicePDF(t *testing.T) {
+	const pdfContent = "%PDF-1.4 invoice"
+
+	tests := []struct {
+		Name       string
+		pdfURL     string
+		expectAuth bool
+	}{
+		{"admin portal host", "https://www.test.com:443/api/invoices/5.pdf", true},
+		{"relative url", "/api/invoices/5.pdf", true},
+		{"external storage", "https://storage.example.com/invoices/5.pdf?signature=abc", false},
+		{"admin portal host over http", "http://www.test.com:443/api/invoices/5.pdf", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				if req.URL.Path == fmt.Sprintf(invoiceResourceEndpoint, 5) {
+					return jsonTestResponse(subT, http.StatusOK, Invoice{Element: InvoiceItem{ID: 5, PDFURL: tt.pdfURL}})
+				}
+
+				if req.URL.Path != "/api/invoices/5.pdf" && req.URL.Path != "/invoices/5.pdf" {
+					subT.Fatalf("unexpected request %s", req.URL.String())
+				}
+				equals(subT, tt.expectAuth, req.Header.Get("Authorization") != "")
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(pdfContent)),
+					Header:     make(http.Header),
+				}
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", httpClient)
+			pdf, err := c.InvoicePDF(5)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			equals(subT, pdfContent, string(pdf))
+		})
+	}
+}
+
+func TestInvoicePDFMissing(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, Invoice{Element: InvoiceItem{ID: 5}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if _, err := c.InvoicePDF(5); err == nil {
+		t.Fatal("expected error")
+	}
+}
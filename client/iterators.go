@@ -0,0 +1,119 @@
+//go:build go1.23
+// +build go1.23
+
+package client
+
+import "iter"
+
+// ApplicationsSeq iterates over the applications of an account, fetching the next page
+// only once the previous one is consumed.
+//
+//	for app, err := range c.ApplicationsSeq(accountID) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// PerPage of opts sets the page size and Page the first page. Iteration stops after the first error
+func (c *ThreeScaleClient) ApplicationsSeq(accountID int64, opts ...ListOptions) iter.Seq2[Application, error] {
+	return pageSeq(mergeListOptions(opts), APPLICATIONS_PER_PAGE, func(options ListOptions) ([]Application, *PageInfo, error) {
+		list, err := c.ListApplicationsFiltered(accountID, nil, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return applicationsOf(list), list.Metadata, nil
+	})
+}
+
+// AllApplicationsSeq iterates over every application of the provider, see ApplicationsSeq
+func (c *ThreeScaleClient) AllApplicationsSeq(opts ...ListOptions) iter.Seq2[Application, error] {
+	return pageSeq(mergeListOptions(opts), APPLICATIONS_PER_PAGE, func(options ListOptions) ([]Application, *PageInfo, error) {
+		list, err := c.listAllApplicationsPage(nil, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return applicationsOf(list), list.Metadata, nil
+	})
+}
+
+// DeveloperAccountsSeq iterates over the developer accounts, see ApplicationsSeq
+func (c *ThreeScaleClient) DeveloperAccountsSeq(opts ...ListOptions) iter.Seq2[DeveloperAccount, error] {
+	return pageSeq(mergeListOptions(opts), DEVELOPERACCOUNTS_PER_PAGE, func(options ListOptions) ([]DeveloperAccount, *PageInfo, error) {
+		list, err := c.listDeveloperAccountsPage(nil, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.Items, list.Metadata, nil
+	})
+}
+
+// ProductsSeq iterates over the products, see ApplicationsSeq
+func (c *ThreeScaleClient) ProductsSeq(opts ...ListOptions) iter.Seq2[Product, error] {
+	return pageSeq(mergeListOptions(opts), PRODUCTS_PER_PAGE, func(options ListOptions) ([]Product, *PageInfo, error) {
+		list, err := c.listProductsPage(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.Products, list.Metadata, nil
+	})
+}
+
+// BackendApisSeq iterates over the backend APIs, see ApplicationsSeq
+func (c *ThreeScaleClient) BackendApisSeq(opts ...ListOptions) iter.Seq2[BackendApi, error] {
+	return pageSeq(mergeListOptions(opts), BACKENDS_PER_PAGE, func(options ListOptions) ([]BackendApi, *PageInfo, error) {
+		list, err := c.listBackendApisPage(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.Backends, list.Metadata, nil
+	})
+}
+
+// pageSeq yields the items of consecutive pages, starting at options.Page, until the page metadata reports
+// the last one. The server may cap per_page, so a page that is not full only ends the iteration when the
+// response carries no metadata, as does an empty page. An error is yielded along with the zero value and ends the iteration
+func pageSeq[T any](options ListOptions, defaultPerPage int, fetch func(options ListOptions) ([]T, *PageInfo, error)) iter.Seq2[T, error] {
+	if options.PerPage < 1 {
+		options.PerPage = defaultPerPage
+	}
+	if options.Page < 1 {
+		options.Page = 1
+	}
+
+	return func(yield func(T, error) bool) {
+		for pageOptions := options; ; pageOptions.Page++ {
+			items, info, err := fetch(pageOptions)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) == 0 {
+				return
+			}
+			if info != nil && info.TotalPages > 0 {
+				if info.IsLastPage() {
+					return
+				}
+			} else if len(items) < pageOptions.PerPage {
+				return
+			}
+		}
+	}
+}
+
+func applicationsOf(list *ApplicationList) []Application {
+	apps := make([]Application, 0, len(list.Applications))
+	for _, elem := range list.Applications {
+		apps = append(apps, elem.Application)
+	}
+	return apps
+}
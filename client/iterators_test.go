@@ -0,0 +1,146 @@
+//go:build go1.23
+// +build go1.23
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestApplicationsSeq(t *testing.T) {
+	const (
+		accountID int64 = 3
+		perPage         = 2
+	)
+	endpoint := fmt.Sprintf(appList, accountID)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		// Will serve: 2 pages
+		// page 1 => perPage
+		// page 2 => 1
+		equals(t, endpoint, req.URL.Path)
+		equals(t, strconv.Itoa(perPage), req.URL.Query().Get("per_page"))
+
+		n := 0
+		switch req.URL.Query().Get("page") {
+		case "1":
+			n = perPage
+		case "2":
+			n = 1
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", req.URL.Query().Get("page"))
+		}
+
+		list := ApplicationList{}
+		for idx := 0; idx < n; idx++ {
+			list.Applications = append(list.Applications, ApplicationElem{Application{ID: int64(len(list.Applications) + 1)}})
+		}
+		return jsonTestResponse(t, http.StatusOK, list)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	count := 0
+	for app, err := range c.ApplicationsSeq(accountID, ListOptions{PerPage: perPage}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if app.ID == 0 {
+			t.Fatal("expected application ID")
+		}
+		count++
+	}
+
+	equals(t, perPage+1, count)
+}
+
+func TestProductsSeqStopsEarly(t *testing.T) {
+	requests := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		return jsonTestResponse(t, http.StatusOK, productsPage(PRODUCTS_PER_PAGE))
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	for _, err := range c.ProductsSeq() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+
+	equals(t, 1, requests)
+}
+
+func TestProductsSeqLastPageMetadata(t *testing.T) {
+	requests := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		resp := jsonTestResponse(t, http.StatusOK, productsPage(PRODUCTS_PER_PAGE))
+		resp.Header.Set(currentPageHeader, req.URL.Query().Get("page"))
+		resp.Header.Set(totalPagesHeader, "1")
+		return resp
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	count := 0
+	for _, err := range c.ProductsSeq() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+
+	equals(t, 1, requests)
+	equals(t, PRODUCTS_PER_PAGE, count)
+}
+
+func TestProductsSeqCappedPerPage(t *testing.T) {
+	const cappedPerPage = 2
+	requests := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		resp := jsonTestResponse(t, http.StatusOK, productsPage(cappedPerPage))
+		resp.Header.Set(currentPageHeader, req.URL.Query().Get("page"))
+		resp.Header.Set(totalPagesHeader, "3")
+		return resp
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	count := 0
+	for _, err := range c.ProductsSeq() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+
+	equals(t, 3, requests)
+	equals(t, 3*cappedPerPage, count)
+}
+
+func TestDeveloperAccountsSeqError(t *testing.T) {
+	transportErr := errors.New("connection refused")
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", &http.Client{Transport: failingTransport{transportErr}})
+
+	errs := 0
+	for account, err := range c.DeveloperAccountsSeq() {
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if !errors.Is(err, transportErr) {
+			t.Fatalf("expected transport error; got %v", err)
+		}
+		equals(t, DeveloperAccount{}, account)
+		errs++
+	}
+
+	equals(t, 1, errs)
+}
@@ -82,22 +82,22 @@ func (c *ThreeScaleClient) DeleteLimitPerEndUserPlan(userPlanId string, metricId
 
 // ListLimitsPerAppPlan - Returns the list of all limits associated to an application plan.
 // Deprecated. Use ListApplicationPlansLimits instead
-func (c *ThreeScaleClient) ListLimitsPerAppPlan(appPlanId string) (LimitList, error) {
+func (c *ThreeScaleClient) ListLimitsPerAppPlan(appPlanId string, opts ...ListOptions) (LimitList, error) {
 	endpoint := fmt.Sprintf(limitAppPlanList, appPlanId)
-	return c.listLimits(endpoint)
+	return c.listLimits(endpoint, opts...)
 }
 
 // ListLimitsPerEndUserPlan - Returns the list of all limits associated to an end user plan.
 // Deprecated. End User plans are deprecated
-func (c *ThreeScaleClient) ListLimitsPerEndUserPlan(endUserPlanId string, metricId string) (LimitList, error) {
+func (c *ThreeScaleClient) ListLimitsPerEndUserPlan(endUserPlanId string, metricId string, opts ...ListOptions) (LimitList, error) {
 	endpoint := fmt.Sprintf(limitEndUserPlanCreateList, endUserPlanId, metricId)
-	return c.listLimits(endpoint)
+	return c.listLimits(endpoint, opts...)
 }
 
 // ListLimitsPerMetric - Returns the list of all limits associated to a metric of an application plan
-func (c *ThreeScaleClient) ListLimitsPerMetric(appPlanId string, metricId string) (LimitList, error) {
+func (c *ThreeScaleClient) ListLimitsPerMetric(appPlanId string, metricId string, opts ...ListOptions) (LimitList, error) {
 	endpoint := fmt.Sprintf(limitAppPlanMetricList, appPlanId, metricId)
-	return c.listLimits(endpoint)
+	return c.listLimits(endpoint, opts...)
 }
 
 func (c *ThreeScaleClient) limitCreate(ep string, metricId string, period string, value int, values url.Values) (Limit, error) {
@@ -112,7 +112,7 @@ func (c *ThreeScaleClient) limitCreate(ep string, metricId string, period string
 	if err != nil {
 		return apiResp, httpReqError
 	}
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 
 	if err != nil {
 		return apiResp, err
@@ -136,7 +136,7 @@ func (c *ThreeScaleClient) updateLimit(ep string, p Params) (Limit, error) {
 		return l, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 
 	if err != nil {
 		return l, err
@@ -155,7 +155,7 @@ func (c *ThreeScaleClient) deleteLimit(ep string) error {
 		return httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -165,7 +165,7 @@ func (c *ThreeScaleClient) deleteLimit(ep string) error {
 }
 
 // listLimits takes an endpoint and returns a list of limits
-func (c *ThreeScaleClient) listLimits(ep string) (LimitList, error) {
+func (c *ThreeScaleClient) listLimits(ep string, opts ...ListOptions) (LimitList, error) {
 	var ml LimitList
 
 	req, err := c.buildGetReq(ep)
@@ -173,10 +173,9 @@ func (c *ThreeScaleClient) listLimits(ep string) (LimitList, error) {
 		return ml, httpReqError
 	}
 
-	values := url.Values{}
-	req.URL.RawQuery = values.Encode()
+	applyListOptions(req, opts...)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return ml, err
 	}
@@ -187,7 +186,7 @@ func (c *ThreeScaleClient) listLimits(ep string) (LimitList, error) {
 }
 
 // ListApplicationPlansLimits List existing application plan limits for a given application plan
-func (c *ThreeScaleClient) ListApplicationPlansLimits(planID int64) (*ApplicationPlanLimitList, error) {
+func (c *ThreeScaleClient) ListApplicationPlansLimits(planID int64, opts ...ListOptions) (*ApplicationPlanLimitList, error) {
 	endpoint := fmt.Sprintf(appPlanLimitListResourceEndpoint, planID)
 
 	req, err := c.buildGetReq(endpoint)
@@ -195,102 +194,35 @@ func (c *ThreeScaleClient) ListApplicationPlansLimits(planID int64) (*Applicatio
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	applyListOptions(req, opts...)
 
-	list := &ApplicationPlanLimitList{}
-	err = handleJsonResp(resp, http.StatusOK, list)
-	return list, err
+	return doReq[ApplicationPlanLimitList](c, req, http.StatusOK)
 }
 
 // CreateApplicationPlanLimit Create 3scale application plan limit
 func (c *ThreeScaleClient) CreateApplicationPlanLimit(planID, metricID int64, params Params) (*ApplicationPlanLimit, error) {
 	endpoint := fmt.Sprintf(appPlanLimitListPerMetricResourceEndpoint, planID, metricID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &ApplicationPlanLimit{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[ApplicationPlanLimit](c, endpoint, params)
 }
 
 // DeleteApplicationPlanLimit Delete 3scale application plan limit
 func (c *ThreeScaleClient) DeleteApplicationPlanLimit(planID, metricID, limitID int64) error {
 	endpoint := fmt.Sprintf(appPlanLimitPerMetricResourceEndpoint, planID, metricID, limitID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // ApplicationPlanLimit Read 3scale application plan limit
 func (c *ThreeScaleClient) ApplicationPlanLimit(planID, metricID, limitID int64) (*ApplicationPlanLimit, error) {
 	endpoint := fmt.Sprintf(appPlanLimitPerMetricResourceEndpoint, planID, metricID, limitID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &ApplicationPlanLimit{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[ApplicationPlanLimit](c, endpoint)
 }
 
 // UpdateApplicationPlanLimit Update 3scale application plan limit
 func (c *ThreeScaleClient) UpdateApplicationPlanLimit(planID, metricID, limitID int64, params Params) (*ApplicationPlanLimit, error) {
 	endpoint := fmt.Sprintf(appPlanLimitPerMetricResourceEndpoint, planID, metricID, limitID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &ApplicationPlanLimit{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[ApplicationPlanLimit](c, endpoint, params)
 }
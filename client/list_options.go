@@ -0,0 +1,113 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListOptions - Options accepted by every List method
+//
+//	list, err := c.ListProducts(ListOptions{PerPage: 50, Sort: "name"})
+//
+// Methods fetching every page use PerPage as page size, or fetch only Page when set.
+// Methods of single page endpoints send Page and PerPage along with the other options.
+// When several options are given, the non zero fields of the latest ones win
+type ListOptions struct {
+	// Page in the paginated list, starting at 1
+	Page int
+	// PerPage is the number of results per page, the maximum depends on the endpoint
+	PerPage int
+	// Sort is sent as the "sort" query param, to the endpoints supporting it
+	Sort string
+	// Params are sent as query params, i.e. filters not covered by the method arguments.
+	// Params take precedence over the filters of the method arguments with the same name
+	Params Params
+}
+
+// mergeListOptions merges opts, the non zero fields of the latest ones winning
+func mergeListOptions(opts []ListOptions) ListOptions {
+	merged := ListOptions{}
+	for _, opt := range opts {
+		if opt.Page != 0 {
+			merged.Page = opt.Page
+		}
+		if opt.PerPage != 0 {
+			merged.PerPage = opt.PerPage
+		}
+		if opt.Sort != "" {
+			merged.Sort = opt.Sort
+		}
+		for k, v := range opt.Params {
+			if merged.Params == nil {
+				merged.Params = Params{}
+			}
+			merged.Params[k] = v
+		}
+	}
+	return merged
+}
+
+// paginationOptions converts the paginationValues of the PerPage methods
+func paginationOptions(paginationValues []int) ListOptions {
+	options := ListOptions{}
+	if len(paginationValues) > 0 {
+		options.Page = paginationValues[0]
+	}
+	if len(paginationValues) > 1 {
+		options.PerPage = paginationValues[1]
+	}
+	return options
+}
+
+// apply sets the options on query, overriding the values already set
+func (o ListOptions) apply(query url.Values) {
+	if o.Page != 0 {
+		query.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage != 0 {
+		query.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if o.Sort != "" {
+		query.Set("sort", o.Sort)
+	}
+	for k, v := range o.Params {
+		query.Set(k, v)
+	}
+}
+
+// applyListOptions sets the merged opts on the request query
+func applyListOptions(req *http.Request, opts ...ListOptions) {
+	if len(opts) == 0 {
+		return
+	}
+	query := req.URL.Query()
+	mergeListOptions(opts).apply(query)
+	req.URL.RawQuery = query.Encode()
+}
+
+// fetchListPages fetches the pages selected by options, see ListOptions.
+// fetch receives the options of every page, with Page and PerPage set
+func (c *ThreeScaleClient) fetchListPages(options ListOptions, defaultPerPage int, fetch func(options ListOptions) (int, *PageInfo, func(), error)) error {
+	perPage := options.PerPage
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	if options.Page > 0 {
+		options.PerPage = perPage
+		_, _, merge, err := fetch(options)
+		if err != nil {
+			return err
+		}
+		merge()
+		return nil
+	}
+
+	return c.fetchAllPages(perPage, func(page int) (int, *PageInfo, func(), error) {
+		pageOptions := options
+		pageOptions.Page = page
+		pageOptions.PerPage = perPage
+		return fetch(pageOptions)
+	})
+}
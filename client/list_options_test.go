@@ -0,0 +1,90 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func productsPage(n int) ProductList {
+	list := ProductList{Products: make([]Product, 0, n)}
+	for idx := 0; idx < n; idx++ {
+		list.Products = append(list.Products, Product{Element: ProductItem{ID: int64(idx)}})
+	}
+	return list
+}
+
+func TestMergeListOptions(t *testing.T) {
+	merged := mergeListOptions([]ListOptions{
+		{Page: 2, PerPage: 10, Sort: "name", Params: Params{"state": "live"}},
+		{PerPage: 20, Params: Params{"plan_id": "3"}},
+	})
+
+	equals(t, ListOptions{Page: 2, PerPage: 20, Sort: "name", Params: Params{"state": "live", "plan_id": "3"}}, merged)
+	equals(t, ListOptions{}, mergeListOptions(nil))
+}
+
+func TestListOptionsPerPage(t *testing.T) {
+	const perPage = 3
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		// Will serve: 2 pages
+		// page 1 => perPage
+		// page 2 => 1
+		equals(t, strconv.Itoa(perPage), req.URL.Query().Get("per_page"))
+		equals(t, "name", req.URL.Query().Get("sort"))
+
+		n := 0
+		switch req.URL.Query().Get("page") {
+		case "1":
+			n = perPage
+		case "2":
+			n = 1
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", req.URL.Query().Get("page"))
+		}
+		return jsonTestResponse(t, http.StatusOK, productsPage(n))
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListProducts(ListOptions{PerPage: perPage, Sort: "name"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, perPage+1, len(list.Products))
+}
+
+func TestListOptionsSinglePage(t *testing.T) {
+	requests := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		requests++
+		equals(t, "3", req.URL.Query().Get("page"))
+		equals(t, strconv.Itoa(PRODUCTS_PER_PAGE), req.URL.Query().Get("per_page"))
+		return jsonTestResponse(t, http.StatusOK, productsPage(PRODUCTS_PER_PAGE))
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListProducts(ListOptions{Page: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, requests)
+	equals(t, PRODUCTS_PER_PAGE, len(list.Products))
+}
+
+func TestListOptionsParamsOverrideFilters(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		equals(t, []string{"suspended"}, req.URL.Query()["state"])
+		equals(t, "7", req.URL.Query().Get("plan_id"))
+		equals(t, "2", req.URL.Query().Get("page"))
+		return jsonTestResponse(t, http.StatusOK, ApplicationList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	_, err := c.ListApplicationsFiltered(1, Params{"state": "live"}, ListOptions{Page: 2, Params: Params{"state": "suspended", "plan_id": "7"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,16 @@
+package client
+
+// SetRequestLogger sets the callback receiving the summary of every request sent to 3scale:
+// method, URL, request headers, status code and duration.
+// Credentials are redacted from the URL and headers before reaching the callback.
+// A nil callback disables the logging
+func (c *ThreeScaleClient) SetRequestLogger(cb RequestLogCB) {
+	c.requestLogger = cb
+}
+
+func (c *ThreeScaleClient) logRequest(summary RequestSummary) {
+	if c.requestLogger == nil {
+		return
+	}
+	c.requestLogger(summary)
+}
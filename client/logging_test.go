@@ -0,0 +1,78 @@
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRequestLogger(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+			Header:     make(http.Header),
+		}
+	})
+
+	var summaries []RequestSummary
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetRequestLogger(func(summary RequestSummary) {
+		summaries = append(summaries, summary)
+	})
+
+	if err := c.DeleteProduct(1); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, len(summaries))
+	equals(t, http.MethodDelete, summaries[0].Method)
+	equals(t, "https://www.test.com:443/admin/api/services/1.json", summaries[0].URL)
+	equals(t, http.StatusOK, summaries[0].StatusCode)
+	equals(t, "REDACTED", summaries[0].Header["Authorization"])
+	equals(t, "application/json", summaries[0].Header["Accept"])
+
+	for _, summary := range summaries {
+		for _, value := range summary.Header {
+			if strings.Contains(value, basicAuth("", "someAccessToken")) {
+				t.Fatalf("credential leaked in header %q", value)
+			}
+		}
+	}
+}
+
+func TestRequestLoggerError(t *testing.T) {
+	httpClient := &http.Client{Transport: failingTransport{err: errors.New("connection refused")}}
+
+	var summaries []RequestSummary
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetRequestLogger(func(summary RequestSummary) {
+		summaries = append(summaries, summary)
+	})
+
+	if _, err := c.ListProducts(); err == nil {
+		t.Fatal("expected error")
+	}
+
+	equals(t, 1, len(summaries))
+	equals(t, 0, summaries[0].StatusCode)
+	if !strings.Contains(summaries[0].Error, "connection refused") {
+		t.Fatalf("unexpected error summary %q", summaries[0].Error)
+	}
+}
+
+func TestSanitizeHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Basic secret")
+	header.Set("Cookie", "session=secret")
+	header.Add("Accept", "application/json")
+	header.Add("Accept", "application/xml")
+
+	equals(t, map[string]string{
+		"Authorization": "REDACTED",
+		"Cookie":        "REDACTED",
+		"Accept":        "application/json, application/xml",
+	}, sanitizeHeader(header))
+}
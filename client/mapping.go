@@ -29,7 +29,7 @@ func (c *ThreeScaleClient) CreateMappingRule(
 		return mr, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return mr, err
 	}
@@ -62,7 +62,7 @@ func (c *ThreeScaleClient) UpdateMappingRule(svcId string, id string, params Par
 		return m, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return m, err
 	}
@@ -84,7 +84,7 @@ func (c *ThreeScaleClient) DeleteMappingRule(svcId string, id string) error {
 		return httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -94,7 +94,7 @@ func (c *ThreeScaleClient) DeleteMappingRule(svcId string, id string) error {
 }
 
 // ListMappingRule - List API for Mapping Rule endpoint
-func (c *ThreeScaleClient) ListMappingRule(svcId string) (MappingRuleList, error) {
+func (c *ThreeScaleClient) ListMappingRule(svcId string, opts ...ListOptions) (MappingRuleList, error) {
 	var mrl MappingRuleList
 	ep := genMrEp(svcId)
 
@@ -107,7 +107,9 @@ func (c *ThreeScaleClient) ListMappingRule(svcId string) (MappingRuleList, error
 	values.Add("service_id", svcId)
 
 	req.URL.RawQuery = values.Encode()
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return mrl, err
 	}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	providerPlanList = "/admin/api/account_plans.json"
+	tenantChangePlan = "/admin/api/accounts/%d/change_plan.json"
+)
+
+// MasterClient communicates with the master Admin Portal.
+// It only exposes master scoped operations, i.e. tenants, provider plans and tenant billing,
+// so master credentials are not used against tenant endpoints and vice versa
+type MasterClient struct {
+	client *ThreeScaleClient
+}
+
+// NewMasterClient creates a MasterClient for the master Admin Portal using master credentials.
+// If http Client is nil, the default http client will be used
+func NewMasterClient(masterPortal *AdminPortal, credential string, httpClient *http.Client) *MasterClient {
+	return &MasterClient{client: NewThreeScale(masterPortal, credential, httpClient)}
+}
+
+// SetCredentials sets the master credentials
+func (m *MasterClient) SetCredentials(credential string) {
+	m.client.SetCredentials(credential)
+}
+
+// CreateTenant creates new tenant
+func (m *MasterClient) CreateTenant(orgName, username, email, password string) (*Tenant, error) {
+	return m.client.CreateTenant(orgName, username, email, password)
+}
+
+// ShowTenant returns tenant info for the specified ID
+func (m *MasterClient) ShowTenant(tenantID int64) (*Tenant, error) {
+	return m.client.ShowTenant(tenantID)
+}
+
+// UpdateTenant updates tenant info for the specified ID
+func (m *MasterClient) UpdateTenant(tenantID int64, params Params) (*Tenant, error) {
+	return m.client.UpdateTenant(tenantID, params)
+}
+
+// DeleteTenant schedules a tenant account to be permanently deleted
+func (m *MasterClient) DeleteTenant(tenantID int64) error {
+	return m.client.DeleteTenant(tenantID)
+}
+
+// TriggerTenantBilling triggers billing of every developer account of the tenant on the given date
+func (m *MasterClient) TriggerTenantBilling(tenantID int64, date time.Time) error {
+	return m.client.TriggerTenantBilling(tenantID, date)
+}
+
+// ListProviderPlans lists the provider plans tenants can subscribe to
+func (m *MasterClient) ListProviderPlans() (*ProviderPlanList, error) {
+	return doGet[ProviderPlanList](m.client, providerPlanList)
+}
+
+// ChangeTenantPlan changes the provider plan of the tenant
+func (m *MasterClient) ChangeTenantPlan(tenantID, planID int64) error {
+	endpoint := fmt.Sprintf(tenantChangePlan, tenantID)
+	return m.client.Do(http.MethodPut, endpoint, Params{"plan_id": strconv.FormatInt(planID, 10)}, http.StatusOK, nil)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestMasterClientListProviderPlans(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   providerPlanList,
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, ProviderPlanList{
+			Plans: []ProviderPlan{{Element: ProviderPlanItem{ID: 1, SystemName: "enterprise"}}},
+		})
+	})
+
+	m := NewMasterClient(NewTestAdminPortal(t), "masterToken", httpClient)
+	list, err := m.ListProviderPlans()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, len(list.Plans))
+	equals(t, "enterprise", list.Plans[0].Element.SystemName)
+}
+
+func TestMasterClientChangeTenantPlan(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   "/admin/api/accounts/3/change_plan.json",
+			Form:   map[string]string{"plan_id": "7"},
+		}.Assert(t, req)
+		equals(t, "application/json", req.Header.Get("Accept"))
+
+		return jsonTestResponse(t, http.StatusOK, DeveloperAccount{})
+	})
+
+	m := NewMasterClient(NewTestAdminPortal(t), "masterToken", httpClient)
+	if err := m.ChangeTenantPlan(3, 7); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMasterClientTenantBilling(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   "/master/api/providers/3/billing_jobs.json",
+			Form:   map[string]string{"date": "2020-01-31"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusAccepted, nil)
+	})
+
+	m := NewMasterClient(NewTestAdminPortal(t), "masterToken", httpClient)
+	if err := m.TriggerTenantBilling(3, time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+}
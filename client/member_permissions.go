@@ -0,0 +1,75 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	memberPermissionsResourceEndpoint = "/admin/api/users/%d/permissions.json"
+)
+
+// Admin portal sections a member can be granted access to
+const (
+	MemberSectionPortal         = "portal"
+	MemberSectionFinance        = "finance"
+	MemberSectionSettings       = "settings"
+	MemberSectionPartners       = "partners"
+	MemberSectionMonitoring     = "monitoring"
+	MemberSectionPlans          = "plans"
+	MemberSectionPolicyRegistry = "policy_registry"
+)
+
+// MemberPermissions Read permissions of provider member user
+func (c *ThreeScaleClient) MemberPermissions(userID int64) (*MemberPermissions, error) {
+	endpoint := fmt.Sprintf(memberPermissionsResourceEndpoint, userID)
+	return doGet[MemberPermissions](c, endpoint)
+}
+
+// UpdateMemberPermissions Replace permissions of provider member user
+// Empty AllowedSections revokes every section.
+// Nil AllowedServiceIDs grants access to every service, empty AllowedServiceIDs revokes every service
+func (c *ThreeScaleClient) UpdateMemberPermissions(userID int64, permissions *MemberPermissions) (*MemberPermissions, error) {
+	if permissions == nil {
+		return nil, errors.New("UpdateMemberPermissions needs not nil pointer")
+	}
+
+	endpoint := fmt.Sprintf(memberPermissionsResourceEndpoint, userID)
+
+	body := strings.NewReader(permissions.Element.values().Encode())
+	req, err := c.buildPutReq(endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[MemberPermissions](c, req, http.StatusOK)
+}
+
+// values encodes permissions the way the API expects them:
+// "[]" clears the list and an empty value is read as every service
+func (p MemberPermissionsItem) values() url.Values {
+	values := url.Values{}
+
+	if len(p.AllowedSections) == 0 {
+		values.Add("allowed_sections[]", "[]")
+	}
+	for _, section := range p.AllowedSections {
+		values.Add("allowed_sections[]", section)
+	}
+
+	switch {
+	case p.AllowedServiceIDs == nil:
+		values.Add("allowed_service_ids[]", "")
+	case len(p.AllowedServiceIDs) == 0:
+		values.Add("allowed_service_ids[]", "[]")
+	}
+	for _, serviceID := range p.AllowedServiceIDs {
+		values.Add("allowed_service_ids[]", strconv.FormatInt(serviceID, 10))
+	}
+
+	return values
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestMemberPermissions(t *testing.T) {
+	var (
+		userID   int64 = 4
+		endpoint       = fmt.Sprintf(memberPermissionsResourceEndpoint, userID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: endpoint}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, map[string]interface{}{
+			"permissions": map[string]interface{}{
+				"user_id":             userID,
+				"role":                "member",
+				"allowed_sections":    []string{MemberSectionPortal, MemberSectionMonitoring},
+				"allowed_service_ids": nil,
+			},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.MemberPermissions(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, userID, obj.Element.UserID)
+	equals(t, []string{MemberSectionPortal, MemberSectionMonitoring}, obj.Element.AllowedSections)
+	equals(t, []int64(nil), obj.Element.AllowedServiceIDs)
+}
+
+func TestUpdateMemberPermissions(t *testing.T) {
+	var (
+		userID   int64 = 4
+		endpoint       = fmt.Sprintf(memberPermissionsResourceEndpoint, userID)
+	)
+
+	tests := []struct {
+		Name        string
+		permissions MemberPermissionsItem
+		form        url.Values
+	}{
+		{
+			"restricted services",
+			MemberPermissionsItem{AllowedSections: []string{MemberSectionPlans}, AllowedServiceIDs: []int64{1, 2}},
+			url.Values{"allowed_sections[]": {"plans"}, "allowed_service_ids[]": {"1", "2"}},
+		},
+		{
+			"every service",
+			MemberPermissionsItem{AllowedSections: []string{MemberSectionPlans, MemberSectionFinance}},
+			url.Values{"allowed_sections[]": {"plans", "finance"}, "allowed_service_ids[]": {""}},
+		},
+		{
+			"no access",
+			MemberPermissionsItem{AllowedServiceIDs: []int64{}},
+			url.Values{"allowed_sections[]": {"[]"}, "allowed_service_ids[]": {"[]"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subTest *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				fake.RequestAssertion{Method: http.MethodPut, Path: endpoint}.Assert(subTest, req)
+
+				body, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					subTest.Fatal(err)
+				}
+				form, err := url.ParseQuery(string(body))
+				if err != nil {
+					subTest.Fatal(err)
+				}
+				equals(subTest, tt.form, form)
+
+				return jsonTestResponse(subTest, http.StatusOK, MemberPermissions{Element: tt.permissions})
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subTest), "someAccessToken", httpClient)
+			obj, err := c.UpdateMemberPermissions(userID, &MemberPermissions{Element: tt.permissions})
+			if err != nil {
+				subTest.Fatal(err)
+			}
+
+			equals(subTest, tt.permissions.AllowedSections, obj.Element.AllowedSections)
+		})
+	}
+}
+
+func TestUpdateMemberPermissionsNil(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	if _, err := c.UpdateMemberPermissions(4, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
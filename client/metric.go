@@ -25,7 +25,7 @@ func (c *ThreeScaleClient) CreateMetric(svcId string, name string, description s
 	if err != nil {
 		return m, httpReqError
 	}
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return m, err
 	}
@@ -55,7 +55,7 @@ func (c *ThreeScaleClient) UpdateMetric(svcId string, id string, params Params)
 		return m, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return m, err
 	}
@@ -76,7 +76,7 @@ func (c *ThreeScaleClient) DeleteMetric(svcId string, id string) error {
 		return httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -86,7 +86,7 @@ func (c *ThreeScaleClient) DeleteMetric(svcId string, id string) error {
 }
 
 // ListMetric - Returns the list of metrics of a service
-func (c *ThreeScaleClient) ListMetrics(svcId string) (MetricList, error) {
+func (c *ThreeScaleClient) ListMetrics(svcId string, opts ...ListOptions) (MetricList, error) {
 	var ml MetricList
 
 	ep := genMetricCreateListEp(svcId)
@@ -99,7 +99,9 @@ func (c *ThreeScaleClient) ListMetrics(svcId string) (MetricList, error) {
 	values := url.Values{}
 	req.URL.RawQuery = values.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 
 	if err != nil {
 		return ml, err
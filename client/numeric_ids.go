@@ -0,0 +1,134 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Some 3scale endpoints encode numbers as JSON strings, i.e. the user_account_id of applications.
+// Integer fields of the client types accept both encodings: when decoding fails on a string
+// found in place of a number, numeric strings are converted following the target type and
+// the document is decoded again.
+
+// lenientJSONDecoder decodes JSON documents accepting string encoded integers
+type lenientJSONDecoder struct {
+	r io.Reader
+}
+
+func (d lenientJSONDecoder) Decode(v interface{}) error {
+	data, err := ioutil.ReadAll(d.r)
+	if err != nil {
+		return err
+	}
+	return unmarshalLenient(data, v)
+}
+
+// unmarshalLenient decodes data into v, converting numeric strings decoded into integer fields
+func unmarshalLenient(data []byte, v interface{}) error {
+	err := json.NewDecoder(bytes.NewReader(data)).Decode(v)
+	var typeErr *json.UnmarshalTypeError
+	if err == nil || !errors.As(err, &typeErr) || typeErr.Value != "string" {
+		return err
+	}
+
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if decoder.Decode(&doc) != nil {
+		return err
+	}
+
+	converted, marshalErr := json.Marshal(numericStringsToNumbers(doc, reflect.TypeOf(v)))
+	if marshalErr != nil {
+		return err
+	}
+	if json.Unmarshal(converted, v) != nil {
+		// report the failure of the document as received
+		return err
+	}
+	return nil
+}
+
+// numericStringsToNumbers converts the numeric strings of doc decoded into integers of type t
+func numericStringsToNumbers(doc interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if s, ok := doc.(string); ok {
+			if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+				return json.Number(s)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if items, ok := doc.([]interface{}); ok {
+			for idx := range items {
+				items[idx] = numericStringsToNumbers(items[idx], t.Elem())
+			}
+		}
+	case reflect.Map:
+		if members, ok := doc.(map[string]interface{}); ok {
+			for name := range members {
+				members[name] = numericStringsToNumbers(members[name], t.Elem())
+			}
+		}
+	case reflect.Struct:
+		if members, ok := doc.(map[string]interface{}); ok {
+			structMembersToNumbers(members, t)
+		}
+	}
+	return doc
+}
+
+func structMembersToNumbers(members map[string]interface{}, t reflect.Type) {
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				structMembersToNumbers(members, embedded)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if key, ok := memberKey(members, name); ok {
+			members[key] = numericStringsToNumbers(members[key], field.Type)
+		}
+	}
+}
+
+// memberKey finds the member decoded into the field name, preferring an exact match
+// and otherwise matching case insensitively like encoding/json
+func memberKey(members map[string]interface{}, name string) (string, bool) {
+	if _, ok := members[name]; ok {
+		return name, true
+	}
+	for key := range members {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
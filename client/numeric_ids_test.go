@@ -0,0 +1,70 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestStringEncodedApplicationAccountID(t *testing.T) {
+	var accountID int64 = 12
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: ioutil.NopCloser(strings.NewReader(
+				`{"applications":[{"application":{"id":3,"user_account_id":"12","plan_id":"7","name":"app"}}]}`,
+			)),
+			Header: make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListApplications(accountID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, len(list.Applications))
+	equals(t, accountID, list.Applications[0].Application.UserAccountID)
+	equals(t, int64(7), list.Applications[0].Application.PlanID)
+	equals(t, "app", list.Applications[0].Application.AppName)
+}
+
+func TestStringEncodedIDs(t *testing.T) {
+	subscriptions := ServiceSubscriptionList{}
+	err := unmarshalLenient([]byte(`{"service_subscriptions":[{"service_subscription":{"id":"1","user_account_id":"2","state":"live"}}]}`), &subscriptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, ServiceSubscriptionItem{ID: 1, AccountID: 2, State: "live"}, subscriptions.Subscriptions[0].Element)
+
+	account := DeveloperAccount{}
+	if err := json.Unmarshal([]byte(`{"account":{"id":"5","org_name":"ACME","vip":"1"}}`), &account); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(5), *account.Element.ID)
+	// strings of custom fields are kept as is
+	equals(t, map[string]interface{}{"vip": "1"}, account.Element.Extra)
+}
+
+func TestStringEncodedIDsCaseInsensitive(t *testing.T) {
+	subscriptions := ServiceSubscriptionList{}
+	err := unmarshalLenient([]byte(`{"service_subscriptions":[{"service_subscription":{"ID":"1","User_Account_ID":"2"}}]}`), &subscriptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, ServiceSubscriptionItem{ID: 1, AccountID: 2}, subscriptions.Subscriptions[0].Element)
+}
+
+func TestNonNumericStringID(t *testing.T) {
+	app := Application{}
+	err := json.Unmarshal([]byte(`{"id":3,"user_account_id":"abc"}`), &app)
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("expected type error; got %v", err)
+	}
+}
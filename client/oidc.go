@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -14,24 +15,15 @@ const (
 // OIDCConfiguration fetches 3scale product oidc configuration
 func (c *ThreeScaleClient) OIDCConfiguration(productID int64) (*OIDCConfiguration, error) {
 	endpoint := fmt.Sprintf(oidcResourceEndpoint, productID)
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	oidcConf := &OIDCConfiguration{}
-	err = handleJsonResp(resp, http.StatusOK, oidcConf)
-	return oidcConf, err
+	return doGet[OIDCConfiguration](c, endpoint)
 }
 
 // UpdateOIDCConfiguration Update 3scale product oidc configuration
 func (c *ThreeScaleClient) UpdateOIDCConfiguration(productID int64, oidcConf *OIDCConfiguration) (*OIDCConfiguration, error) {
+	if oidcConf == nil {
+		return nil, errors.New("UpdateOIDCConfiguration needs not nil pointer")
+	}
+
 	endpoint := fmt.Sprintf(oidcResourceEndpoint, productID)
 
 	bodyArr, err := json.Marshal(oidcConf)
@@ -44,13 +36,5 @@ func (c *ThreeScaleClient) UpdateOIDCConfiguration(productID int64, oidcConf *OI
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	newConf := &OIDCConfiguration{}
-	err = handleJsonResp(resp, http.StatusOK, newConf)
-	return newConf, err
+	return doReq[OIDCConfiguration](c, req, http.StatusOK)
 }
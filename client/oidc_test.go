@@ -32,6 +32,10 @@ func TestOIDCConfiguration(t *testing.T) {
 			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
 		}
 
+		if req.Header.Get("Accept") != "application/json" {
+			t.Fatalf("Accept header does not match. Expected [%s]; got [%s]", "application/json", req.Header.Get("Accept"))
+		}
+
 		responseBodyBytes, err := json.Marshal(oidcConf)
 		if err != nil {
 			t.Fatal(err)
@@ -110,3 +114,10 @@ func TestUpdateOIDCConfiguration(t *testing.T) {
 		t.Fatalf("Expected %v; got %v", *oidcConf, *obj)
 	}
 }
+
+func TestUpdateOIDCConfigurationNil(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	if _, err := c.UpdateOIDCConfiguration(1, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// Pagination headers, read when the response body has no metadata
+const (
+	totalEntriesHeader = "X-Total"
+	totalPagesHeader   = "X-Total-Pages"
+	currentPageHeader  = "X-Page"
+	perPageHeader      = "X-Per-Page"
+)
+
+// IsLastPage reports whether the metadata belongs to the last page.
+// False when the total number of pages is unknown, callers then keep paging until a page is not full
+func (p *PageInfo) IsLastPage() bool {
+	return p != nil && p.TotalPages > 0 && p.CurrentPage >= p.TotalPages
+}
+
+// pageInfo returns the metadata of the body, or of the headers when the body has none.
+// nil when neither reports it
+func pageInfo(body *PageInfo, header http.Header) *PageInfo {
+	if body != nil {
+		return body
+	}
+
+	info := PageInfo{
+		CurrentPage:  headerInt(header, currentPageHeader),
+		PerPage:      headerInt(header, perPageHeader),
+		TotalEntries: headerInt(header, totalEntriesHeader),
+		TotalPages:   headerInt(header, totalPagesHeader),
+	}
+	if info == (PageInfo{}) {
+		return nil
+	}
+	return &info
+}
+
+// headerInt parses an integer header, 0 when missing or invalid
+func headerInt(header http.Header, key string) int {
+	value, err := strconv.Atoi(header.Get(key))
+	if err != nil {
+		return 0
+	}
+	return value
+}
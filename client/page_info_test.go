@@ -0,0 +1,56 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestPageInfoFromBody(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		body := `{"services":[{"service":{"id":1}}],"metadata":{"current_page":2,"per_page":1,"total_entries":3,"total_pages":3}}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}, totalPagesHeader: []string{"9"}},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListProductsPerPage(2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, &PageInfo{CurrentPage: 2, PerPage: 1, TotalEntries: 3, TotalPages: 3}, list.Metadata)
+	equals(t, false, list.Metadata.IsLastPage())
+}
+
+func TestPageInfoFromHeaders(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		resp := jsonTestResponse(t, http.StatusOK, AlertList{Alerts: []Alert{{Element: AlertItem{ID: 1}}}})
+		resp.Header.Set(currentPageHeader, req.URL.Query().Get("page"))
+		resp.Header.Set(perPageHeader, req.URL.Query().Get("per_page"))
+		resp.Header.Set(totalEntriesHeader, "2")
+		resp.Header.Set(totalPagesHeader, "2")
+		return resp
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListAlerts(nil, ListOptions{Page: 2, PerPage: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, &PageInfo{CurrentPage: 2, PerPage: 1, TotalEntries: 2, TotalPages: 2}, list.Metadata)
+	equals(t, true, list.Metadata.IsLastPage())
+}
+
+func TestPageInfoUnknown(t *testing.T) {
+	equals(t, (*PageInfo)(nil), pageInfo(nil, http.Header{currentPageHeader: []string{"invalid"}}))
+
+	var unknown *PageInfo
+	equals(t, false, unknown.IsLastPage())
+	equals(t, false, (&PageInfo{CurrentPage: 4}).IsLastPage())
+}
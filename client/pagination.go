@@ -0,0 +1,75 @@
+package client
+
+import (
+	"sync"
+)
+
+// SetPageConcurrency sets how many pages the List methods fetching every page, like ListDeveloperAccounts or ListProducts, fetch concurrently.
+// Pages are requested in windows of "workers" consecutive pages and merged in page order until the page
+// metadata reports the last page. The server caps per_page, so without metadata the first page with fewer
+// items than requested is the last one, as is an empty page. Pages requested past the last one are discarded. A workers value lower than 2 fetches pages
+// sequentially, which is the default
+func (c *ThreeScaleClient) SetPageConcurrency(workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	c.pageConcurrency = workers
+}
+
+// pageFetcher fetches a single page, returning its number of items, its metadata when reported and
+// a merge function appending them to the caller list
+type pageFetcher func(page int) (n int, info *PageInfo, merge func(), err error)
+
+type fetchedPage struct {
+	n     int
+	info  *PageInfo
+	merge func()
+	err   error
+}
+
+// isLast reports whether no page follows, trusting the metadata over the number of items
+func (p fetchedPage) isLast(perPage int) bool {
+	if p.n == 0 {
+		return true
+	}
+	if p.info != nil && p.info.TotalPages > 0 {
+		return p.info.IsLastPage()
+	}
+	return p.n < perPage
+}
+
+// fetchAllPages fetches every page of perPage items. Concurrently fetched pages are merged
+// in page order from the calling goroutine, so merge functions need no locking
+func (c *ThreeScaleClient) fetchAllPages(perPage int, fetch pageFetcher) error {
+	workers := c.pageConcurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	for first := 1; ; first += workers {
+		pages := make([]fetchedPage, workers)
+		if workers == 1 {
+			pages[0].n, pages[0].info, pages[0].merge, pages[0].err = fetch(first)
+		} else {
+			var wg sync.WaitGroup
+			for idx := range pages {
+				wg.Add(1)
+				go func(idx int) {
+					defer wg.Done()
+					pages[idx].n, pages[idx].info, pages[idx].merge, pages[idx].err = fetch(first + idx)
+				}(idx)
+			}
+			wg.Wait()
+		}
+
+		for _, page := range pages {
+			if page.err != nil {
+				return page.err
+			}
+			page.merge()
+			if page.isLast(perPage) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// pagedApplicationsClient serves "total" applications in pages, recording the requested pages
+func pagedApplicationsClient(t *testing.T, total int, requested map[int]bool, mutex *sync.Mutex) *http.Client {
+	return NewTestClient(func(req *http.Request) *http.Response {
+		page, err := strconv.Atoi(req.URL.Query().Get("page"))
+		if err != nil {
+			t.Errorf("unexpected page param: %v", err)
+		}
+		perPage, err := strconv.Atoi(req.URL.Query().Get("per_page"))
+		if err != nil {
+			t.Errorf("unexpected per_page param: %v", err)
+		}
+
+		mutex.Lock()
+		requested[page] = true
+		mutex.Unlock()
+
+		list := ApplicationList{Applications: []ApplicationElem{}}
+		for idx := (page - 1) * perPage; idx < page*perPage && idx < total; idx++ {
+			list.Applications = append(list.Applications, ApplicationElem{Application: Application{ID: int64(idx)}})
+		}
+
+		responseBodyBytes, err := json.Marshal(list)
+		if err != nil {
+			t.Error(err)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(responseBodyBytes)),
+			Header:     make(http.Header),
+		}
+	})
+}
+
+func TestListAllApplicationsConcurrentPages(t *testing.T) {
+	total := APPLICATIONS_PER_PAGE*4 + 21
+	mutex := &sync.Mutex{}
+	requested := map[int]bool{}
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", pagedApplicationsClient(t, total, requested, mutex))
+	c.SetPageConcurrency(3)
+
+	list, err := c.ListAllApplications()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, total, len(list.Applications))
+	for idx, app := range list.Applications {
+		if app.Application.ID != int64(idx) {
+			t.Fatalf("applications not merged in page order: index %d has ID %d", idx, app.Application.ID)
+		}
+	}
+	// two windows of three pages
+	equals(t, map[int]bool{1: true, 2: true, 3: true, 4: true, 5: true, 6: true}, requested)
+}
+
+func TestListAllApplicationsSequentialPages(t *testing.T) {
+	total := APPLICATIONS_PER_PAGE * 2
+	mutex := &sync.Mutex{}
+	requested := map[int]bool{}
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", pagedApplicationsClient(t, total, requested, mutex))
+
+	list, err := c.ListAllApplications()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, total, len(list.Applications))
+	equals(t, map[int]bool{1: true, 2: true, 3: true}, requested)
+}
+
+func TestFetchAllPagesError(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", http.DefaultClient)
+	c.SetPageConcurrency(4)
+
+	merged := []int{}
+	err := c.fetchAllPages(1, func(page int) (int, *PageInfo, func(), error) {
+		if page == 2 {
+			return 0, nil, nil, createApiErr(http.StatusInternalServerError, "page failed")
+		}
+		return 1, nil, func() { merged = append(merged, page) }, nil
+	})
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	equals(t, []int{1}, merged)
+}
+
+func TestListProductsServerCappedPerPage(t *testing.T) {
+	const (
+		total         = 5
+		cappedPerPage = 2
+	)
+	requested := []string{}
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		page, err := strconv.Atoi(req.URL.Query().Get("page"))
+		if err != nil {
+			t.Fatalf("unexpected page param: %v", err)
+		}
+		requested = append(requested, req.URL.Query().Get("page"))
+
+		list := ProductList{Products: []Product{}}
+		for idx := (page - 1) * cappedPerPage; idx < page*cappedPerPage && idx < total; idx++ {
+			list.Products = append(list.Products, Product{Element: ProductItem{ID: int64(idx)}})
+		}
+		resp := jsonTestResponse(t, http.StatusOK, list)
+		resp.Header.Set(currentPageHeader, strconv.Itoa(page))
+		resp.Header.Set(totalPagesHeader, strconv.Itoa((total+cappedPerPage-1)/cappedPerPage))
+		return resp
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	list, err := c.ListProducts(ListOptions{PerPage: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, total, len(list.Products))
+	equals(t, []string{"1", "2", "3"}, requested)
+}
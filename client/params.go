@@ -0,0 +1,150 @@
+package client
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// paramTag is the struct tag read by ParamsFromStruct
+const paramTag = "param"
+
+// ParamEncoder is implemented by types providing their own param serialization
+type ParamEncoder interface {
+	EncodeParam() (string, error)
+}
+
+// ParamsFromStruct builds Params from the exported fields of a struct or struct pointer.
+// The param name is taken from the "param" tag, defaulting to the field name:
+//
+//	type ProxyParams struct {
+//		Endpoint   string `param:"endpoint"`
+//		ErrorCode  *int   `param:"error_status_auth_failed,omitempty"`
+//		Internal   string `param:"-"`
+//	}
+//
+// Nil pointers are always skipped, "omitempty" also skips zero values.
+// Embedded structs are flattened. Field values implementing ParamEncoder or
+// encoding.TextMarshaler are serialized by them, otherwise only basic types are supported
+func ParamsFromStruct(v interface{}) (Params, error) {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil, fmt.Errorf("ParamsFromStruct needs not nil pointer")
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("ParamsFromStruct needs a struct, got %s", val.Kind())
+	}
+
+	params := NewParams()
+	if err := encodeParamsStruct(params, val); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func encodeParamsStruct(params Params, val reflect.Value) error {
+	valType := val.Type()
+	for i := 0; i < valType.NumField(); i++ {
+		field := valType.Field(i)
+		fieldVal := val.Field(i)
+
+		name, omitEmpty := parseParamTag(field)
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && field.Tag.Get(paramTag) == "" {
+			embedded := fieldVal
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := encodeParamsStruct(params, embedded); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		if fieldVal.Kind() == reflect.Ptr || fieldVal.Kind() == reflect.Interface {
+			if fieldVal.IsNil() {
+				continue
+			}
+		}
+		if omitEmpty && isZeroValue(fieldVal) {
+			continue
+		}
+
+		encoded, err := encodeParamValue(fieldVal)
+		if err != nil {
+			return fmt.Errorf("param %s: %v", name, err)
+		}
+		params.AddParam(name, encoded)
+	}
+	return nil
+}
+
+func parseParamTag(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get(paramTag)
+	parts := strings.Split(tag, ",")
+
+	name := parts[0]
+	if name == "" {
+		name = field.Name
+	}
+
+	omitEmpty := false
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty
+}
+
+func encodeParamValue(val reflect.Value) (string, error) {
+	if val.CanInterface() {
+		switch encoder := val.Interface().(type) {
+		case ParamEncoder:
+			return encoder.EncodeParam()
+		case encoding.TextMarshaler:
+			text, err := encoder.MarshalText()
+			return string(text), err
+		}
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return encodeParamValue(val.Elem())
+	case reflect.String:
+		return val.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(val.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(val.Uint(), 10), nil
+	case reflect.Float32:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 32), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(val.Float(), 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", val.Type())
+}
+
+func isZeroValue(val reflect.Value) bool {
+	return reflect.DeepEqual(val.Interface(), reflect.Zero(val.Type()).Interface())
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type upperParam string
+
+func (u upperParam) EncodeParam() (string, error) {
+	return strings.ToUpper(string(u)), nil
+}
+
+type paramsTestBase struct {
+	State string `param:"state,omitempty"`
+}
+
+type paramsTestStruct struct {
+	paramsTestBase
+	Name       string     `param:"name"`
+	SystemName string     `param:"system_name,omitempty"`
+	Enabled    bool       `param:"enabled"`
+	Limit      *int       `param:"limit"`
+	Cost       float64    `param:"cost_per_month,omitempty"`
+	ServiceID  int64      `param:"service_id"`
+	Code       upperParam `param:"code"`
+	Since      time.Time  `param:"since"`
+	Ignored    string     `param:"-"`
+	Untagged   string
+	unexported string
+}
+
+func TestParamsFromStruct(t *testing.T) {
+	limit := 10
+	params, err := ParamsFromStruct(&paramsTestStruct{
+		paramsTestBase: paramsTestBase{State: "live"},
+		Name:           "my app",
+		Enabled:        true,
+		Limit:          &limit,
+		Cost:           1.5,
+		ServiceID:      42,
+		Code:           "abc",
+		Since:          time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Ignored:        "ignored",
+		Untagged:       "untagged",
+		unexported:     "unexported",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, Params{
+		"state":          "live",
+		"name":           "my app",
+		"enabled":        "true",
+		"limit":          "10",
+		"cost_per_month": "1.5",
+		"service_id":     "42",
+		"code":           "ABC",
+		"since":          "2020-01-02T03:04:05Z",
+		"Untagged":       "untagged",
+	}, params)
+}
+
+func TestParamsFromStructOmitEmpty(t *testing.T) {
+	params, err := ParamsFromStruct(paramsTestStruct{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, Params{
+		"name":       "",
+		"enabled":    "false",
+		"service_id": "0",
+		"code":       "",
+		"since":      "0001-01-01T00:00:00Z",
+		"Untagged":   "",
+	}, params)
+}
+
+func TestParamsFromStructErrors(t *testing.T) {
+	var nilStruct *paramsTestStruct
+
+	errorTests := []struct {
+		Name  string
+		input interface{}
+	}{
+		{"nil pointer", nilStruct},
+		{"not a struct", "name"},
+		{"unsupported field", struct {
+			Tags []string `param:"tags"`
+		}{Tags: []string{"a"}}},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			if _, err := ParamsFromStruct(tt.input); err == nil {
+				subT.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -32,7 +32,7 @@ func (c *ThreeScaleClient) CreateAppPlan(svcId string, name string, stateEvent s
 		return apiResp, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return apiResp, err
 	}
@@ -75,7 +75,7 @@ func (c *ThreeScaleClient) DeleteAppPlan(svcId string, appPlanId string) error {
 		return httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -86,7 +86,7 @@ func (c *ThreeScaleClient) DeleteAppPlan(svcId string, appPlanId string) error {
 
 // ListAppPlanByServiceId - Lists all application plans, filtering on service id
 // Deprecated. Use ListApplicationPlansByProduct instead
-func (c *ThreeScaleClient) ListAppPlanByServiceId(svcId string) (ApplicationPlansList, error) {
+func (c *ThreeScaleClient) ListAppPlanByServiceId(svcId string, opts ...ListOptions) (ApplicationPlansList, error) {
 	var appPlans ApplicationPlansList
 	endpoint := fmt.Sprintf(appPlansByServiceList, svcId)
 
@@ -99,7 +99,9 @@ func (c *ThreeScaleClient) ListAppPlanByServiceId(svcId string) (ApplicationPlan
 	values.Add("service_id", svcId)
 
 	req.URL.RawQuery = values.Encode()
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return appPlans, err
 	}
@@ -110,7 +112,7 @@ func (c *ThreeScaleClient) ListAppPlanByServiceId(svcId string) (ApplicationPlan
 }
 
 // ListAppPlan - List all application plans
-func (c *ThreeScaleClient) ListAppPlan() (ApplicationPlansList, error) {
+func (c *ThreeScaleClient) ListAppPlan(opts ...ListOptions) (ApplicationPlansList, error) {
 	var appPlans ApplicationPlansList
 	endpoint := appPlansList
 
@@ -122,7 +124,9 @@ func (c *ThreeScaleClient) ListAppPlan() (ApplicationPlansList, error) {
 	values := url.Values{}
 
 	req.URL.RawQuery = values.Encode()
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return appPlans, err
 	}
@@ -148,7 +152,7 @@ func (c *ThreeScaleClient) updatePlan(endpoint string, values url.Values) (Plan,
 		return apiResp, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return apiResp, err
 	}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"sort"
+)
+
+// ComparePlans fetches limits, pricing rules and features of two application plans
+// and returns the differences between them.
+// An empty comparison (see IsEmpty) means planB is an exact clone of planA.
+func (c *ThreeScaleClient) ComparePlans(planA, planB int64) (*PlanComparison, error) {
+	limitsA, err := c.ListApplicationPlansLimits(planA)
+	if err != nil {
+		return nil, err
+	}
+
+	limitsB, err := c.ListApplicationPlansLimits(planB)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesA, err := c.ListApplicationPlansPricingRules(planA)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesB, err := c.ListApplicationPlansPricingRules(planB)
+	if err != nil {
+		return nil, err
+	}
+
+	featuresA, err := c.ListApplicationPlanFeatures(planA)
+	if err != nil {
+		return nil, err
+	}
+
+	featuresB, err := c.ListApplicationPlanFeatures(planB)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlanComparison{
+		PlanAID:      planA,
+		PlanBID:      planB,
+		Limits:       diffPlanLimits(limitsA, limitsB),
+		PricingRules: diffPlanPricingRules(rulesA, rulesB),
+		Features:     diffPlanFeatures(featuresA, featuresB),
+	}, nil
+}
+
+// IsEmpty returns true when both plans have the same limits, pricing rules and features
+func (p *PlanComparison) IsEmpty() bool {
+	return len(p.Limits) == 0 && len(p.PricingRules) == 0 && len(p.Features) == 0
+}
+
+type planLimitKey struct {
+	metricID int64
+	period   string
+}
+
+func diffPlanLimits(a, b *ApplicationPlanLimitList) []PlanLimitDiff {
+	diffs := map[planLimitKey]*PlanLimitDiff{}
+	keys := []planLimitKey{}
+
+	entry := func(item ApplicationPlanLimitItem) *PlanLimitDiff {
+		key := planLimitKey{item.MetricID, item.Period}
+		if _, ok := diffs[key]; !ok {
+			diffs[key] = &PlanLimitDiff{MetricID: item.MetricID, Period: item.Period}
+			keys = append(keys, key)
+		}
+		return diffs[key]
+	}
+
+	for idx := range a.Limits {
+		entry(a.Limits[idx].Element).PlanA = &a.Limits[idx].Element
+	}
+	for idx := range b.Limits {
+		entry(b.Limits[idx].Element).PlanB = &b.Limits[idx].Element
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].metricID != keys[j].metricID {
+			return keys[i].metricID < keys[j].metricID
+		}
+		return keys[i].period < keys[j].period
+	})
+
+	result := []PlanLimitDiff{}
+	for _, key := range keys {
+		diff := diffs[key]
+		if diff.PlanA != nil && diff.PlanB != nil && diff.PlanA.Value == diff.PlanB.Value {
+			continue
+		}
+		result = append(result, *diff)
+	}
+
+	return result
+}
+
+type planPricingRuleKey struct {
+	metricID int64
+	min      int
+	max      int
+}
+
+func diffPlanPricingRules(a, b *ApplicationPlanPricingRuleList) []PlanPricingRuleDiff {
+	diffs := map[planPricingRuleKey]*PlanPricingRuleDiff{}
+	keys := []planPricingRuleKey{}
+
+	entry := func(item ApplicationPlanPricingRuleItem) *PlanPricingRuleDiff {
+		key := planPricingRuleKey{item.MetricID, item.Min, item.Max}
+		if _, ok := diffs[key]; !ok {
+			diffs[key] = &PlanPricingRuleDiff{MetricID: item.MetricID, Min: item.Min, Max: item.Max}
+			keys = append(keys, key)
+		}
+		return diffs[key]
+	}
+
+	for idx := range a.Rules {
+		entry(a.Rules[idx].Element).PlanA = &a.Rules[idx].Element
+	}
+	for idx := range b.Rules {
+		entry(b.Rules[idx].Element).PlanB = &b.Rules[idx].Element
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].metricID != keys[j].metricID {
+			return keys[i].metricID < keys[j].metricID
+		}
+		if keys[i].min != keys[j].min {
+			return keys[i].min < keys[j].min
+		}
+		return keys[i].max < keys[j].max
+	})
+
+	result := []PlanPricingRuleDiff{}
+	for _, key := range keys {
+		diff := diffs[key]
+		if diff.PlanA != nil && diff.PlanB != nil && diff.PlanA.CostPerUnit == diff.PlanB.CostPerUnit {
+			continue
+		}
+		result = append(result, *diff)
+	}
+
+	return result
+}
+
+func diffPlanFeatures(a, b *FeatureList) []PlanFeatureDiff {
+	diffs := map[string]*PlanFeatureDiff{}
+	keys := []string{}
+
+	entry := func(item FeatureItem) *PlanFeatureDiff {
+		if _, ok := diffs[item.SystemName]; !ok {
+			diffs[item.SystemName] = &PlanFeatureDiff{SystemName: item.SystemName}
+			keys = append(keys, item.SystemName)
+		}
+		return diffs[item.SystemName]
+	}
+
+	for idx := range a.Features {
+		entry(a.Features[idx].Element).PlanA = &a.Features[idx].Element
+	}
+	for idx := range b.Features {
+		entry(b.Features[idx].Element).PlanB = &b.Features[idx].Element
+	}
+
+	sort.Strings(keys)
+
+	result := []PlanFeatureDiff{}
+	for _, key := range keys {
+		diff := diffs[key]
+		if diff.PlanA != nil && diff.PlanB != nil {
+			continue
+		}
+		result = append(result, *diff)
+	}
+
+	return result
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestComparePlans(t *testing.T) {
+	var (
+		planA int64 = 1
+		planB int64 = 2
+	)
+
+	responses := map[string]interface{}{
+		fmt.Sprintf(appPlanLimitListResourceEndpoint, planA): ApplicationPlanLimitList{
+			Limits: []ApplicationPlanLimit{
+				{Element: ApplicationPlanLimitItem{ID: 1, MetricID: 10, Period: "month", Value: 100}},
+				{Element: ApplicationPlanLimitItem{ID: 2, MetricID: 10, Period: "day", Value: 10}},
+			},
+		},
+		fmt.Sprintf(appPlanLimitListResourceEndpoint, planB): ApplicationPlanLimitList{
+			Limits: []ApplicationPlanLimit{
+				{Element: ApplicationPlanLimitItem{ID: 3, MetricID: 10, Period: "month", Value: 1000}},
+				{Element: ApplicationPlanLimitItem{ID: 4, MetricID: 10, Period: "day", Value: 10}},
+				{Element: ApplicationPlanLimitItem{ID: 5, MetricID: 11, Period: "eternity", Value: 1}},
+			},
+		},
+		fmt.Sprintf(appPlanRuleListResourceEndpoint, planA): ApplicationPlanPricingRuleList{
+			Rules: []ApplicationPlanPricingRule{
+				{Element: ApplicationPlanPricingRuleItem{ID: 1, MetricID: 10, Min: 1, Max: 10, CostPerUnit: "1.0"}},
+			},
+		},
+		fmt.Sprintf(appPlanRuleListResourceEndpoint, planB): ApplicationPlanPricingRuleList{
+			Rules: []ApplicationPlanPricingRule{
+				{Element: ApplicationPlanPricingRuleItem{ID: 2, MetricID: 10, Min: 1, Max: 10, CostPerUnit: "1.0"}},
+			},
+		},
+		fmt.Sprintf(appPlanFeatureListResourceEndpoint, planA): FeatureList{
+			Features: []Feature{
+				{Element: FeatureItem{ID: 1, SystemName: "support"}},
+				{Element: FeatureItem{ID: 2, SystemName: "sla"}},
+			},
+		},
+		fmt.Sprintf(appPlanFeatureListResourceEndpoint, planB): FeatureList{
+			Features: []Feature{
+				{Element: FeatureItem{ID: 1, SystemName: "support"}},
+			},
+		},
+	}
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
+		}
+
+		obj, ok := responses[req.URL.Path]
+		if !ok {
+			t.Fatalf("Unexpected path [%s]", req.URL.Path)
+		}
+
+		responseBodyBytes, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(responseBodyBytes)),
+			Header:     make(http.Header),
+		}
+	})
+
+	credential := "someAccessToken"
+	c := NewThreeScale(NewTestAdminPortal(t), credential, httpClient)
+	comparison, err := c.ComparePlans(planA, planB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if comparison.IsEmpty() {
+		t.Fatal("comparison expected not to be empty")
+	}
+
+	if len(comparison.Limits) != 2 {
+		t.Fatalf("# limit diffs does not match. Expected [%d]; got [%d]", 2, len(comparison.Limits))
+	}
+
+	changed := comparison.Limits[0]
+	if changed.MetricID != 10 || changed.Period != "month" || changed.PlanA.Value != 100 || changed.PlanB.Value != 1000 {
+		t.Fatalf("unexpected limit diff: %+v", changed)
+	}
+
+	added := comparison.Limits[1]
+	if added.MetricID != 11 || added.PlanA != nil || added.PlanB == nil {
+		t.Fatalf("unexpected limit diff: %+v", added)
+	}
+
+	if len(comparison.PricingRules) != 0 {
+		t.Fatalf("# pricing rule diffs does not match. Expected [%d]; got [%d]", 0, len(comparison.PricingRules))
+	}
+
+	if len(comparison.Features) != 1 {
+		t.Fatalf("# feature diffs does not match. Expected [%d]; got [%d]", 1, len(comparison.Features))
+	}
+
+	if comparison.Features[0].SystemName != "sla" || comparison.Features[0].PlanB != nil {
+		t.Fatalf("unexpected feature diff: %+v", comparison.Features[0])
+	}
+}
+
+func TestComparePlansIdentical(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		var obj interface{}
+		switch req.URL.Path {
+		case fmt.Sprintf(appPlanLimitListResourceEndpoint, 1), fmt.Sprintf(appPlanLimitListResourceEndpoint, 2):
+			obj = ApplicationPlanLimitList{
+				Limits: []ApplicationPlanLimit{
+					{Element: ApplicationPlanLimitItem{MetricID: 10, Period: "month", Value: 100}},
+				},
+			}
+		case fmt.Sprintf(appPlanRuleListResourceEndpoint, 1), fmt.Sprintf(appPlanRuleListResourceEndpoint, 2):
+			obj = ApplicationPlanPricingRuleList{}
+		default:
+			obj = FeatureList{}
+		}
+
+		responseBodyBytes, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(responseBodyBytes)),
+			Header:     make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	comparison, err := c.ComparePlans(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !comparison.IsEmpty() {
+		t.Fatalf("comparison expected to be empty: %+v", comparison)
+	}
+}
@@ -14,20 +14,7 @@ const (
 // Policies fetches 3scale product policy chain
 func (c *ThreeScaleClient) Policies(productID int64) (*PoliciesConfigList, error) {
 	endpoint := fmt.Sprintf(policiesResourceEndpoint, productID)
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	policies := &PoliciesConfigList{}
-	err = handleJsonResp(resp, http.StatusOK, policies)
-	return policies, err
+	return doGet[PoliciesConfigList](c, endpoint)
 }
 
 // UpdatePolicies Update 3scale product policy chain
@@ -44,13 +31,5 @@ func (c *ThreeScaleClient) UpdatePolicies(productID int64, policies *PoliciesCon
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	items := &PoliciesConfigList{}
-	err = handleJsonResp(resp, http.StatusOK, items)
-	return items, err
+	return doReq[PoliciesConfigList](c, req, http.StatusOK)
 }
@@ -25,6 +25,10 @@ func TestPolicies(t *testing.T) {
 			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
 		}
 
+		if req.Header.Get("Accept") != "application/json" {
+			t.Fatalf("Accept header does not match. Expected [%s]; got [%s]", "application/json", req.Header.Get("Accept"))
+		}
+
 		policies := &PoliciesConfigList{
 			Policies: []PolicyConfig{
 				{
@@ -14,45 +14,30 @@ const (
 )
 
 // ListAPIcastPolicies List existing apicast policies in the registry for the client provider account
-func (c *ThreeScaleClient) ListAPIcastPolicies() (*APIcastPolicyRegistry, error) {
-	req, err := c.buildGetReq(apicastPolicyRegistryEndpoint)
+func (c *ThreeScaleClient) ListAPIcastPolicies(opts ...ListOptions) (*APIcastPolicyRegistry, error) {
+	req, err := c.buildGetJSONReq(apicastPolicyRegistryEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	applyListOptions(req, opts...)
 
-	obj := &APIcastPolicyRegistry{}
-	err = handleJsonResp(resp, http.StatusOK, obj)
-	return obj, err
+	return doReq[APIcastPolicyRegistry](c, req, http.StatusOK)
 }
 
 // ReadAPIcastPolicy Reads 3scale apicast policy from registry
 func (c *ThreeScaleClient) ReadAPIcastPolicy(id int64) (*APIcastPolicy, error) {
 	endpoint := fmt.Sprintf(apicastPolicyEndpoint, id)
 
-	req, err := c.buildGetJSONReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	obj := &APIcastPolicy{}
-	err = handleJsonResp(resp, http.StatusOK, obj)
-	return obj, err
+	return doGet[APIcastPolicy](c, endpoint)
 }
 
 // CreateAPIcastPolicy Create 3scale apicast policy in the registry
 func (c *ThreeScaleClient) CreateAPIcastPolicy(item *APIcastPolicy) (*APIcastPolicy, error) {
+	if item == nil {
+		return nil, errors.New("CreateAPIcastPolicy needs not nil pointer")
+	}
+
 	bodyArr, err := json.Marshal(item.Element)
 	if err != nil {
 		return nil, err
@@ -64,15 +49,7 @@ func (c *ThreeScaleClient) CreateAPIcastPolicy(item *APIcastPolicy) (*APIcastPol
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &APIcastPolicy{}
-	err = handleJsonResp(resp, http.StatusCreated, respObj)
-	return respObj, err
+	return doReq[APIcastPolicy](c, req, http.StatusCreated)
 }
 
 // UpdateAPIcastPolicy Update existing apicast policy in the registry
@@ -98,31 +75,12 @@ func (c *ThreeScaleClient) UpdateAPIcastPolicy(item *APIcastPolicy) (*APIcastPol
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	respObj := &APIcastPolicy{}
-	err = handleJsonResp(resp, http.StatusOK, respObj)
-	return respObj, err
+	return doReq[APIcastPolicy](c, req, http.StatusOK)
 }
 
 // DeleteAPIcastPolicy Delete existing apicast policy in the registry
 func (c *ThreeScaleClient) DeleteAPIcastPolicy(id int64) error {
 	endpoint := fmt.Sprintf(apicastPolicyEndpoint, id)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
@@ -223,6 +223,13 @@ func TestCreateAPIcastPolicy(t *testing.T) {
 	}
 }
 
+func TestCreateAPIcastPolicyNil(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	if _, err := c.CreateAPIcastPolicy(nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestUpdateAPIcastPolicy(t *testing.T) {
 	var (
 		policyID int64 = 1
@@ -3,8 +3,6 @@ package client
 import (
 	"fmt"
 	"net/http"
-	"net/url"
-	"strings"
 )
 
 const (
@@ -14,7 +12,7 @@ const (
 )
 
 // ListApplicationPlansPricingRules List existing application plans pricing rules for a given application plan
-func (c *ThreeScaleClient) ListApplicationPlansPricingRules(planID int64) (*ApplicationPlanPricingRuleList, error) {
+func (c *ThreeScaleClient) ListApplicationPlansPricingRules(planID int64, opts ...ListOptions) (*ApplicationPlanPricingRuleList, error) {
 	endpoint := fmt.Sprintf(appPlanRuleListResourceEndpoint, planID)
 
 	req, err := c.buildGetReq(endpoint)
@@ -22,57 +20,21 @@ func (c *ThreeScaleClient) ListApplicationPlansPricingRules(planID int64) (*Appl
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	applyListOptions(req, opts...)
 
-	list := &ApplicationPlanPricingRuleList{}
-	err = handleJsonResp(resp, http.StatusOK, list)
-	return list, err
+	return doReq[ApplicationPlanPricingRuleList](c, req, http.StatusOK)
 }
 
 // CreateApplicationPlanPricingRule Create 3scale application plan pricing rule
 func (c *ThreeScaleClient) CreateApplicationPlanPricingRule(planID, metricID int64, params Params) (*ApplicationPlanPricingRule, error) {
 	endpoint := fmt.Sprintf(appPlanRuleListPerMetricResourceEndpoint, planID, metricID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &ApplicationPlanPricingRule{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[ApplicationPlanPricingRule](c, endpoint, params)
 }
 
 // DeleteApplicationPlanPricingRule Delete 3scale application plan pricing rule
 func (c *ThreeScaleClient) DeleteApplicationPlanPricingRule(planID, metricID, ruleID int64) error {
 	endpoint := fmt.Sprintf(appPlanRulePerMetricResourceEndpoint, planID, metricID, ruleID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
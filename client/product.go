@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 )
 
@@ -26,20 +25,7 @@ const (
 func (c *ThreeScaleClient) Product(id int64) (*Product, error) {
 	endpoint := fmt.Sprintf(productResourceEndpoint, id)
 
-	req, err := c.buildGetJSONReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	product := &Product{}
-	err = handleJsonResp(resp, http.StatusOK, product)
-	return product, err
+	return doGet[Product](c, endpoint)
 }
 
 // CreateProduct Create 3scale Product
@@ -56,15 +42,7 @@ func (c *ThreeScaleClient) CreateProduct(name string, params Params) (*Product,
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	product := &Product{}
-	err = handleJsonResp(resp, http.StatusCreated, product)
-	return product, err
+	return doReq[Product](c, req, http.StatusCreated)
 }
 
 // UpdateProduct Update existing product
@@ -82,70 +60,47 @@ func (c *ThreeScaleClient) UpdateProduct(id int64, params Params) (*Product, err
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	product := &Product{}
-	err = handleJsonResp(resp, http.StatusOK, product)
-	return product, err
+	return doReq[Product](c, req, http.StatusOK)
 }
 
 // DeleteProduct Delete existing product
 func (c *ThreeScaleClient) DeleteProduct(id int64) error {
 	productEndpoint := fmt.Sprintf(productResourceEndpoint, id)
 
-	req, err := c.buildDeleteReq(productEndpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, productEndpoint)
 }
 
-func (c *ThreeScaleClient) ListProducts() (*ProductList, error) {
-	// Keep asking until the results length is lower than "per_page" param
-	currentPage := 1
+func (c *ThreeScaleClient) ListProducts(opts ...ListOptions) (*ProductList, error) {
 	productList := &ProductList{}
 
-	allResultsPerPage := false
-	for next := true; next; next = allResultsPerPage {
-		tmpProductList, err := c.ListProductsPerPage(currentPage, PRODUCTS_PER_PAGE)
+	err := c.fetchListPages(mergeListOptions(opts), PRODUCTS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpProductList, err := c.listProductsPage(options)
 		if err != nil {
-			return nil, err
+			return 0, nil, nil, err
 		}
-
-		productList.Products = append(productList.Products, tmpProductList.Products...)
-
-		allResultsPerPage = len(tmpProductList.Products) == PRODUCTS_PER_PAGE
-		currentPage += 1
+		return len(tmpProductList.Products), tmpProductList.Metadata, func() {
+			productList.Products = append(productList.Products, tmpProductList.Products...)
+			productList.Metadata = tmpProductList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return productList, nil
-
 }
 
 // ListProductsPerPage List existing products in a single page
 // paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
 // paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
 func (c *ThreeScaleClient) ListProductsPerPage(paginationValues ...int) (*ProductList, error) {
-	queryValues := url.Values{}
+	return c.listProductsPage(paginationOptions(paginationValues))
+}
 
-	if len(paginationValues) > 0 {
-		queryValues.Add("page", strconv.Itoa(paginationValues[0]))
-	}
+func (c *ThreeScaleClient) listProductsPage(options ListOptions) (*ProductList, error) {
+	queryValues := url.Values{}
 
-	if len(paginationValues) > 1 {
-		queryValues.Add("per_page", strconv.Itoa(paginationValues[1]))
-	}
+	options.apply(queryValues)
 
 	req, err := c.buildGetReq(productListResourceEndpoint)
 	if err != nil {
@@ -153,7 +108,7 @@ func (c *ThreeScaleClient) ListProductsPerPage(paginationValues ...int) (*Produc
 	}
 	req.URL.RawQuery = queryValues.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -161,18 +116,21 @@ func (c *ThreeScaleClient) ListProductsPerPage(paginationValues ...int) (*Produc
 
 	productList := &ProductList{}
 	err = handleJsonResp(resp, http.StatusOK, productList)
+	productList.Metadata = pageInfo(productList.Metadata, resp.Header)
 	return productList, err
 }
 
 // ListProductMethods List existing product methods
-func (c *ThreeScaleClient) ListProductMethods(productID, hitsID int64) (*MethodList, error) {
+func (c *ThreeScaleClient) ListProductMethods(productID, hitsID int64, opts ...ListOptions) (*MethodList, error) {
 	endpoint := fmt.Sprintf(productMethodListResourceEndpoint, productID, hitsID)
 	req, err := c.buildGetReq(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -180,6 +138,7 @@ func (c *ThreeScaleClient) ListProductMethods(productID, hitsID int64) (*MethodL
 
 	list := &MethodList{}
 	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
 	return list, err
 }
 
@@ -187,100 +146,41 @@ func (c *ThreeScaleClient) ListProductMethods(productID, hitsID int64) (*MethodL
 func (c *ThreeScaleClient) CreateProductMethod(productID, hitsID int64, params Params) (*Method, error) {
 	endpoint := fmt.Sprintf(productMethodListResourceEndpoint, productID, hitsID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &Method{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[Method](c, endpoint, params)
 }
 
 // DeleteProductMethod Delete 3scale product method
 func (c *ThreeScaleClient) DeleteProductMethod(productID, hitsID, methodID int64) error {
 	endpoint := fmt.Sprintf(productMethodResourceEndpoint, productID, hitsID, methodID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // ProductMethod Read 3scale product method
 func (c *ThreeScaleClient) ProductMethod(productID, hitsID, methodID int64) (*Method, error) {
 	endpoint := fmt.Sprintf(productMethodResourceEndpoint, productID, hitsID, methodID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &Method{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[Method](c, endpoint)
 }
 
 // UpdateProductMethod Update 3scale product method
 func (c *ThreeScaleClient) UpdateProductMethod(productID, hitsID, methodID int64, params Params) (*Method, error) {
 	endpoint := fmt.Sprintf(productMethodResourceEndpoint, productID, hitsID, methodID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &Method{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[Method](c, endpoint, params)
 }
 
 // ListProductMetrics List existing product metrics
-func (c *ThreeScaleClient) ListProductMetrics(productID int64) (*MetricJSONList, error) {
+func (c *ThreeScaleClient) ListProductMetrics(productID int64, opts ...ListOptions) (*MetricJSONList, error) {
 	endpoint := fmt.Sprintf(productMetricListResourceEndpoint, productID)
 	req, err := c.buildGetReq(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -288,6 +188,7 @@ func (c *ThreeScaleClient) ListProductMetrics(productID int64) (*MetricJSONList,
 
 	list := &MetricJSONList{}
 	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
 	return list, err
 }
 
@@ -295,100 +196,41 @@ func (c *ThreeScaleClient) ListProductMetrics(productID int64) (*MetricJSONList,
 func (c *ThreeScaleClient) CreateProductMetric(productID int64, params Params) (*MetricJSON, error) {
 	endpoint := fmt.Sprintf(productMetricListResourceEndpoint, productID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MetricJSON{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[MetricJSON](c, endpoint, params)
 }
 
 // DeleteProductMetric Delete 3scale product metric
 func (c *ThreeScaleClient) DeleteProductMetric(productID, metricID int64) error {
 	endpoint := fmt.Sprintf(productMetricResourceEndpoint, productID, metricID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // ProductMetric Read 3scale product metric
 func (c *ThreeScaleClient) ProductMetric(productID, metricID int64) (*MetricJSON, error) {
 	endpoint := fmt.Sprintf(productMetricResourceEndpoint, productID, metricID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MetricJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[MetricJSON](c, endpoint)
 }
 
 // UpdateProductMetric Update 3scale product metric
 func (c *ThreeScaleClient) UpdateProductMetric(productID, metricID int64, params Params) (*MetricJSON, error) {
 	endpoint := fmt.Sprintf(productMetricResourceEndpoint, productID, metricID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MetricJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[MetricJSON](c, endpoint, params)
 }
 
 // ListProductMappingRules List existing product mappingrules
-func (c *ThreeScaleClient) ListProductMappingRules(productID int64) (*MappingRuleJSONList, error) {
+func (c *ThreeScaleClient) ListProductMappingRules(productID int64, opts ...ListOptions) (*MappingRuleJSONList, error) {
 	endpoint := fmt.Sprintf(productMappingRuleListResourceEndpoint, productID)
 	req, err := c.buildGetReq(endpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -396,6 +238,7 @@ func (c *ThreeScaleClient) ListProductMappingRules(productID int64) (*MappingRul
 
 	list := &MappingRuleJSONList{}
 	err = handleJsonResp(resp, http.StatusOK, list)
+	list.Metadata = pageInfo(list.Metadata, resp.Header)
 	return list, err
 }
 
@@ -403,137 +246,56 @@ func (c *ThreeScaleClient) ListProductMappingRules(productID int64) (*MappingRul
 func (c *ThreeScaleClient) CreateProductMappingRule(productID int64, params Params) (*MappingRuleJSON, error) {
 	endpoint := fmt.Sprintf(productMappingRuleListResourceEndpoint, productID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPostReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MappingRuleJSON{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doPost[MappingRuleJSON](c, endpoint, params)
 }
 
 // DeleteProductMappingRule Delete 3scale product mappingrule
 func (c *ThreeScaleClient) DeleteProductMappingRule(productID, itemID int64) error {
 	endpoint := fmt.Sprintf(productMappingRuleResourceEndpoint, productID, itemID)
 
-	req, err := c.buildDeleteReq(endpoint, nil)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	return handleJsonResp(resp, http.StatusOK, nil)
+	return doDelete(c, endpoint)
 }
 
 // ProductMappingRule Read 3scale product mappingrule
 func (c *ThreeScaleClient) ProductMappingRule(productID, itemID int64) (*MappingRuleJSON, error) {
 	endpoint := fmt.Sprintf(productMappingRuleResourceEndpoint, productID, itemID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MappingRuleJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[MappingRuleJSON](c, endpoint)
 }
 
 // UpdateProductMappingRule Update 3scale product mappingrule
 func (c *ThreeScaleClient) UpdateProductMappingRule(productID, itemID int64, params Params) (*MappingRuleJSON, error) {
 	endpoint := fmt.Sprintf(productMappingRuleResourceEndpoint, productID, itemID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &MappingRuleJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[MappingRuleJSON](c, endpoint, params)
 }
 
 // ProductProxy Read 3scale product proxy
 func (c *ThreeScaleClient) ProductProxy(productID int64) (*ProxyJSON, error) {
 	endpoint := fmt.Sprintf(productProxyResourceEndpoint, productID)
 
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &ProxyJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doGet[ProxyJSON](c, endpoint)
 }
 
-// UpdateProductProxy Update 3scale product mappingrule
+// UpdateProductProxy Update 3scale product proxy. Common params keys and their purpose are as follows:
+// "endpoint"              - Public Base URL for production environment
+// "sandbox_endpoint"      - Public Base URL for staging environment
+// "credentials_location"  - Credentials location: 'headers', 'query' or 'authorization'
+// "auth_app_key"          - Parameter/header name for the app key
+// "auth_app_id"           - Parameter/header name for the app id
+// "auth_user_key"         - Parameter/header name for the user key
+// "error_auth_failed"     - Error message on failed authentication
+// "error_auth_missing"    - Error message on missing authentication
+// "error_no_match"        - Error message when no mapping rule is matched
+// "error_limits_exceeded" - Error message when usage limits are exceeded
 func (c *ThreeScaleClient) UpdateProductProxy(productID int64, params Params) (*ProxyJSON, error) {
 	endpoint := fmt.Sprintf(productProxyResourceEndpoint, productID)
 
-	values := url.Values{}
-	for k, v := range params {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildUpdateReq(endpoint, body)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &ProxyJSON{}
-	err = handleJsonResp(resp, http.StatusOK, item)
-	return item, err
+	return doPut[ProxyJSON](c, endpoint, params)
 }
 
-// ProductProxyDeploy Promotes proxy configuration to staging
+// DeployProductProxy Promotes proxy configuration to staging.
+// Configuration changes must be deployed to staging before being promoted to production
 func (c *ThreeScaleClient) DeployProductProxy(productID int64) (*ProxyJSON, error) {
 	endpoint := fmt.Sprintf(productProxyDeployResourceEndpoint, productID)
 
@@ -542,13 +304,5 @@ func (c *ThreeScaleClient) DeployProductProxy(productID int64) (*ProxyJSON, erro
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	item := &ProxyJSON{}
-	err = handleJsonResp(resp, http.StatusCreated, item)
-	return item, err
+	return doReq[ProxyJSON](c, req, http.StatusCreated)
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -19,6 +18,11 @@ const (
 	PROXYCONFIGS_PER_PAGE int = 500
 )
 
+const (
+	ProxyConfigEnvSandbox    = "sandbox"
+	ProxyConfigEnvProduction = "production"
+)
+
 // ReadProxy - Returns the Proxy for a specific Service.
 // Deprecated - Use ProductProxy function instead
 func (c *ThreeScaleClient) ReadProxy(svcID string) (Proxy, error) {
@@ -33,7 +37,7 @@ func (c *ThreeScaleClient) ReadProxy(svcID string) (Proxy, error) {
 	values := url.Values{}
 	req.URL.RawQuery = values.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return p, err
 	}
@@ -75,7 +79,7 @@ func (c *ThreeScaleClient) UpdateProxy(svcId string, params Params) (Proxy, erro
 		return p, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return p, err
 	}
@@ -88,7 +92,35 @@ func (c *ThreeScaleClient) UpdateProxy(svcId string, params Params) (Proxy, erro
 
 // ListProxyConfig - Returns the Proxy Configs of a Service
 // env parameter should be one of 'sandbox', 'production'
-func (c *ThreeScaleClient) ListProxyConfig(svcId string, env string) (ProxyConfigList, error) {
+func (c *ThreeScaleClient) ListProxyConfig(svcId string, env string, opts ...ListOptions) (ProxyConfigList, error) {
+	configList := ProxyConfigList{}
+
+	err := c.fetchListPages(mergeListOptions(opts), PROXYCONFIGS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		pageList, err := c.listProxyConfigPage(svcId, env, options)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		return len(pageList.ProxyConfigs), pageList.Metadata, func() {
+			configList.ProxyConfigs = append(configList.ProxyConfigs, pageList.ProxyConfigs...)
+			configList.Metadata = pageList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return configList, err
+	}
+
+	return configList, nil
+}
+
+// ListProxyConfigPerPage List the Proxy Configs of a Service in a single page
+// env parameter should be one of 'sandbox', 'production'
+// paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
+// paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
+func (c *ThreeScaleClient) ListProxyConfigPerPage(svcId string, env string, paginationValues ...int) (ProxyConfigList, error) {
+	return c.listProxyConfigPage(svcId, env, paginationOptions(paginationValues))
+}
+
+func (c *ThreeScaleClient) listProxyConfigPage(svcId string, env string, options ListOptions) (ProxyConfigList, error) {
 	var pc ProxyConfigList
 
 	endpoint := fmt.Sprintf(proxyConfigList, svcId, env)
@@ -99,9 +131,10 @@ func (c *ThreeScaleClient) ListProxyConfig(svcId string, env string) (ProxyConfi
 	req.Header.Set("Accept", "application/json")
 
 	values := url.Values{}
+	options.apply(values)
 	req.URL.RawQuery = values.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return pc, err
 	}
@@ -109,12 +142,24 @@ func (c *ThreeScaleClient) ListProxyConfig(svcId string, env string) (ProxyConfi
 	defer resp.Body.Close()
 
 	err = handleJsonResp(resp, http.StatusOK, &pc)
+	pc.Metadata = pageInfo(pc.Metadata, resp.Header)
 	return pc, err
 }
 
 // PromoteProxyConfig - Promotes a Proxy Config from one environment to another environment.
+// env and toEnv parameters should be one of 'sandbox', 'production' and must differ
 func (c *ThreeScaleClient) PromoteProxyConfig(svcId string, env string, version string, toEnv string) (ProxyConfigElement, error) {
 	var pe ProxyConfigElement
+	if err := validateProxyConfigEnv(env); err != nil {
+		return pe, err
+	}
+	if err := validateProxyConfigEnv(toEnv); err != nil {
+		return pe, err
+	}
+	if env == toEnv {
+		return pe, fmt.Errorf("cannot promote proxy config to the same environment %q", env)
+	}
+
 	endpoint := fmt.Sprintf(proxyConfigPromote, svcId, env, version)
 
 	values := url.Values{}
@@ -126,7 +171,7 @@ func (c *ThreeScaleClient) PromoteProxyConfig(svcId string, env string, version
 		return pe, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return pe, err
 	}
@@ -137,6 +182,15 @@ func (c *ThreeScaleClient) PromoteProxyConfig(svcId string, env string, version
 	return pe, err
 }
 
+func validateProxyConfigEnv(env string) error {
+	switch env {
+	case ProxyConfigEnvSandbox, ProxyConfigEnvProduction:
+		return nil
+	}
+	return fmt.Errorf("invalid proxy config environment %q - must be one of '%s', '%s'",
+		env, ProxyConfigEnvSandbox, ProxyConfigEnvProduction)
+}
+
 func (c *ThreeScaleClient) getProxyConfig(endpoint string) (ProxyConfigElement, error) {
 	var pc ProxyConfigElement
 	req, err := c.buildGetReq(endpoint)
@@ -149,7 +203,7 @@ func (c *ThreeScaleClient) getProxyConfig(endpoint string) (ProxyConfigElement,
 	req.Header.Set("accept", "application/json")
 
 	start := time.Now()
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return pc, err
 	}
@@ -163,24 +217,23 @@ func (c *ThreeScaleClient) getProxyConfig(endpoint string) (ProxyConfigElement,
 	return pc, err
 }
 
-// ListProxyConfig - Returns the Proxy Configs of a Service
+// ListAccountProxyConfigs - Returns the Proxy Configs of the account
 // env parameter should be one of 'sandbox', 'production'
-func (c *ThreeScaleClient) ListAccountProxyConfigs(env string, version, host *string) (*ProxyConfigList, error) {
-	// Keep asking until the results length is lower than "per_page" param
-	currentPage := 1
+func (c *ThreeScaleClient) ListAccountProxyConfigs(env string, version, host *string, opts ...ListOptions) (*ProxyConfigList, error) {
 	configList := &ProxyConfigList{}
 
-	allResultsPerPage := false
-	for next := true; next; next = allResultsPerPage {
-		pageList, err := c.ListAccountProxyConfigsPerPage(env, version, host, currentPage, PROXYCONFIGS_PER_PAGE)
+	err := c.fetchListPages(mergeListOptions(opts), PROXYCONFIGS_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		pageList, err := c.listAccountProxyConfigsPage(env, version, host, options)
 		if err != nil {
-			return nil, err
+			return 0, nil, nil, err
 		}
-
-		configList.ProxyConfigs = append(configList.ProxyConfigs, pageList.ProxyConfigs...)
-
-		allResultsPerPage = len(pageList.ProxyConfigs) == PROXYCONFIGS_PER_PAGE
-		currentPage += 1
+		return len(pageList.ProxyConfigs), pageList.Metadata, func() {
+			configList.ProxyConfigs = append(configList.ProxyConfigs, pageList.ProxyConfigs...)
+			configList.Metadata = pageList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return configList, nil
@@ -190,6 +243,10 @@ func (c *ThreeScaleClient) ListAccountProxyConfigs(env string, version, host *st
 // paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
 // paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
 func (c *ThreeScaleClient) ListAccountProxyConfigsPerPage(env string, version, host *string, paginationValues ...int) (*ProxyConfigList, error) {
+	return c.listAccountProxyConfigsPage(env, version, host, paginationOptions(paginationValues))
+}
+
+func (c *ThreeScaleClient) listAccountProxyConfigsPage(env string, version, host *string, options ListOptions) (*ProxyConfigList, error) {
 	var pc ProxyConfigList
 
 	endpoint := fmt.Sprintf(accountProxyConfigGet, env)
@@ -206,16 +263,10 @@ func (c *ThreeScaleClient) ListAccountProxyConfigsPerPage(env string, version, h
 	if host != nil {
 		values.Add("host", *host)
 	}
-	if len(paginationValues) > 0 {
-		values.Add("page", strconv.Itoa(paginationValues[0]))
-	}
-
-	if len(paginationValues) > 1 {
-		values.Add("per_page", strconv.Itoa(paginationValues[1]))
-	}
+	options.apply(values)
 	req.URL.RawQuery = values.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -223,6 +274,7 @@ func (c *ThreeScaleClient) ListAccountProxyConfigsPerPage(env string, version, h
 	defer resp.Body.Close()
 
 	err = handleJsonResp(resp, http.StatusOK, &pc)
+	pc.Metadata = pageInfo(pc.Metadata, resp.Header)
 	if err != nil {
 		return nil, err
 	}
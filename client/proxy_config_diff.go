@@ -0,0 +1,110 @@
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// proxyConfigVolatileAttributes change on every proxy config version without being part of the configuration
+var proxyConfigVolatileAttributes = map[string]bool{"created_at": true, "updated_at": true, "lock_version": true}
+
+// ProxyConfigDiff holds the differences between the latest sandbox (staging) and production proxy configs of a product.
+// Changes are what promoting the sandbox config would apply to production: From is the production value, To the sandbox one.
+// Attribute is the path of the changed value in the config content, e.g. "proxy.policy_chain[1].configuration"
+type ProxyConfigDiff struct {
+	SandboxVersion    int
+	ProductionVersion int
+	Changes           []AttributeChange
+}
+
+// DiffProxyConfigs fetches the latest sandbox and production proxy configs of a product and compares their content,
+// including the parts Content does not model like policy configurations, ignoring timestamps and lock versions.
+// A product never promoted to production is compared against an empty config and its ProductionVersion is 0
+func (c *ThreeScaleClient) DiffProxyConfigs(productID int64) (*ProxyConfigDiff, error) {
+	sandbox, err := c.latestRawProxyConfig(productID, ProxyConfigEnvSandbox)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &ProxyConfigDiff{SandboxVersion: sandbox.ProxyConfig.Version}
+
+	var productionContent interface{} = map[string]interface{}{}
+	production, err := c.latestRawProxyConfig(productID, ProxyConfigEnvProduction)
+	switch {
+	case IsNotFound(err):
+	case err != nil:
+		return nil, err
+	default:
+		diff.ProductionVersion = production.ProxyConfig.Version
+		productionContent = production.ProxyConfig.Content
+	}
+
+	diff.Changes = diffContent("", productionContent, sandbox.ProxyConfig.Content, nil)
+	return diff, nil
+}
+
+// rawProxyConfig is a proxy config with its content decoded as generic JSON values
+type rawProxyConfig struct {
+	ProxyConfig struct {
+		Version int         `json:"version"`
+		Content interface{} `json:"content"`
+	} `json:"proxy_config"`
+}
+
+func (c *ThreeScaleClient) latestRawProxyConfig(productID int64, env string) (*rawProxyConfig, error) {
+	endpoint := fmt.Sprintf(proxyConfigLatestGet, strconv.FormatInt(productID, 10), env)
+	return doGet[rawProxyConfig](c, endpoint)
+}
+
+// diffContent appends to changes the differences between two generic JSON values found at path
+func diffContent(path string, from, to interface{}, changes []AttributeChange) []AttributeChange {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+	if fromIsMap && toIsMap {
+		keys := make([]string, 0, len(fromMap)+len(toMap))
+		for key := range fromMap {
+			keys = append(keys, key)
+		}
+		for key := range toMap {
+			if _, ok := fromMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if proxyConfigVolatileAttributes[key] {
+				continue
+			}
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			changes = diffContent(keyPath, fromMap[key], toMap[key], changes)
+		}
+		return changes
+	}
+
+	fromList, fromIsList := from.([]interface{})
+	toList, toIsList := to.([]interface{})
+	if fromIsList && toIsList {
+		for i := 0; i < len(fromList) || i < len(toList); i++ {
+			var fromItem, toItem interface{}
+			if i < len(fromList) {
+				fromItem = fromList[i]
+			}
+			if i < len(toList) {
+				toItem = toList[i]
+			}
+			changes = diffContent(fmt.Sprintf("%s[%d]", path, i), fromItem, toItem, changes)
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		changes = append(changes, AttributeChange{Attribute: path, From: from, To: to})
+	}
+	return changes
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func proxyConfigContentResponse(t *testing.T, version int, content map[string]interface{}) *http.Response {
+	return jsonTestResponse(t, http.StatusOK, map[string]interface{}{
+		"proxy_config": map[string]interface{}{"version": version, "content": content},
+	})
+}
+
+func TestDiffProxyConfigs(t *testing.T) {
+	sandboxEndpoint := fmt.Sprintf(proxyConfigLatestGet, "12", ProxyConfigEnvSandbox)
+	productionEndpoint := fmt.Sprintf(proxyConfigLatestGet, "12", ProxyConfigEnvProduction)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		switch req.URL.Path {
+		case sandboxEndpoint:
+			return proxyConfigContentResponse(t, 4, map[string]interface{}{
+				"name":       "api",
+				"updated_at": "t2",
+				"proxy": map[string]interface{}{
+					"endpoint":     "https://api.example.com",
+					"lock_version": 4,
+					"policy_chain": []interface{}{
+						map[string]interface{}{"name": "headers", "configuration": map[string]interface{}{"op": "set"}},
+						map[string]interface{}{"name": "apicast", "configuration": map[string]interface{}{}},
+					},
+				},
+			})
+		case productionEndpoint:
+			return proxyConfigContentResponse(t, 2, map[string]interface{}{
+				"name":        "api",
+				"description": "old",
+				"updated_at":  "t1",
+				"proxy": map[string]interface{}{
+					"endpoint":     "https://api.example.com",
+					"lock_version": 2,
+					"policy_chain": []interface{}{
+						map[string]interface{}{"name": "headers", "configuration": map[string]interface{}{"op": "add"}},
+					},
+				},
+			})
+		}
+		t.Fatalf("unexpected request to %s", req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	diff, err := c.DiffProxyConfigs(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, &ProxyConfigDiff{
+		SandboxVersion:    4,
+		ProductionVersion: 2,
+		Changes: []AttributeChange{
+			{Attribute: "description", From: "old", To: nil},
+			{Attribute: "proxy.policy_chain[0].configuration.op", From: "add", To: "set"},
+			{Attribute: "proxy.policy_chain[1]", From: nil, To: map[string]interface{}{"name": "apicast", "configuration": map[string]interface{}{}}},
+		},
+	}, diff)
+}
+
+func TestDiffProxyConfigsNeverPromoted(t *testing.T) {
+	sandboxEndpoint := fmt.Sprintf(proxyConfigLatestGet, "12", ProxyConfigEnvSandbox)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path == sandboxEndpoint {
+			return proxyConfigContentResponse(t, 1, map[string]interface{}{"name": "api", "created_at": "t1"})
+		}
+		return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	diff, err := c.DiffProxyConfigs(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, &ProxyConfigDiff{
+		SandboxVersion: 1,
+		Changes:        []AttributeChange{{Attribute: "name", From: nil, To: "api"}},
+	}, diff)
+}
+
+func TestDiffProxyConfigsError(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusForbidden, map[string]string{"error": "forbidden"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if _, err := c.DiffProxyConfigs(12); err == nil {
+		t.Fatal("expected error")
+	}
+}
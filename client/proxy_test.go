@@ -231,3 +231,143 @@ func TestListAccountProxyConfigsPerPage(t *testing.T) {
 		}
 	})
 }
+
+func TestPromoteProxyConfig(t *testing.T) {
+	var (
+		svcID      = "12"
+		env        = ProxyConfigEnvSandbox
+		version    = "3"
+		toEnv      = ProxyConfigEnvProduction
+		endpoint   = fmt.Sprintf(proxyConfigPromote, svcID, env, version)
+		credential = "someAccessToken"
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodPost {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPost, req.Method)
+		}
+
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		equals(t, toEnv, req.PostForm.Get("to"))
+
+		return jsonTestResponse(t, http.StatusCreated, ProxyConfigElement{
+			ProxyConfig: ProxyConfig{ID: 1, Version: 3, Environment: toEnv},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), credential, httpClient)
+	pc, err := c.PromoteProxyConfig(svcID, env, version, toEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, toEnv, pc.ProxyConfig.Environment)
+	equals(t, 3, pc.ProxyConfig.Version)
+}
+
+func TestPromoteProxyConfigInvalidEnv(t *testing.T) {
+	errorTests := []struct {
+		Name  string
+		env   string
+		toEnv string
+	}{
+		{"invalid source env", "staging", ProxyConfigEnvProduction},
+		{"invalid target env", ProxyConfigEnvSandbox, "prod"},
+		{"same env", ProxyConfigEnvSandbox, ProxyConfigEnvSandbox},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				subT.Fatalf("unexpected request to %s", req.URL.Path)
+				return nil
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", httpClient)
+			_, err := c.PromoteProxyConfig("12", tt.env, "3", tt.toEnv)
+			if err == nil {
+				subT.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestListProxyConfigPagination(t *testing.T) {
+	var (
+		svcID    = "12"
+		env      = ProxyConfigEnvProduction
+		endpoint = fmt.Sprintf(proxyConfigList, svcID, env)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		// Will serve: 2 pages
+		// page 1 => PROXYCONFIGS_PER_PAGE
+		// page 2 => 3
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.URL.Query().Get("per_page") != strconv.Itoa(PROXYCONFIGS_PER_PAGE) {
+			t.Fatalf("per_page param does not match. Expected [%d]; got [%s]", PROXYCONFIGS_PER_PAGE, req.URL.Query().Get("per_page"))
+		}
+
+		n := 0
+		switch req.URL.Query().Get("page") {
+		case "1":
+			n = PROXYCONFIGS_PER_PAGE
+		case "2":
+			n = 3
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", req.URL.Query().Get("page"))
+		}
+
+		list := ProxyConfigList{ProxyConfigs: make([]ProxyConfigElement, 0, n)}
+		for idx := 0; idx < n; idx++ {
+			list.ProxyConfigs = append(list.ProxyConfigs, ProxyConfigElement{
+				ProxyConfig: ProxyConfig{ID: idx, Version: idx + 1, Environment: env},
+			})
+		}
+
+		return jsonTestResponse(t, http.StatusOK, list)
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	pList, err := c.ListProxyConfig(svcID, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, PROXYCONFIGS_PER_PAGE+3, len(pList.ProxyConfigs))
+}
+
+func TestGetLatestProxyConfig(t *testing.T) {
+	var (
+		svcID    = "12"
+		env      = ProxyConfigEnvSandbox
+		endpoint = fmt.Sprintf(proxyConfigLatestGet, svcID, env)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		return jsonTestResponse(t, http.StatusOK, ProxyConfigElement{
+			ProxyConfig: ProxyConfig{ID: 7, Version: 4, Environment: env},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	pc, err := c.GetLatestProxyConfig(svcID, env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, ProxyConfig{ID: 7, Version: 4, Environment: env}, pc.ProxyConfig)
+}
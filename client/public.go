@@ -0,0 +1,74 @@
+package client
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	apicastLiveEndpoint  = "/status/live"
+	apicastReadyEndpoint = "/status/ready"
+)
+
+// NewPublicClient creates a client for public endpoints that do not require credentials.
+// If http Client is nil, the default http client will be used
+func NewPublicClient(httpClient *http.Client) *PublicClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &PublicClient{httpClient: httpClient}
+}
+
+// PublicClient returns a client for public endpoints sharing the http client of the ThreeScaleClient.
+// No credentials are sent by the returned client.
+func (c *ThreeScaleClient) PublicClient() *PublicClient {
+	return NewPublicClient(c.httpClient)
+}
+
+// CheckDeveloperPortal checks the root page of the developer portal, i.e. https://tenant.example.com
+func (c *PublicClient) CheckDeveloperPortal(portalURL string) (*EndpointStatus, error) {
+	return c.CheckEndpoint(strings.TrimSuffix(portalURL, "/") + "/")
+}
+
+// CheckAPIcastLiveness checks the liveness status endpoint of the APIcast management API, i.e. http://apicast:8090
+func (c *PublicClient) CheckAPIcastLiveness(managementURL string) (*EndpointStatus, error) {
+	return c.CheckEndpoint(strings.TrimSuffix(managementURL, "/") + apicastLiveEndpoint)
+}
+
+// CheckAPIcastReadiness checks the readiness status endpoint of the APIcast management API, i.e. http://apicast:8090
+func (c *PublicClient) CheckAPIcastReadiness(managementURL string) (*EndpointStatus, error) {
+	return c.CheckEndpoint(strings.TrimSuffix(managementURL, "/") + apicastReadyEndpoint)
+}
+
+// CheckEndpoint sends an unauthenticated GET request to the given URL.
+// Any 2xx response is reported as healthy. An error is only returned when the request could not be sent.
+func (c *PublicClient) CheckEndpoint(rawURL string) (*EndpointStatus, error) {
+	if _, err := verifyUrl(rawURL); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", rawURL, nil)
+	if err != nil {
+		return nil, httpReqError
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// drain the body so the connection can be reused
+	io.Copy(ioutil.Discard, resp.Body)
+
+	return &EndpointStatus{
+		URL:        rawURL,
+		StatusCode: resp.StatusCode,
+		Duration:   time.Since(start),
+		Healthy:    resp.StatusCode >= 200 && resp.StatusCode < 300,
+	}, nil
+}
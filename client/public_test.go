@@ -0,0 +1,82 @@
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPublicClientCheckEndpoints(t *testing.T) {
+	inputs := []struct {
+		name            string
+		check           func(c *PublicClient) (*EndpointStatus, error)
+		expectedPath    string
+		responseCode    int
+		expectedHealthy bool
+	}{
+		{
+			name: "DeveloperPortal",
+			check: func(c *PublicClient) (*EndpointStatus, error) {
+				return c.CheckDeveloperPortal("https://tenant.example.com")
+			},
+			expectedPath:    "/",
+			responseCode:    http.StatusOK,
+			expectedHealthy: true,
+		},
+		{
+			name: "APIcastLiveness",
+			check: func(c *PublicClient) (*EndpointStatus, error) {
+				return c.CheckAPIcastLiveness("http://apicast:8090/")
+			},
+			expectedPath:    apicastLiveEndpoint,
+			responseCode:    http.StatusOK,
+			expectedHealthy: true,
+		},
+		{
+			name: "APIcastNotReady",
+			check: func(c *PublicClient) (*EndpointStatus, error) {
+				return c.CheckAPIcastReadiness("http://apicast:8090")
+			},
+			expectedPath:    apicastReadyEndpoint,
+			responseCode:    http.StatusServiceUnavailable,
+			expectedHealthy: false,
+		},
+	}
+
+	for _, input := range inputs {
+		t.Run(input.name, func(subTest *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				if req.URL.Path != input.expectedPath {
+					subTest.Fatalf("Path does not match. Expected [%s]; got [%s]", input.expectedPath, req.URL.Path)
+				}
+
+				if req.Header.Get("Authorization") != "" {
+					subTest.Fatal("Authorization header not expected")
+				}
+
+				return &http.Response{
+					StatusCode: input.responseCode,
+					Body:       ioutil.NopCloser(strings.NewReader("")),
+					Header:     make(http.Header),
+				}
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subTest), "someAccessToken", httpClient).PublicClient()
+			status, err := input.check(c)
+			if err != nil {
+				subTest.Fatal(err)
+			}
+
+			equals(subTest, input.responseCode, status.StatusCode)
+			equals(subTest, input.expectedHealthy, status.Healthy)
+		})
+	}
+}
+
+func TestPublicClientInvalidURL(t *testing.T) {
+	c := NewPublicClient(nil)
+	if _, err := c.CheckEndpoint("ftp://apicast"); err == nil {
+		t.Fatal("expected error for unsupported scheme")
+	}
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Actions reported in RateLimitPlanResult
+const (
+	RateLimitCreated   = "created"
+	RateLimitUpdated   = "updated"
+	RateLimitUnchanged = "unchanged"
+)
+
+var rateLimitPeriods = []string{"eternity", "year", "month", "week", "day", "hour", "minute"}
+
+// ApplyRateLimits applies every rate limit spec to the product, in order.
+// See ApplyRateLimit
+func (c *ThreeScaleClient) ApplyRateLimits(productID int64, specs []RateLimitSpec) ([]RateLimitResult, error) {
+	results := make([]RateLimitResult, 0, len(specs))
+	for _, spec := range specs {
+		result, err := c.ApplyRateLimit(productID, spec)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// ApplyRateLimit makes the product match the rate limit spec:
+// the metric is created when missing, the mapping rule is created when a pattern is given and
+// the limit of every selected application plan is created or updated to the spec value.
+// Applying the same spec twice does not change anything
+func (c *ThreeScaleClient) ApplyRateLimit(productID int64, spec RateLimitSpec) (*RateLimitResult, error) {
+	if err := validateRateLimitSpec(spec); err != nil {
+		return nil, err
+	}
+
+	plans, err := c.selectRateLimitPlans(productID, spec.Plans)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RateLimitResult{}
+
+	result.MetricID, result.MetricCreated, err = c.ensureRateLimitMetric(productID, spec.Metric)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Pattern != "" {
+		result.MappingRuleID, result.MappingRuleCreated, err = c.ensureRateLimitMappingRule(productID, result.MetricID, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, plan := range plans {
+		planResult, err := c.ensureRateLimitPlanLimit(plan.ID, result.MetricID, spec)
+		if err != nil {
+			return nil, err
+		}
+		result.Limits = append(result.Limits, *planResult)
+	}
+
+	return result, nil
+}
+
+func validateRateLimitSpec(spec RateLimitSpec) error {
+	if spec.Metric == "" {
+		return fmt.Errorf("rate limit spec needs a metric")
+	}
+
+	if spec.Value < 0 {
+		return fmt.Errorf("rate limit spec for metric %s has negative value %d", spec.Metric, spec.Value)
+	}
+
+	for _, period := range rateLimitPeriods {
+		if spec.Period == period {
+			return nil
+		}
+	}
+	return fmt.Errorf("rate limit spec for metric %s has invalid period %q - must be one of %s",
+		spec.Metric, spec.Period, strings.Join(rateLimitPeriods, ", "))
+}
+
+func (c *ThreeScaleClient) selectRateLimitPlans(productID int64, systemNames []string) ([]ApplicationPlanItem, error) {
+	list, err := c.ListApplicationPlansByProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(systemNames) == 0 {
+		plans := make([]ApplicationPlanItem, 0, len(list.Plans))
+		for _, plan := range list.Plans {
+			plans = append(plans, plan.Element)
+		}
+		return plans, nil
+	}
+
+	bySystemName := map[string]ApplicationPlanItem{}
+	for _, plan := range list.Plans {
+		bySystemName[plan.Element.SystemName] = plan.Element
+	}
+
+	plans := make([]ApplicationPlanItem, 0, len(systemNames))
+	for _, systemName := range systemNames {
+		plan, ok := bySystemName[systemName]
+		if !ok {
+			return nil, fmt.Errorf("application plan %s not found in product %d", systemName, productID)
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+func (c *ThreeScaleClient) ensureRateLimitMetric(productID int64, systemName string) (int64, bool, error) {
+	list, err := c.ListProductMetrics(productID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, metric := range list.Metrics {
+		if metric.Element.SystemName == systemName {
+			return metric.Element.ID, false, nil
+		}
+	}
+
+	metric, err := c.CreateProductMetric(productID, Params{
+		"friendly_name": systemName,
+		"system_name":   systemName,
+		"unit":          "hit",
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return metric.Element.ID, true, nil
+}
+
+func (c *ThreeScaleClient) ensureRateLimitMappingRule(productID, metricID int64, spec RateLimitSpec) (int64, bool, error) {
+	httpMethod := strings.ToUpper(spec.HTTPMethod)
+	if httpMethod == "" {
+		httpMethod = "GET"
+	}
+
+	list, err := c.ListProductMappingRules(productID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, rule := range list.MappingRules {
+		if rule.Element.MetricID == metricID && rule.Element.Pattern == spec.Pattern && rule.Element.HTTPMethod == httpMethod {
+			return rule.Element.ID, false, nil
+		}
+	}
+
+	rule, err := c.CreateProductMappingRule(productID, Params{
+		"http_method": httpMethod,
+		"pattern":     spec.Pattern,
+		"metric_id":   strconv.FormatInt(metricID, 10),
+		"delta":       "1",
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return rule.Element.ID, true, nil
+}
+
+func (c *ThreeScaleClient) ensureRateLimitPlanLimit(planID, metricID int64, spec RateLimitSpec) (*RateLimitPlanResult, error) {
+	list, err := c.ListApplicationPlansLimits(planID)
+	if err != nil {
+		return nil, err
+	}
+
+	value := strconv.Itoa(spec.Value)
+
+	for _, limit := range list.Limits {
+		if limit.Element.MetricID != metricID || limit.Element.Period != spec.Period {
+			continue
+		}
+
+		if limit.Element.Value == spec.Value {
+			return &RateLimitPlanResult{PlanID: planID, Limit: limit.Element, Action: RateLimitUnchanged}, nil
+		}
+
+		updated, err := c.UpdateApplicationPlanLimit(planID, metricID, limit.Element.ID, Params{"value": value})
+		if err != nil {
+			return nil, err
+		}
+		return &RateLimitPlanResult{PlanID: planID, Limit: updated.Element, Action: RateLimitUpdated}, nil
+	}
+
+	created, err := c.CreateApplicationPlanLimit(planID, metricID, Params{"period": spec.Period, "value": value})
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimitPlanResult{PlanID: planID, Limit: created.Element, Action: RateLimitCreated}, nil
+}
@@ -0,0 +1,158 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestApplyRateLimit(t *testing.T) {
+	const productID int64 = 1
+
+	var (
+		plansEndpoint        = fmt.Sprintf(appPlanListResourceEndpoint, productID)
+		metricsEndpoint      = fmt.Sprintf(productMetricListResourceEndpoint, productID)
+		mappingRulesEndpoint = fmt.Sprintf(productMappingRuleListResourceEndpoint, productID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodPost || req.Method == http.MethodPut {
+			if err := req.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		switch req.Method + " " + req.URL.Path {
+		case "GET " + plansEndpoint:
+			return jsonTestResponse(t, http.StatusOK, ApplicationPlanJSONList{Plans: []ApplicationPlan{
+				{Element: ApplicationPlanItem{ID: 10, SystemName: "basic"}},
+				{Element: ApplicationPlanItem{ID: 11, SystemName: "pro"}},
+				{Element: ApplicationPlanItem{ID: 12, SystemName: "internal"}},
+			}})
+		case "GET " + metricsEndpoint:
+			return jsonTestResponse(t, http.StatusOK, MetricJSONList{Metrics: []MetricJSON{
+				{Element: MetricItem{ID: 2, SystemName: "hits"}},
+			}})
+		case "POST " + metricsEndpoint:
+			equals(t, "search", req.PostForm.Get("system_name"))
+			return jsonTestResponse(t, http.StatusCreated, MetricJSON{Element: MetricItem{ID: 3, SystemName: "search"}})
+		case "GET " + mappingRulesEndpoint:
+			return jsonTestResponse(t, http.StatusOK, MappingRuleJSONList{})
+		case "POST " + mappingRulesEndpoint:
+			equals(t, "POST", req.PostForm.Get("http_method"))
+			equals(t, "/search", req.PostForm.Get("pattern"))
+			equals(t, "3", req.PostForm.Get("metric_id"))
+			return jsonTestResponse(t, http.StatusCreated, MappingRuleJSON{Element: MappingRuleItem{ID: 20, MetricID: 3}})
+		case "GET " + fmt.Sprintf(appPlanLimitListResourceEndpoint, 10):
+			return jsonTestResponse(t, http.StatusOK, ApplicationPlanLimitList{Limits: []ApplicationPlanLimit{
+				{Element: ApplicationPlanLimitItem{ID: 30, MetricID: 3, Period: "day", Value: 100}},
+			}})
+		case "PUT " + fmt.Sprintf(appPlanLimitPerMetricResourceEndpoint, 10, 3, 30):
+			equals(t, "500", req.PostForm.Get("value"))
+			return jsonTestResponse(t, http.StatusOK, ApplicationPlanLimit{Element: ApplicationPlanLimitItem{ID: 30, MetricID: 3, Period: "day", Value: 500}})
+		case "GET " + fmt.Sprintf(appPlanLimitListResourceEndpoint, 11):
+			return jsonTestResponse(t, http.StatusOK, ApplicationPlanLimitList{Limits: []ApplicationPlanLimit{
+				{Element: ApplicationPlanLimitItem{ID: 31, MetricID: 3, Period: "hour", Value: 500}},
+			}})
+		case "POST " + fmt.Sprintf(appPlanLimitListPerMetricResourceEndpoint, 11, 3):
+			equals(t, "day", req.PostForm.Get("period"))
+			equals(t, "500", req.PostForm.Get("value"))
+			return jsonTestResponse(t, http.StatusCreated, ApplicationPlanLimit{Element: ApplicationPlanLimitItem{ID: 32, MetricID: 3, Period: "day", Value: 500}})
+		}
+
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	result, err := c.ApplyRateLimit(productID, RateLimitSpec{
+		Metric:     "search",
+		Period:     "day",
+		Value:      500,
+		Plans:      []string{"basic", "pro"},
+		Pattern:    "/search",
+		HTTPMethod: "post",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, &RateLimitResult{
+		MetricID:           3,
+		MetricCreated:      true,
+		MappingRuleID:      20,
+		MappingRuleCreated: true,
+		Limits: []RateLimitPlanResult{
+			{PlanID: 10, Limit: ApplicationPlanLimitItem{ID: 30, MetricID: 3, Period: "day", Value: 500}, Action: RateLimitUpdated},
+			{PlanID: 11, Limit: ApplicationPlanLimitItem{ID: 32, MetricID: 3, Period: "day", Value: 500}, Action: RateLimitCreated},
+		},
+	}, result)
+}
+
+func TestApplyRateLimitUnchanged(t *testing.T) {
+	const productID int64 = 1
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+
+		switch req.URL.Path {
+		case fmt.Sprintf(appPlanListResourceEndpoint, productID):
+			return jsonTestResponse(t, http.StatusOK, ApplicationPlanJSONList{Plans: []ApplicationPlan{
+				{Element: ApplicationPlanItem{ID: 10, SystemName: "basic"}},
+			}})
+		case fmt.Sprintf(productMetricListResourceEndpoint, productID):
+			return jsonTestResponse(t, http.StatusOK, MetricJSONList{Metrics: []MetricJSON{
+				{Element: MetricItem{ID: 2, SystemName: "hits"}},
+			}})
+		case fmt.Sprintf(appPlanLimitListResourceEndpoint, 10):
+			return jsonTestResponse(t, http.StatusOK, ApplicationPlanLimitList{Limits: []ApplicationPlanLimit{
+				{Element: ApplicationPlanLimitItem{ID: 30, MetricID: 2, Period: "minute", Value: 10}},
+			}})
+		}
+
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	results, err := c.ApplyRateLimits(productID, []RateLimitSpec{{Metric: "hits", Period: "minute", Value: 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, len(results))
+	equals(t, false, results[0].MetricCreated)
+	equals(t, RateLimitUnchanged, results[0].Limits[0].Action)
+}
+
+func TestApplyRateLimitErrors(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != fmt.Sprintf(appPlanListResourceEndpoint, 1) {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return jsonTestResponse(t, http.StatusOK, ApplicationPlanJSONList{Plans: []ApplicationPlan{
+			{Element: ApplicationPlanItem{ID: 10, SystemName: "basic"}},
+		}})
+	})
+
+	errorTests := []struct {
+		Name string
+		spec RateLimitSpec
+	}{
+		{"missing metric", RateLimitSpec{Period: "day", Value: 1}},
+		{"invalid period", RateLimitSpec{Metric: "hits", Period: "fortnight", Value: 1}},
+		{"negative value", RateLimitSpec{Metric: "hits", Period: "day", Value: -1}},
+		{"unknown plan", RateLimitSpec{Metric: "hits", Period: "day", Value: 1, Plans: []string{"gold"}}},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", httpClient)
+			if _, err := c.ApplyRateLimit(1, tt.spec); err == nil {
+				subT.Fatal("expected error")
+			}
+		})
+	}
+}
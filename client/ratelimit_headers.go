@@ -0,0 +1,87 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit holds the rate limiting headers of a 3scale response.
+// Fields not sent by the server are left as -1
+type RateLimit struct {
+	// Limit is the number of requests allowed in the current window
+	Limit int
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// Reset is the time left until the window resets
+	Reset time.Duration
+	// RetryAfter is how long to wait before retrying, from the Retry-After header
+	RetryAfter time.Duration
+}
+
+// RateLimitCB is invoked with the rate limiting headers of every response carrying them
+type RateLimitCB func(limit RateLimit)
+
+// SetRateLimitHook sets the callback invoked with the rate limiting headers of the responses carrying them,
+// to implement adaptive pacing. A nil callback disables it
+func (c *ThreeScaleClient) SetRateLimitHook(cb RateLimitCB) {
+	c.rateLimitHook = cb
+}
+
+func (c *ThreeScaleClient) notifyRateLimit(resp *http.Response) {
+	if c.rateLimitHook == nil || resp == nil {
+		return
+	}
+	if limit, ok := parseRateLimit(resp.Header, time.Now()); ok {
+		c.rateLimitHook(limit)
+	}
+}
+
+// parseRateLimit reads RateLimit-*, X-RateLimit-* and Retry-After headers.
+// Returns false when none of them is set
+func parseRateLimit(header http.Header, now time.Time) (RateLimit, bool) {
+	limit := RateLimit{Limit: -1, Remaining: -1, Reset: -1, RetryAfter: -1}
+	found := false
+
+	if value, ok := rateLimitHeader(header, "Limit"); ok {
+		limit.Limit, found = value, true
+	}
+	if value, ok := rateLimitHeader(header, "Remaining"); ok {
+		limit.Remaining, found = value, true
+	}
+	if value, ok := rateLimitHeader(header, "Reset"); ok {
+		limit.Reset, found = time.Duration(value)*time.Second, true
+	}
+
+	if retryAfter := strings.TrimSpace(header.Get("Retry-After")); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			limit.RetryAfter, found = time.Duration(seconds)*time.Second, true
+		} else if date, err := http.ParseTime(retryAfter); err == nil {
+			limit.RetryAfter, found = date.Sub(now), true
+			if limit.RetryAfter < 0 {
+				limit.RetryAfter = 0
+			}
+		}
+	}
+
+	return limit, found
+}
+
+// rateLimitHeader reads the RateLimit-<name> header, falling back to X-RateLimit-<name>
+func rateLimitHeader(header http.Header, name string) (int, bool) {
+	for _, key := range []string{"RateLimit-" + name, "X-RateLimit-" + name} {
+		raw := strings.TrimSpace(header.Get(key))
+		if raw == "" {
+			continue
+		}
+		// structured values like "100;w=60" carry the number first
+		if idx := strings.IndexAny(raw, ";,"); idx >= 0 {
+			raw = raw[:idx]
+		}
+		if value, err := strconv.Atoi(strings.TrimSpace(raw)); err == nil {
+			return value, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Date(2020, 3, 31, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		Name     string
+		header   http.Header
+		expected RateLimit
+		found    bool
+	}{
+		{"none", http.Header{}, RateLimit{Limit: -1, Remaining: -1, Reset: -1, RetryAfter: -1}, false},
+		{
+			"draft headers",
+			http.Header{"Ratelimit-Limit": {"100"}, "Ratelimit-Remaining": {"0"}, "Ratelimit-Reset": {"30"}},
+			RateLimit{Limit: 100, Remaining: 0, Reset: 30 * time.Second, RetryAfter: -1}, true,
+		},
+		{
+			"x headers with policy",
+			http.Header{"X-Ratelimit-Limit": {"100;w=60"}, "X-Ratelimit-Remaining": {"7"}},
+			RateLimit{Limit: 100, Remaining: 7, Reset: -1, RetryAfter: -1}, true,
+		},
+		{
+			"retry after seconds",
+			http.Header{"Retry-After": {"120"}},
+			RateLimit{Limit: -1, Remaining: -1, Reset: -1, RetryAfter: 2 * time.Minute}, true,
+		},
+		{
+			"retry after date",
+			http.Header{"Retry-After": {now.Add(time.Minute).Format(http.TimeFormat)}},
+			RateLimit{Limit: -1, Remaining: -1, Reset: -1, RetryAfter: time.Minute}, true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subTest *testing.T) {
+			limit, found := parseRateLimit(tt.header, now)
+			equals(subTest, tt.found, found)
+			equals(subTest, tt.expected, limit)
+		})
+	}
+}
+
+func TestRateLimitHook(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		resp := jsonTestResponse(t, http.StatusOK, ProductList{})
+		resp.Header.Set("RateLimit-Remaining", "3")
+		return resp
+	})
+
+	var limits []RateLimit
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetRateLimitHook(func(limit RateLimit) {
+		limits = append(limits, limit)
+	})
+
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 1, len(limits))
+	equals(t, 3, limits[0].Remaining)
+}
+
+func TestRateLimitedErr(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		resp := jsonTestResponse(t, http.StatusTooManyRequests, map[string]string{"error": "Too many requests"})
+		resp.Header.Set("Retry-After", "5")
+		return resp
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	_, err := c.ListProductsPerPage()
+
+	if !IsRateLimited(err) || !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected rate limited error; got %v", err)
+	}
+
+	rateLimitedErr := RateLimitedErr{}
+	if !errors.As(err, &rateLimitedErr) {
+		t.Fatalf("expected RateLimitedErr; got %T", err)
+	}
+	equals(t, 5*time.Second, rateLimitedErr.RateLimit.RetryAfter)
+	equals(t, http.StatusTooManyRequests, rateLimitedErr.Code())
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// redactedValue replaces credentials in the String outputs and errors
+const redactedValue = "REDACTED"
+
+// String returns the admin portal URL without user info and with credential query params redacted,
+// so that AdminPortal values can safely be logged with %v
+func (a *AdminPortal) String() string {
+	if a == nil {
+		return "<nil>"
+	}
+	if a.url != nil {
+		return sanitizeURL(a.url)
+	}
+	return redactURLString(a.rawURL)
+}
+
+// GoString implements fmt.GoStringer, so that %#v never prints the credentials either
+func (a *AdminPortal) GoString() string {
+	return fmt.Sprintf("&client.AdminPortal{%q}", a.String())
+}
+
+// String describes the client by its admin portal, never printing the access token,
+// so that clients can safely be logged with %v
+func (c *ThreeScaleClient) String() string {
+	if c == nil {
+		return "<nil>"
+	}
+	if !c.hasCredential() {
+		return fmt.Sprintf("ThreeScaleClient{adminPortal: %s}", c.adminPortal)
+	}
+	return fmt.Sprintf("ThreeScaleClient{adminPortal: %s, credential: %s}", c.adminPortal, redactedValue)
+}
+
+// GoString implements fmt.GoStringer, so that %#v never prints the access token either
+func (c *ThreeScaleClient) GoString() string {
+	return "&client." + c.String()
+}
+
+func (c *ThreeScaleClient) hasCredential() bool {
+	return c.credential != "" || c.credentials != nil || c.credentialProvider != nil
+}
+
+// redactErr redacts the user info and credential query params of the URL embedded in err,
+// i.e. the *url.Error returned by the http client on transport failures
+func redactErr(err error) error {
+	var urlErr *url.Error
+	if err == nil || !errors.As(err, &urlErr) {
+		return err
+	}
+	urlErr.URL = redactURLString(urlErr.URL)
+	return err
+}
+
+// redactURLString sanitizes a raw URL, see sanitizeURL. URLs that cannot be parsed are fully redacted
+func redactURLString(rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return redactedValue
+	}
+	return sanitizeURL(parsed)
+}
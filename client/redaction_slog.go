@@ -0,0 +1,23 @@
+//go:build go1.21
+// +build go1.21
+
+package client
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer, logging the admin portal without credentials
+func (a *AdminPortal) LogValue() slog.Value {
+	return slog.StringValue(a.String())
+}
+
+// LogValue implements slog.LogValuer, logging the admin portal and never the access token
+func (c *ThreeScaleClient) LogValue() slog.Value {
+	if c == nil {
+		return slog.StringValue("<nil>")
+	}
+	attrs := []slog.Attr{slog.String("admin_portal", c.adminPortal.String())}
+	if c.hasCredential() {
+		attrs = append(attrs, slog.String("credential", redactedValue))
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,33 @@
+//go:build go1.21
+// +build go1.21
+
+package client
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogValueRedacted(t *testing.T) {
+	adminPortal, err := NewAdminPortalFromStr("https://secretToken@tenant-admin.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewThreeScale(adminPortal, "secretToken", nil)
+
+	out := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(out, nil))
+	logger.Info("syncing", "client", c, "portal", adminPortal)
+
+	if strings.Contains(out.String(), "secretToken") {
+		t.Fatalf("log output leaks the credential: %s", out.String())
+	}
+	if !strings.Contains(out.String(), "client.admin_portal=https://tenant-admin.example.com client.credential=REDACTED") {
+		t.Fatalf("unexpected log output: %s", out.String())
+	}
+	if !strings.Contains(out.String(), "portal=https://tenant-admin.example.com") {
+		t.Fatalf("unexpected log output: %s", out.String())
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestAdminPortalString(t *testing.T) {
+	adminPortal, err := NewAdminPortalFromStr("https://secretToken@tenant-admin.example.com?access_token=secretToken")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []string{"%v", "%s", "%+v", "%#v"} {
+		out := fmt.Sprintf(format, adminPortal)
+		if strings.Contains(out, "secretToken") {
+			t.Fatalf("%s output leaks the credential: %s", format, out)
+		}
+		if !strings.Contains(out, "tenant-admin.example.com") {
+			t.Fatalf("%s output misses the host: %s", format, out)
+		}
+	}
+}
+
+func TestThreeScaleClientString(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "secretToken", nil)
+
+	for _, format := range []string{"%v", "%s", "%+v", "%#v"} {
+		out := fmt.Sprintf(format, c)
+		if strings.Contains(out, "secretToken") {
+			t.Fatalf("%s output leaks the credential: %s", format, out)
+		}
+	}
+	equals(t, "ThreeScaleClient{adminPortal: https://www.test.com:443, credential: REDACTED}", c.String())
+
+	c.SetCredentials("")
+	equals(t, "ThreeScaleClient{adminPortal: https://www.test.com:443}", c.String())
+}
+
+func TestTransportErrorRedacted(t *testing.T) {
+	transportErr := errors.New("connection refused")
+	httpClient := &http.Client{Transport: failingTransport{transportErr}}
+
+	adminPortal, err := NewAdminPortalFromStr("https://secretToken@tenant-admin.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewThreeScale(adminPortal, "secretToken", httpClient)
+
+	_, err = c.ListCMSTemplatesPerPage(Params{"access_token": "secretToken"})
+	if err == nil {
+		t.Fatal("expected transport error")
+	}
+	if strings.Contains(err.Error(), "secretToken") {
+		t.Fatalf("error leaks the credential: %v", err)
+	}
+	if !errors.Is(err, transportErr) {
+		t.Fatalf("expected the transport error to be wrapped; got %v", err)
+	}
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Fatalf("expected *url.Error; got %T", err)
+	}
+	equals(t, "https://tenant-admin.example.com/admin/api/cms/templates.json?access_token=REDACTED", urlErr.URL)
+}
@@ -0,0 +1,194 @@
+package client
+
+// Typed params for endpoints taking Params.
+// Nil fields are not sent, Extra holds custom fields defined in the admin portal.
+// Typed fields take precedence over Extra entries with the same name
+//
+//	params, err := AccountCreateParams{OrgName: "ACME", Username: "john", Email: "john@example.com"}.Params()
+//	if err != nil {
+//		return err
+//	}
+//	account, err := c.Signup(params)
+
+// AccountCreateParams - Params for Signup
+type AccountCreateParams struct {
+	OrgName           string `param:"org_name"`
+	Username          string `param:"username"`
+	Email             string `param:"email"`
+	Password          string `param:"password,omitempty"`
+	AccountPlanID     *int64 `param:"account_plan_id"`
+	ServicePlanID     *int64 `param:"service_plan_id"`
+	ApplicationPlanID *int64 `param:"application_plan_id"`
+	Extra             Params `param:"-"`
+}
+
+// Params encodes the account signup params
+func (p AccountCreateParams) Params() (Params, error) {
+	return typedParams(p, p.Extra)
+}
+
+// ApplicationCreateParams - Params for CreateApplication
+type ApplicationCreateParams struct {
+	Description *string `param:"description"`
+	// UserKey of the application, generated by 3scale when not set
+	UserKey *string `param:"user_key"`
+	// ApplicationID and ApplicationKey are the app_id and app_key credentials, generated by 3scale when not set
+	ApplicationID  *string `param:"application_id"`
+	ApplicationKey *string `param:"application_key"`
+	RedirectURL    *string `param:"redirect_url"`
+	Extra          Params  `param:"-"`
+}
+
+// Params encodes the application create params
+func (p ApplicationCreateParams) Params() (Params, error) {
+	return typedParams(p, p.Extra)
+}
+
+// ApplicationUpdateParams - Params for UpdateApplication
+type ApplicationUpdateParams struct {
+	Name        *string `param:"name"`
+	Description *string `param:"description"`
+	RedirectURL *string `param:"redirect_url"`
+	Extra       Params  `param:"-"`
+}
+
+// Params encodes the application update params
+func (p ApplicationUpdateParams) Params() (Params, error) {
+	return typedParams(p, p.Extra)
+}
+
+// ApplicationPlanParams - Params for CreateApplicationPlan and UpdateApplicationPlan
+type ApplicationPlanParams struct {
+	Name               *string  `param:"name"`
+	SystemName         *string  `param:"system_name"`
+	ApprovalRequired   *bool    `param:"approval_required"`
+	TrialPeriodDays    *int     `param:"trial_period_days"`
+	SetupFee           *float64 `param:"setup_fee"`
+	CostPerMonth       *float64 `param:"cost_per_month"`
+	CancellationPeriod *int     `param:"cancellation_period"`
+	// StateEvent transitions the plan state, i.e. "publish" or "hide"
+	StateEvent *string `param:"state_event"`
+}
+
+// Params encodes the application plan params
+func (p ApplicationPlanParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// ProductParams - Params for CreateProduct and UpdateProduct
+type ProductParams struct {
+	Name             *string `param:"name"`
+	SystemName       *string `param:"system_name"`
+	Description      *string `param:"description"`
+	DeploymentOption *string `param:"deployment_option"`
+	BackendVersion   *string `param:"backend_version"`
+}
+
+// Params encodes the product params
+func (p ProductParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// BackendApiParams - Params for CreateBackendApi and UpdateBackendApi
+type BackendApiParams struct {
+	Name            *string `param:"name"`
+	SystemName      *string `param:"system_name"`
+	Description     *string `param:"description"`
+	PrivateEndpoint *string `param:"private_endpoint"`
+}
+
+// Params encodes the backend API params
+func (p BackendApiParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// BackendUsageParams - Params for CreateBackendapiUsage and UpdateBackendapiUsage
+type BackendUsageParams struct {
+	BackendAPIID *int64  `param:"backend_api_id"`
+	Path         *string `param:"path"`
+}
+
+// Params encodes the backend usage params
+func (p BackendUsageParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// MethodParams - Params for the create and update method endpoints of products and backend APIs
+type MethodParams struct {
+	FriendlyName *string `param:"friendly_name"`
+	SystemName   *string `param:"system_name"`
+	Description  *string `param:"description"`
+}
+
+// Params encodes the method params
+func (p MethodParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// MetricParams - Params for the create and update metric endpoints of products and backend APIs
+type MetricParams struct {
+	FriendlyName *string `param:"friendly_name"`
+	SystemName   *string `param:"system_name"`
+	Unit         *string `param:"unit"`
+	Description  *string `param:"description"`
+}
+
+// Params encodes the metric params
+func (p MetricParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// MappingRuleParams - Params for the create and update mapping rule endpoints of products and backend APIs
+type MappingRuleParams struct {
+	HTTPMethod *string `param:"http_method"`
+	Pattern    *string `param:"pattern"`
+	MetricID   *int64  `param:"metric_id"`
+	Delta      *int    `param:"delta"`
+	Position   *int    `param:"position"`
+	Last       *bool   `param:"last"`
+}
+
+// Params encodes the mapping rule params
+func (p MappingRuleParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// ApplicationPlanLimitParams - Params for CreateApplicationPlanLimit and UpdateApplicationPlanLimit
+type ApplicationPlanLimitParams struct {
+	// Period of the limit, i.e. "eternity", "year", "month", "week", "day", "hour" or "minute"
+	Period *string `param:"period"`
+	Value  *int    `param:"value"`
+}
+
+// Params encodes the application plan limit params
+func (p ApplicationPlanLimitParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// FeatureParams - Params for CreateServiceFeature and UpdateServiceFeature
+type FeatureParams struct {
+	Name        *string `param:"name"`
+	SystemName  *string `param:"system_name"`
+	Description *string `param:"description"`
+	// Scope of the feature, see FeatureScope constants
+	Scope *string `param:"scope"`
+}
+
+// Params encodes the feature params
+func (p FeatureParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+func typedParams(v interface{}, extra Params) (Params, error) {
+	params, err := ParamsFromStruct(v)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range extra {
+		if _, ok := params[k]; !ok {
+			params.AddParam(k, v)
+		}
+	}
+	return params, nil
+}
@@ -0,0 +1,194 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestAccountCreateParams(t *testing.T) {
+	var planID int64 = 5
+
+	params, err := AccountCreateParams{
+		OrgName:           "ACME",
+		Username:          "john",
+		Email:             "john@example.com",
+		ApplicationPlanID: &planID,
+		Extra:             Params{"vat_code": "ES123", "org_name": "ignored"},
+	}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, Params{
+		"org_name":            "ACME",
+		"username":            "john",
+		"email":               "john@example.com",
+		"application_plan_id": "5",
+		"vat_code":            "ES123",
+	}, params)
+}
+
+func TestApplicationPlanParams(t *testing.T) {
+	var (
+		approval = true
+		cost     = 9.5
+		event    = "publish"
+	)
+
+	params, err := ApplicationPlanParams{ApprovalRequired: &approval, CostPerMonth: &cost, StateEvent: &event}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, Params{"approval_required": "true", "cost_per_month": "9.5", "state_event": "publish"}, params)
+}
+
+func TestApplicationUpdateParams(t *testing.T) {
+	var (
+		accountID   int64 = 3
+		appID       int64 = 20
+		description       = "Webshop"
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Form:   map[string]string{"description": description, "tier": "gold"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, ApplicationElem{Application: Application{ID: appID, Description: description}})
+	})
+
+	params, err := ApplicationUpdateParams{Description: &description, Extra: Params{"tier": "gold"}}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 2, len(params))
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	app, err := c.UpdateApplication(accountID, appID, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, description, app.Description)
+}
+
+func TestProductParams(t *testing.T) {
+	name := "Echo API"
+
+	params, err := ProductParams{Name: &name}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, Params{"name": name}, params)
+}
+
+func TestBackendApiParamsUnsetAndEmpty(t *testing.T) {
+	var (
+		id          int64 = 7
+		name              = "Echo backend"
+		description       = ""
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPut,
+			Path:   fmt.Sprintf(backendResourceEndpoint, id),
+			Form:   map[string]string{"name": name},
+		}.Assert(t, req)
+
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := req.PostForm["description"]; ok {
+			t.Fatal("unset description must not be sent")
+		}
+
+		return jsonTestResponse(t, http.StatusOK, BackendApi{Element: BackendApiItem{ID: id, Name: name, Description: "kept"}})
+	})
+
+	params, err := BackendApiParams{Name: &name}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	backend, err := c.UpdateBackendApi(id, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "kept", backend.Element.Description)
+
+	// an empty string is sent, clearing the description
+	params, err = BackendApiParams{Description: &description}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, Params{"description": ""}, params)
+}
+
+func TestMappingRuleParams(t *testing.T) {
+	var (
+		delta = 0
+		last  = true
+	)
+
+	params, err := MappingRuleParams{Delta: &delta, Last: &last}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, Params{"delta": "0", "last": "true"}, params)
+}
+
+func TestMetricAndMethodParams(t *testing.T) {
+	var (
+		friendlyName = "Hits"
+		unit         = "hit"
+	)
+
+	params, err := MetricParams{FriendlyName: &friendlyName, Unit: &unit}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, Params{"friendly_name": friendlyName, "unit": unit}, params)
+
+	params, err = MethodParams{FriendlyName: &friendlyName}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, Params{"friendly_name": friendlyName}, params)
+}
+
+func TestLimitUsageAndFeatureParams(t *testing.T) {
+	var (
+		period          = "day"
+		value           = 0
+		backendID int64 = 12
+		path            = "/v1"
+		scope           = FeatureScopeApplicationPlan
+	)
+
+	params, err := ApplicationPlanLimitParams{Period: &period, Value: &value}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, Params{"period": period, "value": "0"}, params)
+
+	params, err = BackendUsageParams{BackendAPIID: &backendID, Path: &path}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, Params{"backend_api_id": "12", "path": path}, params)
+
+	params, err = FeatureParams{Scope: &scope}.Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, Params{"scope": scope}, params)
+}
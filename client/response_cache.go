@@ -0,0 +1,135 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// CachedResponse is a GET response stored by a ResponseCache along with its ETag
+type CachedResponse struct {
+	ETag       string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// ResponseCache stores GET responses keyed by request URL, see EnableResponseCache.
+// Implementations must be safe for concurrent use
+type ResponseCache interface {
+	Get(key string) (*CachedResponse, bool)
+	Set(key string, response *CachedResponse)
+}
+
+// MemoryResponseCache is an in memory ResponseCache
+type MemoryResponseCache struct {
+	mutex     sync.RWMutex
+	responses map[string]*CachedResponse
+}
+
+// NewMemoryResponseCache returns an empty in memory ResponseCache
+func NewMemoryResponseCache() *MemoryResponseCache {
+	return &MemoryResponseCache{responses: map[string]*CachedResponse{}}
+}
+
+// Get returns the response stored for key
+func (m *MemoryResponseCache) Get(key string) (*CachedResponse, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	response, ok := m.responses[key]
+	return response, ok
+}
+
+// Set stores the response for key
+func (m *MemoryResponseCache) Set(key string, response *CachedResponse) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.responses[key] = response
+}
+
+// EnableResponseCache stores GET responses carrying an ETag in cache and revalidates them
+// with If-None-Match on subsequent requests of the same URL. A 304 Not Modified answer is
+// replaced by the cached response, so unchanged resources are not downloaded again.
+// Cached responses are never used without revalidation. A nil cache disables caching
+func (c *ThreeScaleClient) EnableResponseCache(cache ResponseCache) {
+	c.responseCache = cache
+}
+
+func cacheKey(req *http.Request) (string, bool) {
+	if req.Method != http.MethodGet {
+		return "", false
+	}
+	return req.URL.String(), true
+}
+
+// revalidateCached adds If-None-Match to requests of cached URLs,
+// returning the cached response to use when 3scale answers 304 Not Modified
+func (c *ThreeScaleClient) revalidateCached(req *http.Request) *CachedResponse {
+	if c.responseCache == nil || req.Header.Get("If-None-Match") != "" {
+		return nil
+	}
+
+	key, ok := cacheKey(req)
+	if !ok {
+		return nil
+	}
+
+	cached, ok := c.responseCache.Get(key)
+	if !ok {
+		return nil
+	}
+
+	req.Header.Set("If-None-Match", cached.ETag)
+	return cached
+}
+
+// cacheResponse answers 304 Not Modified with the cached response and stores successful responses carrying an ETag
+func (c *ThreeScaleClient) cacheResponse(req *http.Request, resp *http.Response, cached *CachedResponse) {
+	if c.responseCache == nil || resp == nil {
+		return
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		resp.StatusCode = cached.StatusCode
+		resp.Status = fmt.Sprintf("%d %s", cached.StatusCode, http.StatusText(cached.StatusCode))
+		resp.Header = cached.Header.Clone()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(cached.Body))
+		resp.ContentLength = int64(len(cached.Body))
+		return
+	}
+
+	key, ok := cacheKey(req)
+	etag := resp.Header.Get("ETag")
+	if !ok || etag == "" || resp.StatusCode != http.StatusOK {
+		return
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		// hand the read error on to the decoder instead of caching a partial body
+		resp.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err}))
+		return
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	c.responseCache.Set(key, &CachedResponse{
+		ETag:       etag,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	})
+}
+
+// errReader always fails with err
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
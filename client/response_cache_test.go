@@ -0,0 +1,71 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestResponseCache(t *testing.T) {
+	var downloads, notModified int32
+	etag := `"v1"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == etag {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		atomic.AddInt32(&downloads, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"services":[{"service":{"id":1,"name":"cached"}}]}`))
+	}))
+	defer server.Close()
+
+	ap, err := NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewThreeScale(ap, "someAccessToken", server.Client())
+	c.EnableResponseCache(NewMemoryResponseCache())
+
+	for i := 0; i < 3; i++ {
+		list, err := c.ListProductsPerPage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		equals(t, "cached", list.Products[0].Element.Name)
+	}
+	equals(t, int32(1), atomic.LoadInt32(&downloads))
+	equals(t, int32(2), atomic.LoadInt32(&notModified))
+
+	// a changed resource is downloaded and cached again
+	etag = `"v2"`
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int32(2), atomic.LoadInt32(&downloads))
+}
+
+func TestResponseCacheSkipsWrites(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.Header.Get("If-None-Match") != "" {
+			t.Fatalf("unexpected conditional %s request", req.Method)
+		}
+		resp := jsonTestResponse(t, http.StatusCreated, Product{Element: ProductItem{ID: 1}})
+		resp.Header.Set("ETag", `"v1"`)
+		return resp
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	cache := NewMemoryResponseCache()
+	c.EnableResponseCache(cache)
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.CreateProduct("someProduct", Params{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	equals(t, 0, len(cache.responses))
+}
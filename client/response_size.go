@@ -0,0 +1,50 @@
+package client
+
+import (
+	"io"
+	"net/http"
+)
+
+// SetMaxResponseSize limits the number of response body bytes read when decoding a 3scale response.
+// Reading beyond the limit fails with ErrResponseTooLarge, so a misbehaving endpoint cannot make
+// the client allocate unbounded memory. The limit applies to the decompressed body.
+// A size lower than 1 disables the limit, which is the default
+func (c *ThreeScaleClient) SetMaxResponseSize(size int64) {
+	if size < 0 {
+		size = 0
+	}
+	c.maxResponseSize = size
+}
+
+func (c *ThreeScaleClient) limitResponseSize(resp *http.Response) {
+	if c.maxResponseSize == 0 || resp == nil || resp.Body == nil {
+		return
+	}
+	resp.Body = &limitedBody{body: resp.Body, remaining: c.maxResponseSize}
+}
+
+// limitedBody fails with ErrResponseTooLarge once more than the remaining bytes are read
+type limitedBody struct {
+	body      io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+	// read one byte past the limit to tell bodies of exactly the limit size apart
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.body.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), ErrResponseTooLarge
+	}
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestMaxResponseSize(t *testing.T) {
+	body := `{"services":[{"service":{"id":1,"name":"someProduct"}}]}`
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+
+	c.SetMaxResponseSize(int64(len(body)))
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatalf("body of exactly the limit size should decode: %v", err)
+	}
+
+	c.SetMaxResponseSize(int64(len(body) - 1))
+	_, err := c.ListProductsPerPage()
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge; got %v", err)
+	}
+	equals(t, http.StatusOK, err.(ApiErr).Code())
+
+	c.SetMaxResponseSize(0)
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatalf("unexpected error with the limit disabled: %v", err)
+	}
+}
+
+func TestMaxResponseSizeErrorResponse(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusUnprocessableEntity,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"errors":{"name":["` + string(bytes.Repeat([]byte("a"), 1024)) + `"]}}`)),
+			Header:     make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetMaxResponseSize(64)
+
+	_, err := c.CreateProduct("someProduct", Params{})
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge; got %v", err)
+	}
+}
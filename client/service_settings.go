@@ -0,0 +1,140 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Product deployment options
+const (
+	DeploymentOptionHosted           = "hosted"
+	DeploymentOptionSelfManaged      = "self_managed"
+	DeploymentOptionServiceMeshIstio = "service_mesh_istio"
+)
+
+// Product authentication modes, set as the product backend_version
+const (
+	BackendVersionUserKey     = "1"
+	BackendVersionAppIDAppKey = "2"
+	BackendVersionOIDC        = "oidc"
+)
+
+// Locations where APIcast reads the credentials from
+const (
+	CredentialsLocationHeaders       = "headers"
+	CredentialsLocationQuery         = "query"
+	CredentialsLocationAuthorization = "authorization"
+)
+
+// OIDC issuer types
+const (
+	OIDCIssuerTypeKeycloak = "keycloak"
+	OIDCIssuerTypeRest     = "rest"
+)
+
+// ProxyAuthParams - Authentication params for UpdateProductProxy
+type ProxyAuthParams struct {
+	// CredentialsLocation is one of the CredentialsLocation constants
+	CredentialsLocation *string `param:"credentials_location"`
+	AuthUserKey         *string `param:"auth_user_key"`
+	AuthAppID           *string `param:"auth_app_id"`
+	AuthAppKey          *string `param:"auth_app_key"`
+	OidcIssuerEndpoint  *string `param:"oidc_issuer_endpoint"`
+	// OidcIssuerType is one of the OIDCIssuerType constants
+	OidcIssuerType           *string `param:"oidc_issuer_type"`
+	JwtClaimWithClientID     *string `param:"jwt_claim_with_client_id"`
+	JwtClaimWithClientIDType *string `param:"jwt_claim_with_client_id_type"`
+}
+
+// Params encodes the proxy authentication params
+func (p ProxyAuthParams) Params() (Params, error) {
+	return typedParams(p, nil)
+}
+
+// ServiceSettings - Deployment and authentication settings of a product for UpdateServiceSettings.
+// Nil fields are left unchanged
+type ServiceSettings struct {
+	// DeploymentOption is one of the DeploymentOption constants
+	DeploymentOption *string
+	// BackendVersion is one of the BackendVersion constants
+	BackendVersion *string
+	Auth           ProxyAuthParams
+}
+
+// Validate checks the settings hold known deployment options, backend versions, credentials locations and issuer types
+func (s ServiceSettings) Validate() error {
+	for _, check := range []struct {
+		attr    string
+		value   *string
+		allowed []string
+	}{
+		{"deployment_option", s.DeploymentOption, []string{DeploymentOptionHosted, DeploymentOptionSelfManaged, DeploymentOptionServiceMeshIstio}},
+		{"backend_version", s.BackendVersion, []string{BackendVersionUserKey, BackendVersionAppIDAppKey, BackendVersionOIDC}},
+		{"credentials_location", s.Auth.CredentialsLocation, []string{CredentialsLocationHeaders, CredentialsLocationQuery, CredentialsLocationAuthorization}},
+		{"oidc_issuer_type", s.Auth.OidcIssuerType, []string{OIDCIssuerTypeKeycloak, OIDCIssuerTypeRest}},
+	} {
+		if check.value == nil {
+			continue
+		}
+		if !containsString(check.allowed, *check.value) {
+			return fmt.Errorf("invalid %s %q, expected one of %q", check.attr, *check.value, check.allowed)
+		}
+	}
+	return nil
+}
+
+// UpdateServiceSettings validates and applies the deployment and authentication settings of a product.
+// Switching to OIDC requires an issuer endpoint, either in the settings or already set on the proxy,
+// otherwise nothing is changed. The proxy authentication settings are updated before the product,
+// so the new authentication mode never applies with the previous credentials settings.
+// Returns the updated product and proxy, nil when the settings do not change them
+func (c *ThreeScaleClient) UpdateServiceSettings(productID int64, settings ServiceSettings) (*Product, *ProxyJSON, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	if settings.BackendVersion != nil && *settings.BackendVersion == BackendVersionOIDC &&
+		(settings.Auth.OidcIssuerEndpoint == nil || *settings.Auth.OidcIssuerEndpoint == "") {
+		proxy, err := c.ProductProxy(productID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if proxy.Element.OidcIssuerEndpoint == "" {
+			return nil, nil, errors.New("switching to OIDC requires an oidc_issuer_endpoint")
+		}
+	}
+
+	authParams, err := settings.Auth.Params()
+	if err != nil {
+		return nil, nil, err
+	}
+	productParams, err := ProductParams{DeploymentOption: settings.DeploymentOption, BackendVersion: settings.BackendVersion}.Params()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var proxy *ProxyJSON
+	if len(authParams) > 0 {
+		if proxy, err = c.UpdateProductProxy(productID, authParams); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var product *Product
+	if len(productParams) > 0 {
+		if product, err = c.UpdateProduct(productID, productParams); err != nil {
+			return nil, proxy, err
+		}
+	}
+
+	return product, proxy, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	servicePlanListResourceEndpoint         = "/admin/api/services/%d/service_plans.json"
+	serviceSubscriptionListResourceEndpoint = "/admin/api/accounts/%d/service_subscriptions.json"
+)
+
+// ListServicePlans List existing service plans for a given product
+func (c *ThreeScaleClient) ListServicePlans(productID int64, opts ...ListOptions) (*ServicePlanList, error) {
+	endpoint := fmt.Sprintf(servicePlanListResourceEndpoint, productID)
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	applyListOptions(req, opts...)
+
+	return doReq[ServicePlanList](c, req, http.StatusOK)
+}
+
+// ListServiceSubscriptions List existing service subscriptions for a given account
+func (c *ThreeScaleClient) ListServiceSubscriptions(accountID int64, opts ...ListOptions) (*ServiceSubscriptionList, error) {
+	endpoint := fmt.Sprintf(serviceSubscriptionListResourceEndpoint, accountID)
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	applyListOptions(req, opts...)
+
+	return doReq[ServiceSubscriptionList](c, req, http.StatusOK)
+}
+
+// CreateServiceSubscription Subscribes the account to the service of the given service plan
+func (c *ThreeScaleClient) CreateServiceSubscription(accountID, servicePlanID int64) (*ServiceSubscription, error) {
+	endpoint := fmt.Sprintf(serviceSubscriptionListResourceEndpoint, accountID)
+
+	values := url.Values{}
+	values.Add("plan_id", strconv.FormatInt(servicePlanID, 10))
+
+	body := strings.NewReader(values.Encode())
+	req, err := c.buildPostReq(endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[ServiceSubscription](c, req, http.StatusCreated)
+}
+
+// EnsureServiceSubscription Subscribes the account to the given product unless already subscribed.
+// The default service plan of the product is used, falling back to the first published one.
+func (c *ThreeScaleClient) EnsureServiceSubscription(accountID, productID int64) (*ServiceSubscription, error) {
+	subscriptions, err := c.ListServiceSubscriptions(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range subscriptions.Subscriptions {
+		if subscriptions.Subscriptions[idx].Element.ServiceID == productID {
+			return &subscriptions.Subscriptions[idx], nil
+		}
+	}
+
+	plans, err := c.ListServicePlans(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *ServicePlanItem
+	for idx := range plans.Plans {
+		item := &plans.Plans[idx].Element
+		if item.Default {
+			plan = item
+			break
+		}
+		if plan == nil && item.State == "published" {
+			plan = item
+		}
+	}
+
+	if plan == nil {
+		return nil, fmt.Errorf("product %d has no service plan available to subscribe account %d", productID, accountID)
+	}
+
+	return c.CreateServiceSubscription(accountID, plan.ID)
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListServicePlans(t *testing.T) {
+	var (
+		productID int64 = 10
+		endpoint        = fmt.Sprintf(servicePlanListResourceEndpoint, productID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodGet {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
+		}
+
+		return jsonTestResponse(t, http.StatusOK, ServicePlanList{
+			Plans: []ServicePlan{
+				{Element: ServicePlanItem{ID: 1, Name: "Basic", Default: true}},
+			},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListServicePlans(productID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list.Plans) != 1 {
+		t.Fatalf("# list items does not match. Expected [%d]; got [%d]", 1, len(list.Plans))
+	}
+}
+
+func TestCreateServiceSubscription(t *testing.T) {
+	var (
+		accountID     int64 = 3
+		servicePlanID int64 = 7
+		endpoint            = fmt.Sprintf(serviceSubscriptionListResourceEndpoint, accountID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodPost {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodPost, req.Method)
+		}
+
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+
+		if req.PostForm.Get("plan_id") != "7" {
+			t.Fatalf("plan_id does not match. Expected [%s]; got [%s]", "7", req.PostForm.Get("plan_id"))
+		}
+
+		return jsonTestResponse(t, http.StatusCreated, ServiceSubscription{
+			Element: ServiceSubscriptionItem{ID: 1, PlanID: servicePlanID, AccountID: accountID},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CreateServiceSubscription(accountID, servicePlanID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if obj.Element.PlanID != servicePlanID {
+		t.Fatalf("plan ID does not match. Expected [%d]; got [%d]", servicePlanID, obj.Element.PlanID)
+	}
+}
+
+func TestEnsureServiceSubscription(t *testing.T) {
+	var (
+		accountID int64 = 3
+		productID int64 = 10
+	)
+
+	inputs := []struct {
+		name           string
+		subscriptions  ServiceSubscriptionList
+		expectedCreate bool
+	}{
+		{
+			name: "AlreadySubscribed",
+			subscriptions: ServiceSubscriptionList{
+				Subscriptions: []ServiceSubscription{
+					{Element: ServiceSubscriptionItem{ID: 1, ServiceID: productID, PlanID: 5}},
+				},
+			},
+		},
+		{
+			name:           "NotSubscribed",
+			subscriptions:  ServiceSubscriptionList{},
+			expectedCreate: true,
+		},
+	}
+
+	for _, input := range inputs {
+		t.Run(input.name, func(subTest *testing.T) {
+			created := false
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				switch {
+				case req.URL.Path == fmt.Sprintf(serviceSubscriptionListResourceEndpoint, accountID) && req.Method == http.MethodGet:
+					return jsonTestResponse(subTest, http.StatusOK, input.subscriptions)
+				case req.URL.Path == fmt.Sprintf(servicePlanListResourceEndpoint, productID):
+					return jsonTestResponse(subTest, http.StatusOK, ServicePlanList{
+						Plans: []ServicePlan{
+							{Element: ServicePlanItem{ID: 4, State: "published"}},
+							{Element: ServicePlanItem{ID: 5, Default: true}},
+						},
+					})
+				case req.URL.Path == fmt.Sprintf(serviceSubscriptionListResourceEndpoint, accountID) && req.Method == http.MethodPost:
+					created = true
+					if err := req.ParseForm(); err != nil {
+						subTest.Fatal(err)
+					}
+					if req.PostForm.Get("plan_id") != "5" {
+						subTest.Fatalf("default plan expected. Expected [%s]; got [%s]", "5", req.PostForm.Get("plan_id"))
+					}
+					return jsonTestResponse(subTest, http.StatusCreated, ServiceSubscription{
+						Element: ServiceSubscriptionItem{ID: 2, ServiceID: productID, PlanID: 5},
+					})
+				}
+				subTest.Fatalf("Unexpected request [%s %s]", req.Method, req.URL.Path)
+				return nil
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subTest), "someAccessToken", httpClient)
+			obj, err := c.EnsureServiceSubscription(accountID, productID)
+			if err != nil {
+				subTest.Fatal(err)
+			}
+
+			equals(subTest, input.expectedCreate, created)
+			equals(subTest, productID, obj.Element.ServiceID)
+		})
+	}
+}
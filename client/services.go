@@ -8,8 +8,9 @@ import (
 )
 
 const (
-	serviceCreateList   = "/admin/api/services.xml"
-	serviceUpdateDelete = "/admin/api/services/%s.xml"
+	serviceCreateList       = "/admin/api/services.xml"
+	serviceUpdateDelete     = "/admin/api/services/%s.xml"
+	SERVICES_PER_PAGE   int = 500
 )
 
 func (c *ThreeScaleClient) CreateService(name string) (Service, error) {
@@ -26,7 +27,7 @@ func (c *ThreeScaleClient) CreateService(name string) (Service, error) {
 		return s, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return s, err
 	}
@@ -60,7 +61,7 @@ func (c *ThreeScaleClient) UpdateService(id string, params Params) (Service, err
 		return s, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return s, err
 	}
@@ -83,7 +84,7 @@ func (c *ThreeScaleClient) DeleteService(id string) error {
 		return httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -92,7 +93,35 @@ func (c *ThreeScaleClient) DeleteService(id string) error {
 	return handleXMLResp(resp, http.StatusOK, nil)
 }
 
-func (c *ThreeScaleClient) ListServices() (ServiceList, error) {
+// ListServices List every service of the provider account
+func (c *ThreeScaleClient) ListServices(opts ...ListOptions) (ServiceList, error) {
+	sl := ServiceList{}
+
+	err := c.fetchListPages(mergeListOptions(opts), SERVICES_PER_PAGE, func(options ListOptions) (int, *PageInfo, func(), error) {
+		tmpList, err := c.listServicesPage(options)
+		if err != nil {
+			return 0, nil, nil, err
+		}
+		return len(tmpList.Services), tmpList.Metadata, func() {
+			sl.Services = append(sl.Services, tmpList.Services...)
+			sl.Metadata = tmpList.Metadata
+		}, nil
+	})
+	if err != nil {
+		return sl, err
+	}
+
+	return sl, nil
+}
+
+// ListServicesPerPage List existing services in a single page
+// paginationValues[0] = Page in the paginated list. Defaults to 1 for the API, as the client will not send the page param.
+// paginationValues[1] = Number of results per page. Default and max is 500 for the aPI, as the client will not send the per_page param.
+func (c *ThreeScaleClient) ListServicesPerPage(paginationValues ...int) (ServiceList, error) {
+	return c.listServicesPage(paginationOptions(paginationValues))
+}
+
+func (c *ThreeScaleClient) listServicesPage(options ListOptions) (ServiceList, error) {
 	var sl ServiceList
 
 	ep := serviceCreateList
@@ -103,14 +132,41 @@ func (c *ThreeScaleClient) ListServices() (ServiceList, error) {
 	}
 
 	values := url.Values{}
+	options.apply(values)
 	req.URL.RawQuery = values.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return sl, err
 	}
 	defer resp.Body.Close()
 
 	err = handleXMLResp(resp, http.StatusOK, &sl)
+	sl.Metadata = pageInfo(sl.Metadata, resp.Header)
 	return sl, err
 }
+
+// FindServiceBySystemName Looks up the service with the given system name, fetching pages until it is found.
+// Returns a not found error when no service matches
+func (c *ThreeScaleClient) FindServiceBySystemName(systemName string) (*Service, error) {
+	currentPage := 1
+
+	allResultsPerPage := false
+	for next := true; next; next = allResultsPerPage {
+		tmpList, err := c.ListServicesPerPage(currentPage, SERVICES_PER_PAGE)
+		if err != nil {
+			return nil, err
+		}
+
+		for idx := range tmpList.Services {
+			if tmpList.Services[idx].SystemName == systemName {
+				return &tmpList.Services[idx], nil
+			}
+		}
+
+		allResultsPerPage = len(tmpList.Services) == SERVICES_PER_PAGE
+		currentPage += 1
+	}
+
+	return nil, createApiErr(http.StatusNotFound, fmt.Sprintf("service with system_name %q not found", systemName))
+}
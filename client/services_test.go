@@ -0,0 +1,92 @@
+package client
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+// servicePagesClient serves SERVICES_PER_PAGE services on the first page and one service on the second,
+// counting the requests of every page
+func servicePagesClient(t *testing.T, pages map[string]int) *http.Client {
+	return NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodGet,
+			Path:   serviceCreateList,
+			Query:  map[string]string{"per_page": strconv.Itoa(SERVICES_PER_PAGE)},
+		}.Assert(t, req)
+
+		page := req.URL.Query().Get("page")
+		pages[page]++
+
+		n := 0
+		switch page {
+		case "1":
+			n = SERVICES_PER_PAGE
+		case "2":
+			n = 1
+		default:
+			t.Fatalf("page param unexpected value; got [%s]", page)
+		}
+
+		list := ServiceList{}
+		for idx := 0; idx < n; idx++ {
+			id := fmt.Sprintf("%s%d", page, idx)
+			list.Services = append(list.Services, Service{ID: id, SystemName: "svc_" + id})
+		}
+
+		body, err := xml.Marshal(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(body)),
+			Header:     make(http.Header),
+		}
+	})
+}
+
+func TestListServicesPagination(t *testing.T) {
+	pages := map[string]int{}
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", servicePagesClient(t, pages))
+
+	list, err := c.ListServices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, SERVICES_PER_PAGE+1, len(list.Services))
+	equals(t, map[string]int{"1": 1, "2": 1}, pages)
+}
+
+func TestFindServiceBySystemName(t *testing.T) {
+	pages := map[string]int{}
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", servicePagesClient(t, pages))
+
+	service, err := c.FindServiceBySystemName("svc_15")
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "15", service.ID)
+	// found on the first page, second page not requested
+	equals(t, map[string]int{"1": 1}, pages)
+
+	service, err = c.FindServiceBySystemName("svc_20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "20", service.ID)
+
+	_, err = c.FindServiceBySystemName("missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+}
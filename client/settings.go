@@ -0,0 +1,47 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	settingsResourceEndpoint = "/admin/api/settings.json"
+)
+
+// Values accepted by ChangeAccountPlanPermission and ChangeServicePlanPermission settings
+const (
+	PlanPermissionDirect            = "direct"
+	PlanPermissionRequest           = "request"
+	PlanPermissionCreditCard        = "credit_card"
+	PlanPermissionRequestCreditCard = "request_credit_card"
+	PlanPermissionNone              = "none"
+)
+
+// GetSettings Reads 3scale tenant settings
+func (c *ThreeScaleClient) GetSettings() (*Settings, error) {
+	return doGet[Settings](c, settingsResourceEndpoint)
+}
+
+// UpdateSettings Updates 3scale tenant settings
+// Only not nil fields are updated
+func (c *ThreeScaleClient) UpdateSettings(settings *Settings) (*Settings, error) {
+	if settings == nil {
+		return nil, errors.New("UpdateSettings needs not nil pointer")
+	}
+
+	bodyArr, err := json.Marshal(settings.Element)
+	if err != nil {
+		return nil, err
+	}
+	body := bytes.NewReader(bodyArr)
+
+	req, err := c.buildUpdateJSONReq(settingsResourceEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[Settings](c, req, http.StatusOK)
+}
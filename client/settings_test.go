@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestGetSettings(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: settingsResourceEndpoint}.Assert(t, req)
+		equals(t, "application/json", req.Header.Get("Accept"))
+
+		return jsonTestResponse(t, http.StatusOK, map[string]interface{}{
+			"settings": map[string]interface{}{
+				"useraccountarea_enabled":        true,
+				"signups_enabled":                false,
+				"change_account_plan_permission": PlanPermissionRequest,
+			},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	settings, err := c.GetSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, true, *settings.Element.UserAccountAreaEnabled)
+	equals(t, false, *settings.Element.SignupsEnabled)
+	equals(t, PlanPermissionRequest, *settings.Element.ChangeAccountPlanPermission)
+	equals(t, (*bool)(nil), settings.Element.PublicSearch)
+}
+
+func TestUpdateSettings(t *testing.T) {
+	signupsEnabled := false
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPut, Path: settingsResourceEndpoint}.Assert(t, req)
+
+		bodyArr, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sent := map[string]interface{}{}
+		if err := json.Unmarshal(bodyArr, &sent); err != nil {
+			t.Fatal(err)
+		}
+		// unset settings must not be sent
+		equals(t, map[string]interface{}{"signups_enabled": false}, sent)
+
+		return jsonTestResponse(t, http.StatusOK, Settings{Element: SettingsItem{SignupsEnabled: &signupsEnabled}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	settings, err := c.UpdateSettings(&Settings{Element: SettingsItem{SignupsEnabled: &signupsEnabled}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, false, *settings.Element.SignupsEnabled)
+}
+
+func TestUpdateSettingsNil(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	if _, err := c.UpdateSettings(nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ssoTokenResourceEndpoint = "/admin/api/sso_tokens.json"
+)
+
+// CreateSSOToken Creates a one-time token logging the developer user into the developer portal.
+// The returned SSOURL signs the user in when opened, then redirects to redirectURL when not empty.
+// Zero expiresIn keeps the API default of 10 minutes
+func (c *ThreeScaleClient) CreateSSOToken(userID int64, expiresIn time.Duration, redirectURL string) (*SSOToken, error) {
+	values := url.Values{}
+	values.Add("user_id", strconv.FormatInt(userID, 10))
+	if expiresIn > 0 {
+		values.Add("expires_in", strconv.FormatInt(int64(expiresIn/time.Second), 10))
+	}
+	if redirectURL != "" {
+		values.Add("redirect_url", redirectURL)
+	}
+
+	body := strings.NewReader(values.Encode())
+	req, err := c.buildPostReq(ssoTokenResourceEndpoint, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return doReq[SSOToken](c, req, http.StatusCreated)
+}
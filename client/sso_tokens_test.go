@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestCreateSSOToken(t *testing.T) {
+	const ssoURL = "https://developer.example.com/session/create?expires_at=1585648800&token=abc"
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   ssoTokenResourceEndpoint,
+			Form: map[string]string{
+				"user_id":      "4",
+				"expires_in":   "60",
+				"redirect_url": "/admin/applications",
+			},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusCreated, SSOToken{Element: SSOTokenItem{Token: "abc", SSOURL: ssoURL}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	obj, err := c.CreateSSOToken(4, time.Minute, "/admin/applications")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, ssoURL, obj.Element.SSOURL)
+}
+
+func TestCreateSSOTokenDefaults(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodPost, Path: ssoTokenResourceEndpoint}.Assert(t, req)
+
+		if err := req.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		equals(t, "4", req.PostForm.Get("user_id"))
+		if _, ok := req.PostForm["expires_in"]; ok {
+			t.Fatal("expires_in param should not be sent")
+		}
+		if _, ok := req.PostForm["redirect_url"]; ok {
+			t.Fatal("redirect_url param should not be sent")
+		}
+
+		return jsonTestResponse(t, http.StatusCreated, SSOToken{Element: SSOTokenItem{Token: "abc"}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if _, err := c.CreateSSOToken(4, 0, ""); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	appUsageStatsEndpoint        = "/stats/applications/%d/usage.json"
+	productUsageStatsEndpoint    = "/stats/services/%d/usage.json"
+	backendApiUsageStatsEndpoint = "/stats/backend_apis/%d/usage.json"
+	topApplicationsStatsEndpoint = "/stats/services/%d/top_applications.json"
+	usageStatsTimeLayout         = "2006-01-02 15:04:05"
+)
+
+// Analytics periods
+const (
+	StatsPeriodYear  StatsPeriod = "year"
+	StatsPeriodMonth StatsPeriod = "month"
+	StatsPeriodWeek  StatsPeriod = "week"
+	StatsPeriodDay   StatsPeriod = "day"
+)
+
+// Analytics granularities
+const (
+	StatsGranularityMonth StatsGranularity = "month"
+	StatsGranularityDay   StatsGranularity = "day"
+	StatsGranularityHour  StatsGranularity = "hour"
+)
+
+// statsPeriodGranularity is the granularity of the values 3scale returns for each period
+var statsPeriodGranularity = map[StatsPeriod]StatsGranularity{
+	StatsPeriodYear:  StatsGranularityMonth,
+	StatsPeriodMonth: StatsGranularityDay,
+	StatsPeriodWeek:  StatsGranularityDay,
+	StatsPeriodDay:   StatsGranularityHour,
+}
+
+// Granularity returns the granularity of the values of the period, empty for unknown periods
+func (p StatsPeriod) Granularity() StatsGranularity {
+	return statsPeriodGranularity[p]
+}
+
+// Validate fails for unknown periods
+func (p StatsPeriod) Validate() error {
+	if _, ok := statsPeriodGranularity[p]; !ok {
+		return fmt.Errorf("unsupported stats period %q - must be one of year, month, week, day", p)
+	}
+	return nil
+}
+
+// Validate fails for unknown granularities
+func (g StatsGranularity) Validate() error {
+	switch g {
+	case StatsGranularityMonth, StatsGranularityDay, StatsGranularityHour:
+		return nil
+	}
+	return fmt.Errorf("unsupported stats granularity %q - must be one of month, day, hour", g)
+}
+
+// validateStatsRange checks the time range of a stats query: either a period starting at since,
+// optionally with the granularity of the period, or an until after since with any granularity
+func validateStatsRange(since, until time.Time, period StatsPeriod, granularity StatsGranularity) error {
+	if since.IsZero() {
+		return fmt.Errorf("stats need since")
+	}
+	if until.IsZero() == (period == "") {
+		return fmt.Errorf("stats need either until or period")
+	}
+
+	if period != "" {
+		if err := period.Validate(); err != nil {
+			return err
+		}
+		if granularity != "" && granularity != period.Granularity() {
+			return fmt.Errorf("stats period %q only supports granularity %q, got %q", period, period.Granularity(), granularity)
+		}
+		return nil
+	}
+
+	if granularity == "" {
+		return fmt.Errorf("stats need granularity when until is set")
+	}
+	if !until.After(since) {
+		return fmt.Errorf("stats until must be after since")
+	}
+	return granularity.Validate()
+}
+
+// ApplicationUsage returns the usage of a metric by an application
+func (c *ThreeScaleClient) ApplicationUsage(appID int64, params UsageStatsParams) (*UsageStats, error) {
+	return c.usageStats(fmt.Sprintf(appUsageStatsEndpoint, appID), params)
+}
+
+// ProductUsage returns the usage of a metric of a product
+func (c *ThreeScaleClient) ProductUsage(productID int64, params UsageStatsParams) (*UsageStats, error) {
+	return c.usageStats(fmt.Sprintf(productUsageStatsEndpoint, productID), params)
+}
+
+// BackendApiUsage returns the usage of a metric of a backend api
+func (c *ThreeScaleClient) BackendApiUsage(backendapiID int64, params UsageStatsParams) (*UsageStats, error) {
+	return c.usageStats(fmt.Sprintf(backendApiUsageStatsEndpoint, backendapiID), params)
+}
+
+func (c *ThreeScaleClient) usageStats(endpoint string, params UsageStatsParams) (*UsageStats, error) {
+	values, err := params.values()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, httpReqError
+	}
+	req.URL.RawQuery = values.Encode()
+
+	return doReq[UsageStats](c, req, http.StatusOK)
+}
+
+// TopApplications returns the applications of a product ranked by their usage of a metric, highest first
+func (c *ThreeScaleClient) TopApplications(productID int64, params TopApplicationsParams) (*TopApplications, error) {
+	if params.MetricName == "" {
+		return nil, fmt.Errorf("top applications need a metric name")
+	}
+	if params.Period == "" {
+		return nil, fmt.Errorf("top applications need period")
+	}
+	if err := validateStatsRange(params.Since, time.Time{}, params.Period, ""); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("metric_name", params.MetricName)
+	values.Add("since", params.Since.Format(usageStatsTimeLayout))
+	values.Add("period", string(params.Period))
+
+	endpoint := fmt.Sprintf(topApplicationsStatsEndpoint, productID)
+	req, err := c.buildGetJSONReq(endpoint)
+	if err != nil {
+		return nil, httpReqError
+	}
+	req.URL.RawQuery = values.Encode()
+
+	return doReq[TopApplications](c, req, http.StatusOK)
+}
+
+func (p UsageStatsParams) values() (url.Values, error) {
+	if p.MetricName == "" {
+		return nil, fmt.Errorf("usage stats need a metric name")
+	}
+	if err := validateStatsRange(p.Since, p.Until, p.Period, p.Granularity); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("metric_name", p.MetricName)
+	values.Add("since", p.Since.Format(usageStatsTimeLayout))
+	if !p.Until.IsZero() {
+		values.Add("until", p.Until.Format(usageStatsTimeLayout))
+	}
+	if p.Period != "" {
+		values.Add("period", string(p.Period))
+	}
+	if p.Granularity != "" {
+		values.Add("granularity", string(p.Granularity))
+	}
+	if p.Timezone != "" {
+		values.Add("timezone", p.Timezone)
+	}
+	if p.SkipChange {
+		values.Add("skip_change", strconv.FormatBool(p.SkipChange))
+	}
+	return values, nil
+}
+
+// Points returns the report values together with the start time of their granularity step
+func (u *UsageStats) Points() ([]UsagePoint, error) {
+	since, err := time.Parse(time.RFC3339, u.Period.Since)
+	if err != nil {
+		return nil, fmt.Errorf("parsing usage period since: %v", err)
+	}
+
+	var step func(time.Time) time.Time
+	switch StatsGranularity(u.Period.Granularity) {
+	case StatsGranularityMonth:
+		step = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	case StatsGranularityDay:
+		step = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case StatsGranularityHour:
+		step = func(t time.Time) time.Time { return t.Add(time.Hour) }
+	default:
+		return nil, fmt.Errorf("unsupported usage granularity %q", u.Period.Granularity)
+	}
+
+	points := make([]UsagePoint, 0, len(u.Values))
+	for current, idx := since, 0; idx < len(u.Values); current, idx = step(current), idx+1 {
+		points = append(points, UsagePoint{Time: current, Value: u.Values[idx]})
+	}
+	return points, nil
+}
@@ -0,0 +1,255 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestApplicationUsage(t *testing.T) {
+	var (
+		appID    int64 = 7
+		endpoint       = fmt.Sprintf(appUsageStatsEndpoint, appID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		if req.Method != http.MethodGet {
+			t.Fatalf("Method does not match. Expected [%s]; got [%s]", http.MethodGet, req.Method)
+		}
+
+		query := req.URL.Query()
+		equals(t, "hits", query.Get("metric_name"))
+		equals(t, "2020-03-01 00:00:00", query.Get("since"))
+		equals(t, "2020-03-03 00:00:00", query.Get("until"))
+		equals(t, "day", query.Get("granularity"))
+		equals(t, "UTC", query.Get("timezone"))
+		equals(t, "true", query.Get("skip_change"))
+
+		return jsonTestResponse(t, http.StatusOK, UsageStats{
+			Metric: UsageStatsMetric{ID: 1, Name: "Hits", SystemName: "hits", Unit: "hit"},
+			Period: UsageStatsPeriod{
+				Since:       "2020-03-01T00:00:00Z",
+				Until:       "2020-03-03T23:59:59Z",
+				Timezone:    "Etc/UTC",
+				Granularity: "day",
+			},
+			Total:  6,
+			Values: []int64{1, 2, 3},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	stats, err := c.ApplicationUsage(appID, UsageStatsParams{
+		MetricName:  "hits",
+		Since:       time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+		Until:       time.Date(2020, 3, 3, 0, 0, 0, 0, time.UTC),
+		Granularity: "day",
+		Timezone:    "UTC",
+		SkipChange:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, int64(6), stats.Total)
+
+	points, err := stats.Points()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, []UsagePoint{
+		{Time: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Value: 1},
+		{Time: time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC), Value: 2},
+		{Time: time.Date(2020, 3, 3, 0, 0, 0, 0, time.UTC), Value: 3},
+	}, points)
+}
+
+func TestApplicationUsageParamsErrors(t *testing.T) {
+	since := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	errorTests := []struct {
+		Name   string
+		params UsageStatsParams
+	}{
+		{"missing metric", UsageStatsParams{Since: since, Period: "day"}},
+		{"missing since", UsageStatsParams{MetricName: "hits", Period: "day"}},
+		{"missing until and period", UsageStatsParams{MetricName: "hits", Since: since}},
+		{"until and period", UsageStatsParams{MetricName: "hits", Since: since, Until: since, Period: "day", Granularity: "hour"}},
+		{"until without granularity", UsageStatsParams{MetricName: "hits", Since: since, Until: since}},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", nil)
+			if _, err := c.ApplicationUsage(1, tt.params); err == nil {
+				subT.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestUsageStatsPoints(t *testing.T) {
+	stats := UsageStats{
+		Period: UsageStatsPeriod{Since: "2020-01-31T00:00:00+01:00", Granularity: "hour"},
+		Values: []int64{4, 5},
+	}
+
+	points, err := stats.Points()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(points))
+	equals(t, time.Hour, points[1].Time.Sub(points[0].Time))
+
+	stats.Period.Granularity = "minute"
+	if _, err := stats.Points(); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestProductAndBackendApiUsage(t *testing.T) {
+	params := UsageStatsParams{
+		MetricName: "hits",
+		Since:      time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+		Period:     "day",
+	}
+
+	tests := []struct {
+		Name     string
+		endpoint string
+		call     func(c *ThreeScaleClient) (*UsageStats, error)
+	}{
+		{"product", fmt.Sprintf(productUsageStatsEndpoint, 3), func(c *ThreeScaleClient) (*UsageStats, error) {
+			return c.ProductUsage(3, params)
+		}},
+		{"backend api", fmt.Sprintf(backendApiUsageStatsEndpoint, 4), func(c *ThreeScaleClient) (*UsageStats, error) {
+			return c.BackendApiUsage(4, params)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				if req.URL.Path != tt.endpoint {
+					subT.Fatalf("Path does not match. Expected [%s]; got [%s]", tt.endpoint, req.URL.Path)
+				}
+
+				equals(subT, "day", req.URL.Query().Get("period"))
+
+				return jsonTestResponse(subT, http.StatusOK, UsageStats{
+					Period: UsageStatsPeriod{Name: "day", Since: "2020-03-01T00:00:00Z", Granularity: "hour"},
+					Total:  10,
+					Values: []int64{10},
+				})
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subT), "someAccessToken", httpClient)
+			stats, err := tt.call(c)
+			if err != nil {
+				subT.Fatal(err)
+			}
+			equals(subT, int64(10), stats.Total)
+		})
+	}
+}
+
+func TestTopApplications(t *testing.T) {
+	var (
+		productID int64 = 3
+		endpoint        = fmt.Sprintf(topApplicationsStatsEndpoint, productID)
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		if req.URL.Path != endpoint {
+			t.Fatalf("Path does not match. Expected [%s]; got [%s]", endpoint, req.URL.Path)
+		}
+
+		query := req.URL.Query()
+		equals(t, "hits", query.Get("metric_name"))
+		equals(t, "2020-03-01 00:00:00", query.Get("since"))
+		equals(t, "week", query.Get("period"))
+
+		return jsonTestResponse(t, http.StatusOK, TopApplications{
+			Metric: UsageStatsMetric{SystemName: "hits"},
+			Applications: []TopApplication{
+				{ID: 1, Name: "heavy", Plan: TopApplicationRef{ID: 10, Name: "pro"}, Account: TopApplicationRef{ID: 5, Name: "acme"}, Value: 900},
+				{ID: 2, Name: "light", Value: 10},
+			},
+		})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	top, err := c.TopApplications(productID, TopApplicationsParams{
+		MetricName: "hits",
+		Since:      time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+		Period:     "week",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(top.Applications))
+	equals(t, int64(900), top.Applications[0].Value)
+	equals(t, "acme", top.Applications[0].Account.Name)
+
+	if _, err := c.TopApplications(productID, TopApplicationsParams{MetricName: "hits"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestStatsPeriodGranularity(t *testing.T) {
+	equals(t, StatsGranularityMonth, StatsPeriodYear.Granularity())
+	equals(t, StatsGranularityDay, StatsPeriodWeek.Granularity())
+	equals(t, StatsGranularityHour, StatsPeriodDay.Granularity())
+	equals(t, StatsGranularity(""), StatsPeriod("decade").Granularity())
+
+	equals(t, nil, StatsPeriodMonth.Validate())
+	if err := StatsPeriod("quarter").Validate(); err == nil {
+		t.Fatal("expected unsupported period error")
+	}
+	equals(t, nil, StatsGranularityHour.Validate())
+	if err := StatsGranularity("minute").Validate(); err == nil {
+		t.Fatal("expected unsupported granularity error")
+	}
+}
+
+func TestUsageStatsParamsCombinations(t *testing.T) {
+	since := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 7)
+
+	tests := []struct {
+		Name   string
+		params UsageStatsParams
+		valid  bool
+	}{
+		{"period", UsageStatsParams{MetricName: "hits", Since: since, Period: StatsPeriodWeek}, true},
+		{"period with its granularity", UsageStatsParams{MetricName: "hits", Since: since, Period: StatsPeriodDay, Granularity: StatsGranularityHour}, true},
+		{"period with other granularity", UsageStatsParams{MetricName: "hits", Since: since, Period: StatsPeriodYear, Granularity: StatsGranularityHour}, false},
+		{"unknown period", UsageStatsParams{MetricName: "hits", Since: since, Period: "quarter"}, false},
+		{"until with granularity", UsageStatsParams{MetricName: "hits", Since: since, Until: until, Granularity: StatsGranularityHour}, true},
+		{"until with unknown granularity", UsageStatsParams{MetricName: "hits", Since: since, Until: until, Granularity: "minute"}, false},
+		{"until before since", UsageStatsParams{MetricName: "hits", Since: until, Until: since, Granularity: StatsGranularityDay}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subT *testing.T) {
+			_, err := tt.params.values()
+			equals(subT, tt.valid, err == nil)
+		})
+	}
+}
+
+func TestTopApplicationsUnknownPeriod(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	_, err := c.TopApplications(1, TopApplicationsParams{MetricName: "hits", Since: time.Now(), Period: "quarter"})
+	if err == nil {
+		t.Fatal("expected unsupported period error")
+	}
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"strconv"
+)
+
+// Resource scoped sub clients grouping the flat ThreeScaleClient methods under short names.
+// They share the parent client configuration, credentials and hooks.
+
+// ApplicationsClient - Applications sub client, see ThreeScaleClient.Applications
+type ApplicationsClient struct {
+	c *ThreeScaleClient
+}
+
+// AccountsClient - Developer accounts sub client, see ThreeScaleClient.Accounts
+type AccountsClient struct {
+	c *ThreeScaleClient
+}
+
+// ProxiesClient - Product proxies sub client, see ThreeScaleClient.Proxies
+type ProxiesClient struct {
+	c *ThreeScaleClient
+}
+
+// BackendsClient - Backend APIs sub client, see ThreeScaleClient.Backends
+type BackendsClient struct {
+	c *ThreeScaleClient
+}
+
+// Applications returns the applications sub client
+func (c *ThreeScaleClient) Applications() *ApplicationsClient {
+	return &ApplicationsClient{c: c}
+}
+
+// Accounts returns the developer accounts sub client
+func (c *ThreeScaleClient) Accounts() *AccountsClient {
+	return &AccountsClient{c: c}
+}
+
+// Proxies returns the product proxies sub client
+func (c *ThreeScaleClient) Proxies() *ProxiesClient {
+	return &ProxiesClient{c: c}
+}
+
+// Backends returns the backend APIs sub client
+func (c *ThreeScaleClient) Backends() *BackendsClient {
+	return &BackendsClient{c: c}
+}
+
+// List applications of an account
+func (a *ApplicationsClient) List(accountID int64, opts ...ListOptions) (*ApplicationList, error) {
+	return a.c.ListApplications(accountID, opts...)
+}
+
+// ListAll applications of the provider
+func (a *ApplicationsClient) ListAll(opts ...ListOptions) (*ApplicationList, error) {
+	return a.c.ListAllApplications(opts...)
+}
+
+// Read application
+func (a *ApplicationsClient) Read(accountID, id int64) (*Application, error) {
+	return a.c.Application(accountID, id)
+}
+
+// Create application subscribed to the given application plan
+func (a *ApplicationsClient) Create(accountID, planID int64, name, description string) (*Application, error) {
+	return a.c.CreateApplication(accountID, planID, name, Params{"description": description})
+}
+
+// Update application
+func (a *ApplicationsClient) Update(accountID, id int64, params Params) (*Application, error) {
+	return a.c.UpdateApplication(accountID, id, params)
+}
+
+// Delete application
+func (a *ApplicationsClient) Delete(accountID, id int64) error {
+	return a.c.DeleteApplication(accountID, id)
+}
+
+// ChangePlan of application
+func (a *ApplicationsClient) ChangePlan(accountID, id, planID int64) (*Application, error) {
+	return a.c.ChangeApplicationPlan(accountID, id, planID)
+}
+
+// Suspend application
+func (a *ApplicationsClient) Suspend(accountID, id int64) (*Application, error) {
+	return a.c.ApplicationSuspend(accountID, id)
+}
+
+// Resume suspended application
+func (a *ApplicationsClient) Resume(accountID, id int64) (*Application, error) {
+	return a.c.ApplicationResume(accountID, id)
+}
+
+// Ensure application exists, see ThreeScaleClient.EnsureApplication
+func (a *ApplicationsClient) Ensure(accountID, planID int64, name string, params Params) (*Application, error) {
+	return a.c.EnsureApplication(accountID, planID, name, params)
+}
+
+// List developer accounts
+func (a *AccountsClient) List(opts ...ListOptions) (*DeveloperAccountList, error) {
+	return a.c.ListDeveloperAccounts(opts...)
+}
+
+// ListPerPage developer accounts in a single page, see ThreeScaleClient.ListDeveloperAccountsPerPage
+func (a *AccountsClient) ListPerPage(paginationValues ...int) (*DeveloperAccountList, error) {
+	return a.c.ListDeveloperAccountsPerPage(paginationValues...)
+}
+
+// Read developer account
+func (a *AccountsClient) Read(id int64) (*DeveloperAccount, error) {
+	return a.c.DeveloperAccount(id)
+}
+
+// Find developer account by username
+func (a *AccountsClient) Find(username string) (*Account, error) {
+	return a.c.FindAccount(username)
+}
+
+// Create developer account and its admin user, see ThreeScaleClient.Signup
+func (a *AccountsClient) Create(params Params) (*DeveloperAccount, error) {
+	return a.c.Signup(params)
+}
+
+// Update developer account
+func (a *AccountsClient) Update(account *DeveloperAccount) (*DeveloperAccount, error) {
+	return a.c.UpdateDeveloperAccount(account)
+}
+
+// Delete developer account
+func (a *AccountsClient) Delete(id int64) error {
+	return a.c.DeleteDeveloperAccount(id)
+}
+
+// Ensure developer account exists, see ThreeScaleClient.EnsureAccount
+func (a *AccountsClient) Ensure(username string, params Params) (*DeveloperAccount, error) {
+	return a.c.EnsureAccount(username, params)
+}
+
+// Read product proxy
+func (p *ProxiesClient) Read(productID int64) (*ProxyJSON, error) {
+	return p.c.ProductProxy(productID)
+}
+
+// Update product proxy, see ThreeScaleClient.UpdateProductProxy for common params
+func (p *ProxiesClient) Update(productID int64, params Params) (*ProxyJSON, error) {
+	return p.c.UpdateProductProxy(productID, params)
+}
+
+// Deploy product proxy configuration to staging
+func (p *ProxiesClient) Deploy(productID int64) (*ProxyJSON, error) {
+	return p.c.DeployProductProxy(productID)
+}
+
+// Config returns the product proxy config of the environment at the given version
+func (p *ProxiesClient) Config(productID int64, env, version string) (ProxyConfigElement, error) {
+	return p.c.GetProxyConfig(strconv.FormatInt(productID, 10), env, version)
+}
+
+// LatestConfig returns the latest product proxy config of the environment
+func (p *ProxiesClient) LatestConfig(productID int64, env string) (ProxyConfigElement, error) {
+	return p.c.GetLatestProxyConfig(strconv.FormatInt(productID, 10), env)
+}
+
+// ListConfigs of the product proxy in the environment
+func (p *ProxiesClient) ListConfigs(productID int64, env string, opts ...ListOptions) (ProxyConfigList, error) {
+	return p.c.ListProxyConfig(strconv.FormatInt(productID, 10), env, opts...)
+}
+
+// PromoteConfig promotes the product proxy config version from env to toEnv
+func (p *ProxiesClient) PromoteConfig(productID int64, env, version, toEnv string) (ProxyConfigElement, error) {
+	return p.c.PromoteProxyConfig(strconv.FormatInt(productID, 10), env, version, toEnv)
+}
+
+// List backend APIs
+func (b *BackendsClient) List(opts ...ListOptions) (*BackendApiList, error) {
+	return b.c.ListBackendApis(opts...)
+}
+
+// ListPerPage backend APIs in a single page, see ThreeScaleClient.ListBackendApisPerPage
+func (b *BackendsClient) ListPerPage(paginationValues ...int) (*BackendApiList, error) {
+	return b.c.ListBackendApisPerPage(paginationValues...)
+}
+
+// Read backend API
+func (b *BackendsClient) Read(id int64) (*BackendApi, error) {
+	return b.c.BackendApi(id)
+}
+
+// Create backend API
+func (b *BackendsClient) Create(params Params) (*BackendApi, error) {
+	return b.c.CreateBackendApi(params)
+}
+
+// Update backend API
+func (b *BackendsClient) Update(id int64, params Params) (*BackendApi, error) {
+	return b.c.UpdateBackendApi(id, params)
+}
+
+// Delete backend API
+func (b *BackendsClient) Delete(id int64) error {
+	return b.c.DeleteBackendApi(id)
+}
+
+// ListUsages of backend APIs by the product
+func (b *BackendsClient) ListUsages(productID int64, opts ...ListOptions) (BackendAPIUsageList, error) {
+	return b.c.ListBackendapiUsages(productID, opts...)
+}
+
+// CreateUsage adds a backend API to the product, params must include backend_api_id and path
+func (b *BackendsClient) CreateUsage(productID int64, params Params) (*BackendAPIUsage, error) {
+	return b.c.CreateBackendapiUsage(productID, params)
+}
+
+// DeleteUsage removes a backend API from the product
+func (b *BackendsClient) DeleteUsage(productID, backendUsageID int64) error {
+	return b.c.DeleteBackendapiUsage(productID, backendUsageID)
+}
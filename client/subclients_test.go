@@ -0,0 +1,79 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+func TestApplicationsClient(t *testing.T) {
+	var (
+		accountID int64 = 3
+		planID    int64 = 7
+	)
+
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{
+			Method: http.MethodPost,
+			Path:   fmt.Sprintf(appCreate, "3"),
+			Form:   map[string]string{"account_id": "3", "plan_id": "7", "name": "app", "description": "desc"},
+		}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusCreated, ApplicationElem{Application: Application{ID: 10, AppName: "app"}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	app, err := c.Applications().Create(accountID, planID, "app", "desc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, int64(10), app.ID)
+}
+
+func TestAccountsClient(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: fmt.Sprintf(developerAccountResourceEndpoint, 3)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, DeveloperAccount{Element: DeveloperAccountItem{ID: &[]int64{3}[0]}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	account, err := c.Accounts().Read(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, int64(3), *account.Element.ID)
+}
+
+func TestProxiesClient(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: fmt.Sprintf(proxyConfigLatestGet, "5", ProxyConfigEnvSandbox)}.Assert(t, req)
+
+		return jsonTestResponse(t, http.StatusOK, ProxyConfigElement{ProxyConfig: ProxyConfig{Version: 2}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	config, err := c.Proxies().LatestConfig(5, ProxyConfigEnvSandbox)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, config.ProxyConfig.Version)
+}
+
+func TestBackendsClient(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodDelete, Path: fmt.Sprintf(backendResourceEndpoint, 4)}.Assert(t, req)
+
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Header: make(http.Header)}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.Backends().Delete(4); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+// Validate checks the configuration for dangling references without calling 3scale:
+// plans must belong to an existing product, metrics to an existing product or backend api,
+// backend usages must link existing products and backend apis, and limits must reference an existing plan and
+// a metric of the plan product or of a backend api used by it.
+// Every problem found is returned together as ReferenceErrors
+func (cfg *TenantConfig) Validate() error {
+	var errs ReferenceErrors
+
+	products := map[int64]bool{}
+	for _, product := range cfg.Products {
+		products[product.ID] = true
+	}
+
+	backendApis := map[int64]bool{}
+	for _, backendApi := range cfg.BackendApis {
+		backendApis[backendApi.ID] = true
+	}
+
+	planProducts := map[int64]int64{}
+	for _, plan := range cfg.ApplicationPlans {
+		planProducts[plan.Plan.ID] = plan.ProductID
+		if !products[plan.ProductID] {
+			errs = append(errs, ReferenceError{"application_plan", plan.Plan.ID, "product_id", plan.ProductID})
+		}
+	}
+
+	productMetrics := map[int64]map[int64]bool{}
+	backendApiMetrics := map[int64]map[int64]bool{}
+	addMetric := func(owners map[int64]map[int64]bool, ownerID, metricID int64) {
+		if owners[ownerID] == nil {
+			owners[ownerID] = map[int64]bool{}
+		}
+		owners[ownerID][metricID] = true
+	}
+	for _, metric := range cfg.Metrics {
+		switch {
+		case metric.BackendApiID != 0:
+			addMetric(backendApiMetrics, metric.BackendApiID, metric.Metric.ID)
+			if !backendApis[metric.BackendApiID] {
+				errs = append(errs, ReferenceError{"metric", metric.Metric.ID, "backend_api_id", metric.BackendApiID})
+			}
+		default:
+			addMetric(productMetrics, metric.ProductID, metric.Metric.ID)
+			if !products[metric.ProductID] {
+				errs = append(errs, ReferenceError{"metric", metric.Metric.ID, "product_id", metric.ProductID})
+			}
+		}
+	}
+
+	productBackendApis := map[int64][]int64{}
+	for _, usage := range cfg.BackendUsages {
+		productBackendApis[usage.ProductID] = append(productBackendApis[usage.ProductID], usage.BackendAPIID)
+		if !products[usage.ProductID] {
+			errs = append(errs, ReferenceError{"backend_usage", usage.ID, "service_id", usage.ProductID})
+		}
+		if !backendApis[usage.BackendAPIID] {
+			errs = append(errs, ReferenceError{"backend_usage", usage.ID, "backend_id", usage.BackendAPIID})
+		}
+	}
+
+	for _, limit := range cfg.Limits {
+		productID, ok := planProducts[limit.PlanID]
+		if !ok {
+			errs = append(errs, ReferenceError{"limit", limit.ID, "plan_id", limit.PlanID})
+			continue
+		}
+
+		metricFound := productMetrics[productID][limit.MetricID]
+		for _, backendApiID := range productBackendApis[productID] {
+			metricFound = metricFound || backendApiMetrics[backendApiID][limit.MetricID]
+		}
+		if !metricFound {
+			errs = append(errs, ReferenceError{"limit", limit.ID, "metric_id", limit.MetricID})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
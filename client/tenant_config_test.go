@@ -0,0 +1,59 @@
+package client
+
+import (
+	"testing"
+)
+
+func validTenantConfig() *TenantConfig {
+	return &TenantConfig{
+		Products:    []ProductItem{{ID: 1}},
+		BackendApis: []BackendApiItem{{ID: 2}},
+		ApplicationPlans: []TenantConfigPlan{
+			{ProductID: 1, Plan: ApplicationPlanItem{ID: 10}},
+		},
+		Metrics: []TenantConfigMetric{
+			{ProductID: 1, Metric: MetricItem{ID: 100}},
+			{BackendApiID: 2, Metric: MetricItem{ID: 200}},
+		},
+		Limits: []ApplicationPlanLimitItem{
+			{ID: 1000, PlanID: 10, MetricID: 100},
+			{ID: 1001, PlanID: 10, MetricID: 200},
+		},
+		BackendUsages: []BackendAPIUsageItem{
+			{ID: 50, ProductID: 1, BackendAPIID: 2},
+		},
+	}
+}
+
+func TestTenantConfigValidate(t *testing.T) {
+	if err := validTenantConfig().Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTenantConfigValidateDanglingReferences(t *testing.T) {
+	cfg := validTenantConfig()
+	cfg.ApplicationPlans = append(cfg.ApplicationPlans, TenantConfigPlan{ProductID: 9, Plan: ApplicationPlanItem{ID: 11}})
+	cfg.Metrics = append(cfg.Metrics, TenantConfigMetric{BackendApiID: 8, Metric: MetricItem{ID: 300}})
+	cfg.BackendUsages = []BackendAPIUsageItem{{ID: 50, ProductID: 1, BackendAPIID: 7}}
+	cfg.Limits = append(cfg.Limits, ApplicationPlanLimitItem{ID: 1002, PlanID: 12, MetricID: 100})
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	errs, ok := err.(ReferenceErrors)
+	if !ok {
+		t.Fatalf("expected ReferenceErrors; got %T", err)
+	}
+
+	equals(t, ReferenceErrors{
+		{"application_plan", 11, "product_id", 9},
+		{"metric", 300, "backend_api_id", 8},
+		{"backend_usage", 50, "backend_id", 7},
+		// backend api 2 is no longer used by product 1
+		{"limit", 1001, "metric_id", 200},
+		{"limit", 1002, "plan_id", 12},
+	}, errs)
+}
@@ -14,6 +14,7 @@ const (
 )
 
 // CreateTenant creates new tenant using 3scale API
+// Requires master credentials, see MasterClient
 func (c *ThreeScaleClient) CreateTenant(orgName, username, email, password string) (*Tenant, error) {
 	values := url.Values{}
 	values.Add("org_name", orgName)
@@ -27,15 +28,7 @@ func (c *ThreeScaleClient) CreateTenant(orgName, username, email, password strin
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	tenant := &Tenant{}
-	err = handleJsonResp(resp, http.StatusCreated, tenant)
-	return tenant, err
+	return doReq[Tenant](c, req, http.StatusCreated)
 }
 
 // ShowTenant - Returns tenant info for the specified ID
@@ -46,15 +39,7 @@ func (c *ThreeScaleClient) ShowTenant(tenantID int64) (*Tenant, error) {
 		return nil, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	tenant := &Tenant{}
-	err = handleJsonResp(resp, http.StatusOK, tenant)
-	return tenant, err
+	return doReq[Tenant](c, req, http.StatusOK)
 }
 
 // UpdateTenant - Updates tenant info for the specified ID
@@ -71,15 +56,7 @@ func (c *ThreeScaleClient) UpdateTenant(tenantID int64, params Params) (*Tenant,
 		return nil, httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	tenant := &Tenant{}
-	err = handleJsonResp(resp, http.StatusOK, tenant)
-	return tenant, err
+	return doReq[Tenant](c, req, http.StatusOK)
 }
 
 // DeleteTenant - Schedules a tenant account to be permanently deleted in X days (check Porta doc)
@@ -91,7 +68,7 @@ func (c *ThreeScaleClient) DeleteTenant(tenantID int64) error {
 		return httpReqError
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WithTimeout returns a client whose calls fail when not completed within timeout,
+// e.g. short timeouts for reads and longer ones for proxy promotions:
+//
+//	c.WithTimeout(2 * time.Minute).PromoteProxyConfig(productID, "sandbox", version, "production")
+//
+// The timeout covers the whole call, including reading the response body, and applies on top of
+// the http.Client timeout, the shortest one winning. The returned client shares the configuration
+// of c like clients returned by WithHeaders. A timeout lower than 1 sets no per-call timeout
+func (c *ThreeScaleClient) WithTimeout(timeout time.Duration) *ThreeScaleClient {
+	derived := c.derive()
+	derived.timeout = timeout
+	return derived
+}
+
+// doTimedRequest sends the request through the parent client, canceling it once the timeout expires
+func (c *ThreeScaleClient) doTimedRequest(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), c.timeout)
+	resp, err := c.parent.doRequest(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelBody releases the request context once the response body is closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	delay := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			select {
+			case <-delay:
+			case <-r.Context().Done():
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	defer server.Close()
+	defer close(delay)
+
+	ap, err := NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewThreeScale(ap, "someAccessToken", server.Client())
+
+	if _, err := c.WithTimeout(time.Second).ListProductsPerPage(1); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.WithTimeout(50 * time.Millisecond).WithHeaders(http.Header{"X-Request-Id": {"someID"}}).ListProductsPerPage(2)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded; got %v", err)
+	}
+}
+
+func TestWithTimeoutKeepsParentUntouched(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	derived := c.WithTimeout(time.Second)
+
+	equals(t, time.Duration(0), c.timeout)
+	equals(t, c, derived.parent)
+	equals(t, c, derived.WithHeaders(http.Header{}).parent)
+	equals(t, time.Second, derived.WithHeaders(http.Header{}).timeout)
+}
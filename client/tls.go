@@ -0,0 +1,52 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
+
+// TLSOptions configures how the client verifies and authenticates to on-premises 3scale installs
+type TLSOptions struct {
+	// CACertificates is a PEM bundle of CA certificates trusted on top of the system ones
+	CACertificates []byte
+	// Certificates are presented to the server for mutual TLS, see tls.LoadX509KeyPair
+	Certificates []tls.Certificate
+	// InsecureSkipVerify disables the server certificate verification. Use only for testing
+	InsecureSkipVerify bool
+}
+
+// SetTLSOptions configures TLS on a copy of the client transport.
+// Fails when the CA bundle holds no valid certificate or when the client was built with a custom http.RoundTripper
+func (c *ThreeScaleClient) SetTLSOptions(opts TLSOptions) error {
+	var rootCAs *x509.CertPool
+	if len(opts.CACertificates) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(opts.CACertificates) {
+			return errors.New("no valid PEM certificate found in CA bundle")
+		}
+		rootCAs = pool
+	}
+
+	return c.configureTransport(func(transport *http.Transport) error {
+		tlsConfig := &tls.Config{}
+		if transport.TLSClientConfig != nil {
+			tlsConfig = transport.TLSClientConfig.Clone()
+		}
+
+		if rootCAs != nil {
+			tlsConfig.RootCAs = rootCAs
+		}
+		if len(opts.Certificates) > 0 {
+			tlsConfig.Certificates = opts.Certificates
+		}
+		tlsConfig.InsecureSkipVerify = opts.InsecureSkipVerify
+
+		transport.TLSClientConfig = tlsConfig
+		return nil
+	})
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTLSTestServer(t *testing.T, clientAuth tls.ClientAuthType) *httptest.Server {
+	t.Helper()
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"services":[]}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: clientAuth}
+	server.StartTLS()
+	return server
+}
+
+func newTLSTestClient(t *testing.T, server *httptest.Server) *ThreeScaleClient {
+	t.Helper()
+	ap, err := NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewThreeScale(ap, "someAccessToken", &http.Client{Transport: &http.Transport{}})
+}
+
+func TestSetTLSOptions(t *testing.T) {
+	server := newTLSTestServer(t, tls.RequireAnyClientCert)
+	defer server.Close()
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := []struct {
+		Name    string
+		opts    *TLSOptions
+		success bool
+	}{
+		{"untrusted CA", nil, false},
+		{"missing client certificate", &TLSOptions{CACertificates: caBundle}, false},
+		{"mutual TLS", &TLSOptions{CACertificates: caBundle, Certificates: server.TLS.Certificates}, true},
+		{"insecure", &TLSOptions{InsecureSkipVerify: true, Certificates: server.TLS.Certificates}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subTest *testing.T) {
+			c := newTLSTestClient(subTest, server)
+			if tt.opts != nil {
+				if err := c.SetTLSOptions(*tt.opts); err != nil {
+					subTest.Fatal(err)
+				}
+			}
+
+			_, err := c.ListProductsPerPage()
+			if tt.success && err != nil {
+				subTest.Fatal(err)
+			}
+			if !tt.success && err == nil {
+				subTest.Fatal("expected TLS error")
+			}
+		})
+	}
+}
+
+func TestSetTLSOptionsInvalidBundle(t *testing.T) {
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", nil)
+	if err := c.SetTLSOptions(TLSOptions{CACertificates: []byte("not a certificate")}); err == nil {
+		t.Fatal("expected invalid bundle error")
+	}
+}
+
+func TestSetTLSOptionsCustomTransport(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	if err := c.SetTLSOptions(TLSOptions{InsecureSkipVerify: true}); err == nil {
+		t.Fatal("expected unsupported transport error")
+	}
+}
+
+func TestSetTLSOptionsKeepsInterceptors(t *testing.T) {
+	server := newTLSTestServer(t, tls.NoClientCert)
+	defer server.Close()
+
+	calls := 0
+	c := newTLSTestClient(t, server)
+	c.Use(func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return next.RoundTrip(req)
+		})
+	})
+	if err := c.SetTLSOptions(TLSOptions{InsecureSkipVerify: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ListProductsPerPage(); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 1, calls)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// configureTransport applies configure to a copy of the *http.Transport sending the client requests.
+// The http client passed to NewThreeScale is not modified.
+// Fails when the client was built with a custom http.RoundTripper, which must then be configured by the caller
+func (c *ThreeScaleClient) configureTransport(configure func(*http.Transport) error) error {
+	base := c.httpClient.Transport
+	if c.interceptors != nil {
+		base = c.baseTransport
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	transport, ok := base.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("cannot configure transport of type %T, only *http.Transport is supported", base)
+	}
+	transport = transport.Clone()
+	if err := configure(transport); err != nil {
+		return err
+	}
+
+	if c.interceptors != nil {
+		c.baseTransport = transport
+		c.rebuildTransport()
+		return nil
+	}
+
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+	return nil
+}
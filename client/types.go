@@ -1,5 +1,7 @@
 package client
 
+//go:generate go run ../tools/deepcopy-gen -output zz_generated.deepcopy.go types.go
+
 import (
 	"encoding/json"
 	"encoding/xml"
@@ -9,29 +11,98 @@ import (
 )
 
 // AdminPortal defines a 3scale adminPortal service
+// +deepcopy-gen=false
 type AdminPortal struct {
 	rawURL string
 	url    *url.URL
 }
 
 // ThreeScaleClient interacts with 3scale Service Management API
+// +deepcopy-gen=false
 type ThreeScaleClient struct {
 	adminPortal   *AdminPortal
 	credential    string
 	httpClient    *http.Client
 	afterResponse AfterResponseCB
+	requestLogger RequestLogCB
+	rateLimitHook RateLimitCB
+	// deprecationHook receives the Deprecation and Sunset headers, see SetDeprecationHook
+	deprecationHook DeprecationCB
+	debugHistory    *debugHistory
+	debugDump       *debugDump
+	apiBasePath     string
+	pathOverrides   map[string]string
+	credentials     *credentialTracker
+	// credentialProvider, when set, provides the credential of every request, see SetCredentialProvider
+	credentialProvider CredentialProvider
+	// userAgentSuffix is appended to DefaultUserAgent
+	userAgentSuffix string
+	// parent sends the requests of clients derived with WithHeaders, adding header to them
+	parent *ThreeScaleClient
+	header http.Header
+	// timeout bounds the requests of clients derived with WithTimeout
+	timeout time.Duration
+	// interceptors registered with Use, wrapping baseTransport
+	interceptors  []Interceptor
+	baseTransport http.RoundTripper
+	// conflictRetries is the number of times GET requests answered with 409 Conflict are retried
+	conflictRetries int
+	conflictBackoff time.Duration
+	// maxResponseSize limits the response body bytes read, see SetMaxResponseSize
+	maxResponseSize int64
+	// pageConcurrency is the number of pages ListAll methods fetch concurrently, see SetPageConcurrency
+	pageConcurrency int
+	// responseCache revalidates GET responses with their ETag, see EnableResponseCache
+	responseCache ResponseCache
+	// breaker fails requests fast while the Admin Portal is down, see SetCircuitBreaker
+	breaker *circuitBreaker
+	// waitInitialInterval and waitMaxInterval are the poll intervals of the WaitFor helpers
+	waitInitialInterval time.Duration
+	waitMaxInterval     time.Duration
+	// dryRun records mutating requests instead of sending them, see SetDryRun
+	dryRun *dryRun
+	// bulkConcurrency is the number of concurrent requests of bulk helpers, see SetBulkConcurrency
+	bulkConcurrency int
+}
+
+// PublicClient performs unauthenticated requests against public 3scale endpoints
+// +deepcopy-gen=false
+type PublicClient struct {
+	httpClient *http.Client
 }
 
 // AfterResponseCB provides a hook that can be used to infer details of the underlying HTTP request/response
+// +deepcopy-gen=false
 type AfterResponseCB func(statusCode int, timeTaken time.Duration)
 
+// RequestLogCB receives the sanitized summary of every request sent to 3scale
+// +deepcopy-gen=false
+type RequestLogCB func(summary RequestSummary)
+
+// CredentialRefreshCB provides a new credential and its expiry time, called ahead of the current credential expiry.
+// A zero expiresAt means the new credential does not expire
+// +deepcopy-gen=false
+type CredentialRefreshCB func() (credential string, expiresAt time.Time, err error)
+
+// RequestSummary - Sanitized summary of a request sent to 3scale and its response
+// Credentials are never included in the summary
+type RequestSummary struct {
+	Time       time.Time         `json:"time"`
+	Method     string            `json:"method"`
+	URL        string            `json:"url"`
+	Header     map[string]string `json:"header,omitempty"`
+	StatusCode int               `json:"status_code,omitempty"`
+	Duration   time.Duration     `json:"duration"`
+	Error      string            `json:"error,omitempty"`
+}
+
 // Application - API response for create app endpoint
 type Application struct {
 	ID                      int64  `json:"id"`
 	CreatedAt               string `json:"created_at"`
 	UpdatedAt               string `json:"updated_at"`
 	State                   string `json:"state"`
-	UserAccountID           string `json:"user_account_id"`
+	UserAccountID           int64  `json:"user_account_id"`
 	FirstTrafficAt          string `json:"first_traffic_at"`
 	FirstDailyTrafficAt     string `json:"first_daily_traffic_at"`
 	EndUserRequired         bool   `json:"end_user_required"`
@@ -43,6 +114,8 @@ type Application struct {
 	Description             string `json:"description"`
 	ExtraFields             string `json:"extra_fields"`
 	Error                   string `json:"error,omitempty"`
+	// Extra holds the custom fields, see the Extra field of DeveloperAccountItem
+	Extra map[string]interface{} `json:"-"`
 }
 
 // ApplicationElem - Holds a intenal application element
@@ -50,9 +123,20 @@ type ApplicationElem struct {
 	Application Application `json:"application"`
 }
 
+// PageInfo - Pagination metadata of a list response, read from the "metadata" object of the body or,
+// when missing, from the X-Total, X-Total-Pages, X-Page and X-Per-Page headers.
+// Zero fields are not reported by the endpoint
+type PageInfo struct {
+	CurrentPage  int `json:"current_page"`
+	PerPage      int `json:"per_page"`
+	TotalEntries int `json:"total_entries"`
+	TotalPages   int `json:"total_pages"`
+}
+
 // ApplicationList - Holds a list of applications
 type ApplicationList struct {
 	Applications []ApplicationElem `json:"applications"`
+	Metadata     *PageInfo         `json:"metadata,omitempty"`
 }
 
 // ApplicationPlansList - Holds a list of application plans
@@ -182,6 +266,7 @@ type Service struct {
 type ServiceList struct {
 	XMLName  xml.Name  `xml:"services"`
 	Services []Service `xml:"service"`
+	Metadata *PageInfo `xml:"-"`
 }
 
 type ErrorResp struct {
@@ -203,6 +288,7 @@ type ProxyConfig struct {
 
 type ProxyConfigList struct {
 	ProxyConfigs []ProxyConfigElement `json:"proxy_configs"`
+	Metadata     *PageInfo            `json:"metadata,omitempty"`
 }
 
 type ProxyConfigElement struct {
@@ -407,6 +493,9 @@ type DeveloperAccountItem struct {
 	PoNumber               *string             `json:"po_number,omitempty"`
 	CreatedAt              *string             `json:"created_at,omitempty"`
 	UpdatedAt              *string             `json:"updated_at,omitempty"`
+	// Extra holds the custom fields defined by the provider, which are not known to the client.
+	// They are kept when decoding and sent along with the other fields when encoding
+	Extra map[string]interface{} `json:"-"`
 }
 
 type DeveloperAccount struct {
@@ -414,7 +503,8 @@ type DeveloperAccount struct {
 }
 
 type DeveloperAccountList struct {
-	Items []DeveloperAccount `json:"accounts"`
+	Items    []DeveloperAccount `json:"accounts"`
+	Metadata *PageInfo          `json:"metadata,omitempty"`
 }
 
 type AccessToken struct {
@@ -434,6 +524,27 @@ type Tenant struct {
 	Signup Signup `json:"signup"`
 }
 
+// ProviderPlanItem - Account plan of the master account, subscribed by tenants
+type ProviderPlanItem struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	SystemName string `json:"system_name"`
+	State      string `json:"state"`
+	Default    bool   `json:"default"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// ProviderPlan - Holds a provider plan serialized/Unserialized in json format
+type ProviderPlan struct {
+	Element ProviderPlanItem `json:"account_plan"`
+}
+
+// ProviderPlanList - Holds a list of provider plans serialized/Unserialized in json format
+type ProviderPlanList struct {
+	Plans []ProviderPlan `json:"plans"`
+}
+
 type ProductItem struct {
 	ID                        int64  `json:"id"`
 	Name                      string `json:"name"`
@@ -462,6 +573,7 @@ type Product struct {
 
 type ProductList struct {
 	Products []Product `json:"services"`
+	Metadata *PageInfo `json:"metadata,omitempty"`
 }
 
 type BackendApiItem struct {
@@ -481,6 +593,7 @@ type BackendApi struct {
 
 type BackendApiList struct {
 	Backends []BackendApi `json:"backend_apis"`
+	Metadata *PageInfo    `json:"metadata,omitempty"`
 }
 
 // MethodItem - Defines the method object
@@ -500,7 +613,8 @@ type Method struct {
 
 // MethodList - Holds a list of Methods
 type MethodList struct {
-	Methods []Method `json:"methods"`
+	Methods  []Method  `json:"methods"`
+	Metadata *PageInfo `json:"metadata,omitempty"`
 }
 
 // MetricItem - Defines the metric object serialized/Unserialized in json format
@@ -521,7 +635,8 @@ type MetricJSON struct {
 
 // MetricJSONList - Holds a list of Metrics serialized/Unserialized in json format
 type MetricJSONList struct {
-	Metrics []MetricJSON `json:"metrics"`
+	Metrics  []MetricJSON `json:"metrics"`
+	Metadata *PageInfo    `json:"metadata,omitempty"`
 }
 
 // MappingRuleItem - Defines the mapping rule object serialized/Unserialized in json format
@@ -545,6 +660,7 @@ type MappingRuleJSON struct {
 // MappingRuleJSONList - Holds a list of MappingRules serialized/Unserialized in json format
 type MappingRuleJSONList struct {
 	MappingRules []MappingRuleJSON `json:"mapping_rules"`
+	Metadata     *PageInfo         `json:"metadata,omitempty"`
 }
 
 // BackendAPIUsageItem - Defines the backend usage object serialized/Unserialized in json format
@@ -759,6 +875,8 @@ type DeveloperUserItem struct {
 	Email     *string `json:"email,omitempty"`
 	CreatedAt *string `json:"created_at,omitempty"`
 	UpdatedAt *string `json:"updated_at,omitempty"`
+	// Extra holds the custom fields, see the Extra field of DeveloperAccountItem
+	Extra map[string]interface{} `json:"-"`
 }
 
 type DeveloperUser struct {
@@ -768,3 +886,524 @@ type DeveloperUser struct {
 type DeveloperUserList struct {
 	Items []DeveloperUser `json:"users"`
 }
+
+// AccountMessageItem - Defines the message sent to a developer account serialized/Unserialized in json format
+type AccountMessageItem struct {
+	ID        int64  `json:"id"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+}
+
+// AccountMessage - Holds a message obj serialized/Unserialized in json format
+type AccountMessage struct {
+	Element AccountMessageItem `json:"message"`
+}
+
+// FeatureItem - Defines the feature object serialized/Unserialized in json format
+type FeatureItem struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	SystemName  string `json:"system_name"`
+	Scope       string `json:"scope"`
+	Visible     bool   `json:"visible"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// Feature - Holds a feature obj serialized/Unserialized in json format
+type Feature struct {
+	Element FeatureItem `json:"feature"`
+}
+
+// FeatureList - Holds a list of features serialized/Unserialized in json format
+type FeatureList struct {
+	Features []Feature `json:"features"`
+}
+
+// PlanLimitDiff - Holds the limits of a metric and period that differ between two plans
+// PlanA or PlanB is nil when the limit only exists in the other plan
+type PlanLimitDiff struct {
+	MetricID int64                     `json:"metric_id"`
+	Period   string                    `json:"period"`
+	PlanA    *ApplicationPlanLimitItem `json:"plan_a,omitempty"`
+	PlanB    *ApplicationPlanLimitItem `json:"plan_b,omitempty"`
+}
+
+// PlanPricingRuleDiff - Holds the pricing rules of a metric and range that differ between two plans
+// PlanA or PlanB is nil when the pricing rule only exists in the other plan
+type PlanPricingRuleDiff struct {
+	MetricID int64                           `json:"metric_id"`
+	Min      int                             `json:"min"`
+	Max      int                             `json:"max"`
+	PlanA    *ApplicationPlanPricingRuleItem `json:"plan_a,omitempty"`
+	PlanB    *ApplicationPlanPricingRuleItem `json:"plan_b,omitempty"`
+}
+
+// PlanFeatureDiff - Holds a feature enabled in only one of two plans
+// PlanA or PlanB is nil when the feature only exists in the other plan
+type PlanFeatureDiff struct {
+	SystemName string       `json:"system_name"`
+	PlanA      *FeatureItem `json:"plan_a,omitempty"`
+	PlanB      *FeatureItem `json:"plan_b,omitempty"`
+}
+
+// PlanComparison - Holds the differences between two application plans
+type PlanComparison struct {
+	PlanAID      int64                 `json:"plan_a_id"`
+	PlanBID      int64                 `json:"plan_b_id"`
+	Limits       []PlanLimitDiff       `json:"limits"`
+	PricingRules []PlanPricingRuleDiff `json:"pricing_rules"`
+	Features     []PlanFeatureDiff     `json:"features"`
+}
+
+// ServicePlanItem - Defines the service plan object serialized/Unserialized in json format
+type ServicePlanItem struct {
+	ID               int64   `json:"id"`
+	Name             string  `json:"name"`
+	SystemName       string  `json:"system_name"`
+	State            string  `json:"state"`
+	ServiceID        int64   `json:"service_id"`
+	SetupFee         float64 `json:"setup_fee"`
+	CostPerMonth     float64 `json:"cost_per_month"`
+	TrialPeriodDays  int     `json:"trial_period_days"`
+	ApprovalRequired bool    `json:"approval_required"`
+	Default          bool    `json:"default"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+// ServicePlan - Holds a service plan obj serialized/Unserialized in json format
+type ServicePlan struct {
+	Element ServicePlanItem `json:"service_plan"`
+}
+
+// ServicePlanList - Holds a list of service plans serialized/Unserialized in json format
+type ServicePlanList struct {
+	Plans []ServicePlan `json:"plans"`
+}
+
+// ServiceSubscriptionItem - Defines the service subscription object serialized/Unserialized in json format
+type ServiceSubscriptionItem struct {
+	ID        int64  `json:"id"`
+	PlanID    int64  `json:"plan_id"`
+	ServiceID int64  `json:"service_id"`
+	AccountID int64  `json:"user_account_id"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ServiceSubscription - Holds a service subscription obj serialized/Unserialized in json format
+type ServiceSubscription struct {
+	Element ServiceSubscriptionItem `json:"service_subscription"`
+}
+
+// ServiceSubscriptionList - Holds a list of service subscriptions serialized/Unserialized in json format
+type ServiceSubscriptionList struct {
+	Subscriptions []ServiceSubscription `json:"service_subscriptions"`
+}
+
+// EndpointStatus - Result of a status check against a public endpoint
+type EndpointStatus struct {
+	URL        string        `json:"url"`
+	StatusCode int           `json:"status_code"`
+	Duration   time.Duration `json:"duration"`
+	Healthy    bool          `json:"healthy"`
+}
+
+// RateLimitSpec - Declarative rate limit applied to the application plans of a product
+type RateLimitSpec struct {
+	// Metric system name. The metric is created when missing
+	Metric string `json:"metric"`
+	// Period is one of eternity, year, month, week, day, hour or minute
+	Period string `json:"period"`
+	Value  int    `json:"value"`
+	// Plans system names the limit applies to. Empty selects every plan of the product
+	Plans []string `json:"plans,omitempty"`
+	// Pattern of the mapping rule reporting the metric. No mapping rule is managed when empty
+	Pattern string `json:"pattern,omitempty"`
+	// HTTPMethod of the mapping rule. Defaults to GET
+	HTTPMethod string `json:"http_method,omitempty"`
+}
+
+// RateLimitPlanResult - Limit of one application plan after applying a RateLimitSpec
+type RateLimitPlanResult struct {
+	PlanID int64                    `json:"plan_id"`
+	Limit  ApplicationPlanLimitItem `json:"limit"`
+	Action string                   `json:"action"`
+}
+
+// RateLimitResult - Outcome of applying a RateLimitSpec
+type RateLimitResult struct {
+	MetricID           int64                 `json:"metric_id"`
+	MetricCreated      bool                  `json:"metric_created"`
+	MappingRuleID      int64                 `json:"mapping_rule_id,omitempty"`
+	MappingRuleCreated bool                  `json:"mapping_rule_created"`
+	Limits             []RateLimitPlanResult `json:"limits"`
+}
+
+// APIcastDeploymentParams - Parameters to configure a self-managed APIcast gateway for one product environment
+type APIcastDeploymentParams struct {
+	ServiceID int64 `json:"service_id"`
+	// Version of the proxy config the parameters were read from
+	Version int `json:"version"`
+	// Environment of the proxy config, one of 'sandbox', 'production'
+	Environment string `json:"environment"`
+	// DeploymentEnv is the APIcast THREESCALE_DEPLOYMENT_ENV value, one of 'staging', 'production'
+	DeploymentEnv string `json:"deployment_env"`
+	// PortalEndpoint is the admin portal URL, without credentials
+	PortalEndpoint  string   `json:"portal_endpoint,omitempty"`
+	PublicEndpoint  string   `json:"public_endpoint"`
+	Hosts           []string `json:"hosts"`
+	BackendEndpoint string   `json:"backend_endpoint"`
+	BackendHost     string   `json:"backend_host"`
+	// ServiceToken authenticating APIcast against the backend. Empty when the product uses provider keys
+	ServiceToken string `json:"service_token,omitempty"`
+}
+
+// StatsPeriod - Length of an analytics time range starting at its since time, see the StatsPeriod constants
+// +deepcopy-gen=false
+type StatsPeriod string
+
+// StatsGranularity - Resolution of analytics values, see the StatsGranularity constants
+// +deepcopy-gen=false
+type StatsGranularity string
+
+// UsageStatsParams - Query of the analytics usage endpoints
+type UsageStatsParams struct {
+	// MetricName is the system name of the metric. Required
+	MetricName string
+	// Since is the start of the time range, sent as wall clock time in Timezone. Required
+	Since time.Time
+	// Until is the end of the time range. Either Until or Period must be set
+	Until time.Time
+	// Period is the length of the time range starting at Since, one of year, month, week, day
+	Period StatsPeriod
+	// Granularity of the values, one of month, day, hour. Required together with Until.
+	// Together with Period, it can only be the granularity of the period
+	Granularity StatsGranularity
+	// Timezone name of the time range, i.e. "UTC" or "Europe/Madrid". Defaults to the provider timezone
+	Timezone string
+	// SkipChange skips computing the change against the previous time range
+	SkipChange bool
+}
+
+// UsageStatsMetric - Metric of a usage report
+type UsageStatsMetric struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	SystemName string `json:"system_name"`
+	Unit       string `json:"unit"`
+}
+
+// UsageStatsPeriod - Time range of a usage report
+type UsageStatsPeriod struct {
+	Name        string `json:"name,omitempty"`
+	Since       string `json:"since"`
+	Until       string `json:"until"`
+	Timezone    string `json:"timezone"`
+	Granularity string `json:"granularity"`
+}
+
+// UsageStats - Usage report returned by the analytics usage endpoints
+type UsageStats struct {
+	Metric        UsageStatsMetric `json:"metric"`
+	Period        UsageStatsPeriod `json:"period"`
+	Total         int64            `json:"total"`
+	PreviousTotal *int64           `json:"previous_total,omitempty"`
+	Change        *float64         `json:"change,omitempty"`
+	Values        []int64          `json:"values"`
+}
+
+// UsagePoint - Value of a usage report at the start of a granularity step
+type UsagePoint struct {
+	Time  time.Time `json:"time"`
+	Value int64     `json:"value"`
+}
+
+// TopApplicationsParams - Query of the analytics top applications endpoint
+type TopApplicationsParams struct {
+	// MetricName is the system name of the metric. Required
+	MetricName string
+	// Since is the start of the time range. Required
+	Since time.Time
+	// Period is the length of the time range starting at Since, one of year, month, week, day. Required
+	Period StatsPeriod
+}
+
+// TopApplicationRef - Plan or account of a ranked application
+type TopApplicationRef struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// TopApplication - Application usage entry of the top applications ranking
+type TopApplication struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Plan        TopApplicationRef `json:"plan"`
+	Account     TopApplicationRef `json:"account"`
+	Value       int64             `json:"value"`
+}
+
+// TopApplications - Applications of a product ranked by usage
+type TopApplications struct {
+	Metric       UsageStatsMetric `json:"metric"`
+	Period       UsageStatsPeriod `json:"period"`
+	Applications []TopApplication `json:"applications"`
+}
+
+// TenantConfigPlan - Application plan of a product in a TenantConfig
+type TenantConfigPlan struct {
+	ProductID int64               `json:"product_id"`
+	Plan      ApplicationPlanItem `json:"plan"`
+}
+
+// TenantConfigMetric - Metric owned by either a product or a backend api in a TenantConfig
+type TenantConfigMetric struct {
+	ProductID    int64      `json:"product_id,omitempty"`
+	BackendApiID int64      `json:"backend_api_id,omitempty"`
+	Metric       MetricItem `json:"metric"`
+}
+
+// TenantConfig - Desired configuration of a tenant, checked with Validate before being applied
+type TenantConfig struct {
+	Products         []ProductItem              `json:"products"`
+	BackendApis      []BackendApiItem           `json:"backend_apis"`
+	ApplicationPlans []TenantConfigPlan         `json:"application_plans"`
+	Metrics          []TenantConfigMetric       `json:"metrics"`
+	Limits           []ApplicationPlanLimitItem `json:"limits"`
+	BackendUsages    []BackendAPIUsageItem      `json:"backend_usages"`
+}
+
+// AlertItem - Usage limit alert raised when an application reaches a utilization level
+type AlertItem struct {
+	ID            int64   `json:"id"`
+	AccountID     int64   `json:"account_id"`
+	ApplicationID int64   `json:"application_id"`
+	ServiceID     int64   `json:"service_id"`
+	AlertID       int64   `json:"alert_id"`
+	State         string  `json:"state"`
+	Level         int     `json:"level"`
+	Utilization   float64 `json:"utilization"`
+	Message       string  `json:"message"`
+	Timestamp     string  `json:"timestamp"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type Alert struct {
+	Element AlertItem `json:"alert"`
+}
+
+type AlertList struct {
+	Alerts   []Alert   `json:"alerts"`
+	Metadata *PageInfo `json:"metadata,omitempty"`
+}
+
+// InvoicePeriod - Billing period of an invoice
+type InvoicePeriod struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// InvoiceItem - Invoice of a developer account
+type InvoiceItem struct {
+	ID             int64         `json:"id"`
+	FriendlyID     string        `json:"friendly_id"`
+	AccountID      int64         `json:"account_id"`
+	State          string        `json:"state"`
+	Currency       string        `json:"currency"`
+	Cost           float64       `json:"cost"`
+	CostWithoutVAT float64       `json:"cost_without_vat"`
+	VATAmount      float64       `json:"vat_amount"`
+	VATRate        float64       `json:"vat_rate"`
+	Period         InvoicePeriod `json:"period"`
+	IssuedOn       string        `json:"issued_on"`
+	DueOn          string        `json:"due_on"`
+	PaidAt         string        `json:"paid_at"`
+	PDFURL         string        `json:"pdf_url"`
+	CreatedAt      string        `json:"created_at"`
+	UpdatedAt      string        `json:"updated_at"`
+}
+
+type Invoice struct {
+	Element InvoiceItem `json:"invoice"`
+}
+
+type InvoiceList struct {
+	Invoices []Invoice `json:"invoices"`
+	Metadata *PageInfo `json:"metadata,omitempty"`
+}
+
+// InvoiceLineItemItem - Charge of an invoice
+type InvoiceLineItemItem struct {
+	ID          int64   `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Quantity    int     `json:"quantity"`
+	Cost        float64 `json:"cost"`
+	Type        string  `json:"type,omitempty"`
+	CreatedAt   string  `json:"created_at,omitempty"`
+	UpdatedAt   string  `json:"updated_at,omitempty"`
+}
+
+type InvoiceLineItem struct {
+	Element InvoiceLineItemItem `json:"line_item"`
+}
+
+type InvoiceLineItemList struct {
+	LineItems []InvoiceLineItem `json:"line_items"`
+}
+
+// WebhookFailure - Webhook delivery 3scale failed to send
+// Event holds the XML payload of the undelivered event
+type WebhookFailure struct {
+	XMLName xml.Name `xml:"webhooks-failure"`
+	ID      string   `xml:"id"`
+	Time    string   `xml:"time"`
+	Error   string   `xml:"error"`
+	URL     string   `xml:"url"`
+	Event   string   `xml:"event"`
+}
+
+// WebhookFailureList - Holds a list of WebhookFailure
+type WebhookFailureList struct {
+	XMLName  xml.Name         `xml:"webhooks-failures"`
+	Failures []WebhookFailure `xml:"webhooks-failure"`
+}
+
+// SettingsItem - Holds tenant settings. Nil fields are left untouched on update
+type SettingsItem struct {
+	UserAccountAreaEnabled      *bool   `json:"useraccountarea_enabled,omitempty"`
+	HideService                 *bool   `json:"hide_service,omitempty"`
+	SignupsEnabled              *bool   `json:"signups_enabled,omitempty"`
+	AccountApprovalRequired     *bool   `json:"account_approval_required,omitempty"`
+	StrongPasswordsEnabled      *bool   `json:"strong_passwords_enabled,omitempty"`
+	PublicSearch                *bool   `json:"public_search,omitempty"`
+	AccountPlansUIVisible       *bool   `json:"account_plans_ui_visible,omitempty"`
+	ChangeAccountPlanPermission *string `json:"change_account_plan_permission,omitempty"`
+	ServicePlansUIVisible       *bool   `json:"service_plans_ui_visible,omitempty"`
+	ChangeServicePlanPermission *string `json:"change_service_plan_permission,omitempty"`
+}
+
+// Settings - Holds tenant settings object
+type Settings struct {
+	Element SettingsItem `json:"settings"`
+}
+
+// MemberPermissionsItem - Admin portal access of provider member user
+// Nil AllowedServiceIDs means access to every service
+type MemberPermissionsItem struct {
+	UserID            int64    `json:"user_id,omitempty"`
+	Role              string   `json:"role,omitempty"`
+	AllowedSections   []string `json:"allowed_sections"`
+	AllowedServiceIDs []int64  `json:"allowed_service_ids"`
+}
+
+type MemberPermissions struct {
+	Element MemberPermissionsItem `json:"permissions"`
+}
+
+// InvitationItem - Invitation to join the admin portal as a member
+type InvitationItem struct {
+	ID         int64  `json:"id"`
+	AccountID  int64  `json:"account_id"`
+	Email      string `json:"email"`
+	Accepted   bool   `json:"accepted"`
+	AcceptedAt string `json:"accepted_at"`
+	SentAt     string `json:"sent_at"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+type Invitation struct {
+	Element InvitationItem `json:"invitation"`
+}
+
+type InvitationList struct {
+	Invitations []Invitation `json:"invitations"`
+}
+
+// SSOTokenItem - One-time developer portal login
+type SSOTokenItem struct {
+	Token  string `json:"token"`
+	SSOURL string `json:"sso_url"`
+}
+
+type SSOToken struct {
+	Element SSOTokenItem `json:"sso_token"`
+}
+
+// AuthenticationProviderItem - SSO integration. Nil fields are not sent
+type AuthenticationProviderItem struct {
+	ID                             *int64  `json:"id,omitempty"`
+	Kind                           *string `json:"kind,omitempty"`
+	Name                           *string `json:"name,omitempty"`
+	SystemName                     *string `json:"system_name,omitempty"`
+	ClientID                       *string `json:"client_id,omitempty"`
+	ClientSecret                   *string `json:"client_secret,omitempty"`
+	Site                           *string `json:"site,omitempty"`
+	Published                      *bool   `json:"published,omitempty"`
+	SkipSSLCertificateVerification *bool   `json:"skip_ssl_certificate_verification,omitempty"`
+	CallbackURL                    *string `json:"callback_url,omitempty"`
+	AccountType                    *string `json:"account_type,omitempty"`
+	CreatedAt                      *string `json:"created_at,omitempty"`
+	UpdatedAt                      *string `json:"updated_at,omitempty"`
+}
+
+type AuthenticationProvider struct {
+	Element AuthenticationProviderItem `json:"authentication_provider"`
+}
+
+type AuthenticationProviderList struct {
+	Items []AuthenticationProvider `json:"authentication_providers"`
+}
+
+// CMSTemplate - Developer portal page, layout or partial. Nil fields are not sent
+// CMS API objects are not wrapped in a root element
+type CMSTemplate struct {
+	ID            *int64  `json:"id,omitempty"`
+	Type          *string `json:"type,omitempty"`
+	SystemName    *string `json:"system_name,omitempty"`
+	Title         *string `json:"title,omitempty"`
+	Path          *string `json:"path,omitempty"`
+	SectionID     *int64  `json:"section_id,omitempty"`
+	LayoutName    *string `json:"layout_name,omitempty"`
+	LayoutID      *int64  `json:"layout_id,omitempty"`
+	ContentType   *string `json:"content_type,omitempty"`
+	Handler       *string `json:"handler,omitempty"`
+	LiquidEnabled *bool   `json:"liquid_enabled,omitempty"`
+	Hidden        *bool   `json:"hidden,omitempty"`
+	Draft         *string `json:"draft,omitempty"`
+	Published     *string `json:"published,omitempty"`
+	CreatedAt     *string `json:"created_at,omitempty"`
+	UpdatedAt     *string `json:"updated_at,omitempty"`
+}
+
+type CMSTemplateList struct {
+	Templates []CMSTemplate `json:"collection"`
+	Metadata  *PageInfo     `json:"metadata,omitempty"`
+}
+
+// CMSFile - Developer portal file. Nil fields are not sent
+type CMSFile struct {
+	ID           *int64  `json:"id,omitempty"`
+	SectionID    *int64  `json:"section_id,omitempty"`
+	Path         *string `json:"path,omitempty"`
+	Downloadable *bool   `json:"downloadable,omitempty"`
+	URL          *string `json:"url,omitempty"`
+	Title        *string `json:"title,omitempty"`
+	ContentType  *string `json:"content_type,omitempty"`
+	CreatedAt    *string `json:"created_at,omitempty"`
+	UpdatedAt    *string `json:"updated_at,omitempty"`
+}
+
+type CMSFileList struct {
+	Files    []CMSFile `json:"collection"`
+	Metadata *PageInfo `json:"metadata,omitempty"`
+}
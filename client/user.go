@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
 )
 
 const (
@@ -24,7 +23,7 @@ func (c *ThreeScaleClient) ActivateUser(accountID, userID int64) error {
 		return err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return err
 	}
@@ -41,19 +40,8 @@ func (c *ThreeScaleClient) ActivateUser(accountID, userID int64) error {
 // Deprecated: Use DeveloperUser instead
 func (c *ThreeScaleClient) ReadUser(accountID, userID int64) (*User, error) {
 	endpoint := fmt.Sprintf(userRead, accountID, userID)
-	req, err := c.buildGetReq(endpoint)
-	if err != nil {
-		return nil, httpReqError
-	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	userElem := &UserElem{}
-	err = handleJsonResp(resp, http.StatusOK, userElem)
+	userElem, err := doGet[UserElem](c, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -61,8 +49,8 @@ func (c *ThreeScaleClient) ReadUser(accountID, userID int64) (*User, error) {
 }
 
 // ListUser list users of a given account and a given filter params
-// Deprecated: Use ListDeveloperAccounts instead
-func (c *ThreeScaleClient) ListUsers(accountID int64, filterParams Params) (*UserList, error) {
+// Deprecated: Use ListDeveloperUsers instead
+func (c *ThreeScaleClient) ListUsers(accountID int64, filterParams Params, opts ...ListOptions) (*UserList, error) {
 	endpoint := fmt.Sprintf(userList, accountID)
 	req, err := c.buildGetReq(endpoint)
 	if err != nil {
@@ -75,7 +63,9 @@ func (c *ThreeScaleClient) ListUsers(accountID int64, filterParams Params) (*Use
 	}
 	req.URL.RawQuery = values.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -94,24 +84,7 @@ func (c *ThreeScaleClient) ListUsers(accountID int64, filterParams Params) (*Use
 func (c *ThreeScaleClient) UpdateUser(accountID int64, userID int64, userParams Params) (*User, error) {
 	endpoint := fmt.Sprintf(userUpdate, accountID, userID)
 
-	values := url.Values{}
-	for k, v := range userParams {
-		values.Add(k, v)
-	}
-	body := strings.NewReader(values.Encode())
-	req, err := c.buildPutReq(endpoint, body)
-	if err != nil {
-		return nil, httpReqError
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	userElem := &UserElem{}
-	err = handleJsonResp(resp, http.StatusOK, userElem)
+	userElem, err := doPut[UserElem](c, endpoint, userParams)
 	if err != nil {
 		return nil, err
 	}
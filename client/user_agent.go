@@ -0,0 +1,33 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Version of the library, reported in the User-Agent header. Bump it on every release
+const Version = "0.10.0"
+
+// DefaultUserAgent identifies the library in the 3scale system access logs
+const DefaultUserAgent = "3scale-porta-go-client/" + Version
+
+// SetUserAgentSuffix appends a caller identifier, e.g. "3scale-operator/2.13", to the default User-Agent
+// sent on every request. An empty suffix restores the default User-Agent
+func (c *ThreeScaleClient) SetUserAgentSuffix(suffix string) {
+	c.userAgentSuffix = strings.TrimSpace(suffix)
+}
+
+// UserAgent returns the User-Agent header sent on every request
+func (c *ThreeScaleClient) UserAgent() string {
+	if c.userAgentSuffix == "" {
+		return DefaultUserAgent
+	}
+	return DefaultUserAgent + " " + c.userAgentSuffix
+}
+
+// setUserAgent sets the client User-Agent unless the request carries its own
+func (c *ThreeScaleClient) setUserAgent(req *http.Request) {
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent())
+	}
+}
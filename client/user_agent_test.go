@@ -0,0 +1,50 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	tests := []struct {
+		Name     string
+		suffix   string
+		expected string
+	}{
+		{"default", "", "3scale-porta-go-client/" + Version},
+		{"suffix", "3scale-operator/2.13", "3scale-porta-go-client/" + Version + " 3scale-operator/2.13"},
+		{"blank suffix", "  ", "3scale-porta-go-client/" + Version},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subTest *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				equals(subTest, tt.expected, req.Header.Get("User-Agent"))
+				return jsonTestResponse(subTest, http.StatusOK, ProductList{})
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subTest), "someAccessToken", httpClient)
+			c.SetUserAgentSuffix(tt.suffix)
+			if _, err := c.ListProducts(); err != nil {
+				subTest.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestUserAgentNotOverridden(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		equals(t, "custom/1.0", req.Header.Get("User-Agent"))
+		return jsonTestResponse(t, http.StatusOK, ProductList{})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	req, err := c.NewRequest(http.MethodGet, "/admin/api/services.json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("User-Agent", "custom/1.0")
+	if err := c.DoRequest(req, http.StatusOK, &ProductList{}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultWaitInitialInterval is the first interval between polls of the WaitFor helpers
+	DefaultWaitInitialInterval = 500 * time.Millisecond
+	// DefaultWaitMaxInterval is the longest interval between polls of the WaitFor helpers
+	DefaultWaitMaxInterval = 10 * time.Second
+)
+
+// SetWaitBackoff sets the intervals between polls of the WaitFor helpers.
+// The interval starts at initial and doubles after every poll up to max.
+// Values lower than 1 use DefaultWaitInitialInterval and DefaultWaitMaxInterval
+func (c *ThreeScaleClient) SetWaitBackoff(initial, max time.Duration) {
+	c.waitInitialInterval = initial
+	c.waitMaxInterval = max
+}
+
+// WaitFor polls condition with backoff, see SetWaitBackoff, until it returns true or an error,
+// or until ctx is done, in which case the context error is returned
+func (c *ThreeScaleClient) WaitFor(ctx context.Context, condition func() (bool, error)) error {
+	interval := c.waitInitialInterval
+	if interval < 1 {
+		interval = DefaultWaitInitialInterval
+	}
+	maxInterval := c.waitMaxInterval
+	if maxInterval < 1 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+
+	for {
+		done, err := condition()
+		if err != nil || done {
+			return err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// WaitForApplicationState polls the application until it is in the given state,
+// e.g. after ApplicationSuspend or ApplicationResume
+func (c *ThreeScaleClient) WaitForApplicationState(ctx context.Context, accountID, appID int64, state ApplicationState) (*Application, error) {
+	var app *Application
+	err := c.WaitFor(ctx, func() (bool, error) {
+		var err error
+		app, err = c.Application(accountID, appID)
+		if err != nil {
+			return false, err
+		}
+		return app.AppState() == state, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// WaitForProxyConfigVersion polls the latest product proxy config of the environment
+// until it reaches the given version, e.g. after PromoteProxyConfig.
+// Environments without any proxy config yet are polled until one is available
+func (c *ThreeScaleClient) WaitForProxyConfigVersion(ctx context.Context, productID int64, env string, version int) (*ProxyConfigElement, error) {
+	var config ProxyConfigElement
+	err := c.WaitFor(ctx, func() (bool, error) {
+		var err error
+		config, err = c.GetLatestProxyConfig(strconv.FormatInt(productID, 10), env)
+		if IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return config.ProxyConfig.Version >= version, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitForApplicationState(t *testing.T) {
+	polls := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		polls++
+		state := "live"
+		if polls >= 3 {
+			state = "suspended"
+		}
+		return jsonTestResponse(t, http.StatusOK, ApplicationElem{Application: Application{ID: 2, State: state}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetWaitBackoff(time.Millisecond, 2*time.Millisecond)
+
+	app, err := c.WaitForApplicationState(context.Background(), 1, 2, ApplicationStateSuspended)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, ApplicationStateSuspended, app.AppState())
+	equals(t, 3, polls)
+}
+
+func TestWaitForApplicationStateContextDone(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusOK, ApplicationElem{Application: Application{ID: 2, State: "live"}})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetWaitBackoff(time.Millisecond, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := c.WaitForApplicationState(ctx, 1, 2, ApplicationStateSuspended)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded; got %v", err)
+	}
+}
+
+func TestWaitForProxyConfigVersion(t *testing.T) {
+	polls := 0
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		equals(t, fmt.Sprintf(proxyConfigLatestGet, "5", "production"), req.URL.Path)
+		polls++
+		switch polls {
+		case 1:
+			return jsonTestResponse(t, http.StatusNotFound, map[string]string{"status": "Not found"})
+		case 2:
+			return jsonTestResponse(t, http.StatusOK, ProxyConfigElement{ProxyConfig: ProxyConfig{Version: 3}})
+		default:
+			return jsonTestResponse(t, http.StatusOK, ProxyConfigElement{ProxyConfig: ProxyConfig{Version: 4}})
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	c.SetWaitBackoff(time.Millisecond, time.Millisecond)
+
+	config, err := c.WaitForProxyConfigVersion(context.Background(), 5, "production", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 4, config.ProxyConfig.Version)
+	equals(t, 3, polls)
+}
+
+func TestWaitForError(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		return jsonTestResponse(t, http.StatusForbidden, map[string]string{"error": "forbidden"})
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	_, err := c.WaitForApplicationState(context.Background(), 1, 2, ApplicationStateLive)
+	if !IsForbidden(err) {
+		t.Fatalf("expected forbidden error; got %v", err)
+	}
+}
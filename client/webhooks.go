@@ -0,0 +1,54 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Failed deliveries are only exposed in XML format
+const webhookFailuresResourceEndpoint = "/admin/api/webhooks/failures.xml"
+
+// ListFailedWebhooks Lists webhook deliveries that 3scale failed to send
+func (c *ThreeScaleClient) ListFailedWebhooks(opts ...ListOptions) (*WebhookFailureList, error) {
+	req, err := c.buildGetReq(webhookFailuresResourceEndpoint)
+	if err != nil {
+		return nil, httpReqError
+	}
+
+	applyListOptions(req, opts...)
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	list := &WebhookFailureList{}
+	err = handleXMLResp(resp, http.StatusOK, list)
+	return list, err
+}
+
+// DeleteFailedWebhooks Deletes failed webhook deliveries that happened before the given time.
+// The zero time deletes every failed delivery
+func (c *ThreeScaleClient) DeleteFailedWebhooks(before time.Time) error {
+	values := url.Values{}
+	if !before.IsZero() {
+		values.Add("time", before.Format(time.RFC3339))
+	}
+
+	body := strings.NewReader(values.Encode())
+	req, err := c.buildDeleteReq(webhookFailuresResourceEndpoint, body)
+	if err != nil {
+		return httpReqError
+	}
+
+	resp, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return handleXMLResp(resp, http.StatusOK, nil)
+}
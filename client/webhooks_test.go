@@ -0,0 +1,98 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/3scale/3scale-porta-go-client/fake"
+)
+
+const webhookFailuresXML = `<?xml version="1.0" encoding="UTF-8"?>
+<webhooks-failures>
+  <webhooks-failure>
+    <id>1</id>
+    <time>2020-03-31T10:00:00Z</time>
+    <error>Connection refused</error>
+    <url>https://hooks.example.com/3scale</url>
+    <event>&lt;event&gt;&lt;type&gt;application&lt;/type&gt;&lt;/event&gt;</event>
+  </webhooks-failure>
+  <webhooks-failure>
+    <id>2</id>
+    <time>2020-03-31T11:00:00Z</time>
+    <error>Timeout</error>
+    <url>https://hooks.example.com/3scale</url>
+    <event>&lt;event&gt;&lt;type&gt;account&lt;/type&gt;&lt;/event&gt;</event>
+  </webhooks-failure>
+</webhooks-failures>`
+
+func TestListFailedWebhooks(t *testing.T) {
+	httpClient := NewTestClient(func(req *http.Request) *http.Response {
+		fake.RequestAssertion{Method: http.MethodGet, Path: webhookFailuresResourceEndpoint}.Assert(t, req)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(webhookFailuresXML)),
+			Header:     make(http.Header),
+		}
+	})
+
+	c := NewThreeScale(NewTestAdminPortal(t), "someAccessToken", httpClient)
+	list, err := c.ListFailedWebhooks()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, 2, len(list.Failures))
+	equals(t, "1", list.Failures[0].ID)
+	equals(t, "Connection refused", list.Failures[0].Error)
+	equals(t, "<event><type>application</type></event>", list.Failures[0].Event)
+}
+
+func TestDeleteFailedWebhooks(t *testing.T) {
+	tests := []struct {
+		Name   string
+		before time.Time
+		form   map[string]string
+	}{
+		{"all", time.Time{}, nil},
+		{"before time", time.Date(2020, time.March, 31, 10, 30, 0, 0, time.UTC), map[string]string{"time": "2020-03-31T10:30:00Z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(subTest *testing.T) {
+			httpClient := NewTestClient(func(req *http.Request) *http.Response {
+				fake.RequestAssertion{
+					Method: http.MethodDelete,
+					Path:   webhookFailuresResourceEndpoint,
+					Form:   tt.form,
+				}.Assert(subTest, req)
+
+				body, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					subTest.Fatal(err)
+				}
+				form, err := url.ParseQuery(string(body))
+				if err != nil {
+					subTest.Fatal(err)
+				}
+				_, sent := form["time"]
+				equals(subTest, tt.form != nil, sent)
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(`<webhooks-failures></webhooks-failures>`)),
+					Header:     make(http.Header),
+				}
+			})
+
+			c := NewThreeScale(NewTestAdminPortal(subTest), "someAccessToken", httpClient)
+			if err := c.DeleteFailedWebhooks(tt.before); err != nil {
+				subTest.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,3272 @@
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package client
+
+import (
+	"encoding/json"
+)
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *APIcastDeploymentParams) DeepCopyInto(out *APIcastDeploymentParams) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new APIcastDeploymentParams copying the receiver.
+func (in *APIcastDeploymentParams) DeepCopy() *APIcastDeploymentParams {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastDeploymentParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *APIcastPolicy) DeepCopyInto(out *APIcastPolicy) {
+	*out = *in
+	in.Element.DeepCopyInto(&out.Element)
+}
+
+// DeepCopy creates a new APIcastPolicy copying the receiver.
+func (in *APIcastPolicy) DeepCopy() *APIcastPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *APIcastPolicyItem) DeepCopyInto(out *APIcastPolicyItem) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(APIcastPolicySchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a new APIcastPolicyItem copying the receiver.
+func (in *APIcastPolicyItem) DeepCopy() *APIcastPolicyItem {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastPolicyItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *APIcastPolicyRegistry) DeepCopyInto(out *APIcastPolicyRegistry) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIcastPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a new APIcastPolicyRegistry copying the receiver.
+func (in *APIcastPolicyRegistry) DeepCopy() *APIcastPolicyRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastPolicyRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *APIcastPolicySchema) DeepCopyInto(out *APIcastPolicySchema) {
+	*out = *in
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new([]string)
+		**out = **in
+		if **in != nil {
+			in, out := &**in, &**out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(json.RawMessage)
+		**out = **in
+		if **in != nil {
+			in, out := &**in, &**out
+			*out = make(json.RawMessage, len(*in))
+			copy(*out, *in)
+		}
+	}
+}
+
+// DeepCopy creates a new APIcastPolicySchema copying the receiver.
+func (in *APIcastPolicySchema) DeepCopy() *APIcastPolicySchema {
+	if in == nil {
+		return nil
+	}
+	out := new(APIcastPolicySchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AccessToken) DeepCopyInto(out *AccessToken) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new AccessToken copying the receiver.
+func (in *AccessToken) DeepCopy() *AccessToken {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Account) DeepCopyInto(out *Account) {
+	*out = *in
+}
+
+// DeepCopy creates a new Account copying the receiver.
+func (in *Account) DeepCopy() *Account {
+	if in == nil {
+		return nil
+	}
+	out := new(Account)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AccountElem) DeepCopyInto(out *AccountElem) {
+	*out = *in
+}
+
+// DeepCopy creates a new AccountElem copying the receiver.
+func (in *AccountElem) DeepCopy() *AccountElem {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountElem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AccountList) DeepCopyInto(out *AccountList) {
+	*out = *in
+	if in.Accounts != nil {
+		in, out := &in.Accounts, &out.Accounts
+		*out = make([]AccountElem, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new AccountList copying the receiver.
+func (in *AccountList) DeepCopy() *AccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AccountMessage) DeepCopyInto(out *AccountMessage) {
+	*out = *in
+}
+
+// DeepCopy creates a new AccountMessage copying the receiver.
+func (in *AccountMessage) DeepCopy() *AccountMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AccountMessageItem) DeepCopyInto(out *AccountMessageItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new AccountMessageItem copying the receiver.
+func (in *AccountMessageItem) DeepCopy() *AccountMessageItem {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountMessageItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ActiveDoc) DeepCopyInto(out *ActiveDoc) {
+	*out = *in
+	in.Element.DeepCopyInto(&out.Element)
+}
+
+// DeepCopy creates a new ActiveDoc copying the receiver.
+func (in *ActiveDoc) DeepCopy() *ActiveDoc {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDoc)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ActiveDocItem) DeepCopyInto(out *ActiveDocItem) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SystemName != nil {
+		in, out := &in.SystemName, &out.SystemName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipSwaggerValidations != nil {
+		in, out := &in.SkipSwaggerValidations, &out.SkipSwaggerValidations
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceID != nil {
+		in, out := &in.ServiceID, &out.ServiceID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a new ActiveDocItem copying the receiver.
+func (in *ActiveDocItem) DeepCopy() *ActiveDocItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDocItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ActiveDocList) DeepCopyInto(out *ActiveDocList) {
+	*out = *in
+	if in.ActiveDocs != nil {
+		in, out := &in.ActiveDocs, &out.ActiveDocs
+		*out = make([]ActiveDoc, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a new ActiveDocList copying the receiver.
+func (in *ActiveDocList) DeepCopy() *ActiveDocList {
+	if in == nil {
+		return nil
+	}
+	out := new(ActiveDocList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Alert) DeepCopyInto(out *Alert) {
+	*out = *in
+}
+
+// DeepCopy creates a new Alert copying the receiver.
+func (in *Alert) DeepCopy() *Alert {
+	if in == nil {
+		return nil
+	}
+	out := new(Alert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AlertItem) DeepCopyInto(out *AlertItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new AlertItem copying the receiver.
+func (in *AlertItem) DeepCopy() *AlertItem {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AlertList) DeepCopyInto(out *AlertList) {
+	*out = *in
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]Alert, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new AlertList copying the receiver.
+func (in *AlertList) DeepCopy() *AlertList {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Application) DeepCopyInto(out *Application) {
+	*out = *in
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string]interface{}, len(*in))
+		for key, val := range *in {
+			(*out)[key] = deepCopyJSONValue(val)
+		}
+	}
+}
+
+// DeepCopy creates a new Application copying the receiver.
+func (in *Application) DeepCopy() *Application {
+	if in == nil {
+		return nil
+	}
+	out := new(Application)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationElem) DeepCopyInto(out *ApplicationElem) {
+	*out = *in
+	in.Application.DeepCopyInto(&out.Application)
+}
+
+// DeepCopy creates a new ApplicationElem copying the receiver.
+func (in *ApplicationElem) DeepCopy() *ApplicationElem {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationElem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]ApplicationElem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new ApplicationList copying the receiver.
+func (in *ApplicationList) DeepCopy() *ApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlan) DeepCopyInto(out *ApplicationPlan) {
+	*out = *in
+}
+
+// DeepCopy creates a new ApplicationPlan copying the receiver.
+func (in *ApplicationPlan) DeepCopy() *ApplicationPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanItem) DeepCopyInto(out *ApplicationPlanItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ApplicationPlanItem copying the receiver.
+func (in *ApplicationPlanItem) DeepCopy() *ApplicationPlanItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanJSONList) DeepCopyInto(out *ApplicationPlanJSONList) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]ApplicationPlan, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ApplicationPlanJSONList copying the receiver.
+func (in *ApplicationPlanJSONList) DeepCopy() *ApplicationPlanJSONList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanJSONList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanLimit) DeepCopyInto(out *ApplicationPlanLimit) {
+	*out = *in
+}
+
+// DeepCopy creates a new ApplicationPlanLimit copying the receiver.
+func (in *ApplicationPlanLimit) DeepCopy() *ApplicationPlanLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanLimitItem) DeepCopyInto(out *ApplicationPlanLimitItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ApplicationPlanLimitItem copying the receiver.
+func (in *ApplicationPlanLimitItem) DeepCopy() *ApplicationPlanLimitItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanLimitItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanLimitList) DeepCopyInto(out *ApplicationPlanLimitList) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]ApplicationPlanLimit, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ApplicationPlanLimitList copying the receiver.
+func (in *ApplicationPlanLimitList) DeepCopy() *ApplicationPlanLimitList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanLimitList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanPricingRule) DeepCopyInto(out *ApplicationPlanPricingRule) {
+	*out = *in
+}
+
+// DeepCopy creates a new ApplicationPlanPricingRule copying the receiver.
+func (in *ApplicationPlanPricingRule) DeepCopy() *ApplicationPlanPricingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanPricingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanPricingRuleItem) DeepCopyInto(out *ApplicationPlanPricingRuleItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ApplicationPlanPricingRuleItem copying the receiver.
+func (in *ApplicationPlanPricingRuleItem) DeepCopy() *ApplicationPlanPricingRuleItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanPricingRuleItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlanPricingRuleList) DeepCopyInto(out *ApplicationPlanPricingRuleList) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ApplicationPlanPricingRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ApplicationPlanPricingRuleList copying the receiver.
+func (in *ApplicationPlanPricingRuleList) DeepCopy() *ApplicationPlanPricingRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlanPricingRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ApplicationPlansList) DeepCopyInto(out *ApplicationPlansList) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]Plan, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ApplicationPlansList copying the receiver.
+func (in *ApplicationPlansList) DeepCopy() *ApplicationPlansList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationPlansList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AuthenticationProvider) DeepCopyInto(out *AuthenticationProvider) {
+	*out = *in
+	in.Element.DeepCopyInto(&out.Element)
+}
+
+// DeepCopy creates a new AuthenticationProvider copying the receiver.
+func (in *AuthenticationProvider) DeepCopy() *AuthenticationProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AuthenticationProviderItem) DeepCopyInto(out *AuthenticationProviderItem) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SystemName != nil {
+		in, out := &in.SystemName, &out.SystemName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientID != nil {
+		in, out := &in.ClientID, &out.ClientID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Site != nil {
+		in, out := &in.Site, &out.Site
+		*out = new(string)
+		**out = **in
+	}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipSSLCertificateVerification != nil {
+		in, out := &in.SkipSSLCertificateVerification, &out.SkipSSLCertificateVerification
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CallbackURL != nil {
+		in, out := &in.CallbackURL, &out.CallbackURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccountType != nil {
+		in, out := &in.AccountType, &out.AccountType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a new AuthenticationProviderItem copying the receiver.
+func (in *AuthenticationProviderItem) DeepCopy() *AuthenticationProviderItem {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationProviderItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *AuthenticationProviderList) DeepCopyInto(out *AuthenticationProviderList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthenticationProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a new AuthenticationProviderList copying the receiver.
+func (in *AuthenticationProviderList) DeepCopy() *AuthenticationProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Backend) DeepCopyInto(out *Backend) {
+	*out = *in
+}
+
+// DeepCopy creates a new Backend copying the receiver.
+func (in *Backend) DeepCopy() *Backend {
+	if in == nil {
+		return nil
+	}
+	out := new(Backend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *BackendAPIUsage) DeepCopyInto(out *BackendAPIUsage) {
+	*out = *in
+}
+
+// DeepCopy creates a new BackendAPIUsage copying the receiver.
+func (in *BackendAPIUsage) DeepCopy() *BackendAPIUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendAPIUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *BackendAPIUsageItem) DeepCopyInto(out *BackendAPIUsageItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new BackendAPIUsageItem copying the receiver.
+func (in *BackendAPIUsageItem) DeepCopy() *BackendAPIUsageItem {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendAPIUsageItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in BackendAPIUsageList) DeepCopyInto(out *BackendAPIUsageList) {
+	*out = in
+	if in != nil {
+		in, out := &in, &(*out)
+		*out = make([]BackendAPIUsage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new BackendAPIUsageList copying the receiver.
+func (in BackendAPIUsageList) DeepCopy() BackendAPIUsageList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendAPIUsageList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *BackendApi) DeepCopyInto(out *BackendApi) {
+	*out = *in
+}
+
+// DeepCopy creates a new BackendApi copying the receiver.
+func (in *BackendApi) DeepCopy() *BackendApi {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendApi)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *BackendApiItem) DeepCopyInto(out *BackendApiItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new BackendApiItem copying the receiver.
+func (in *BackendApiItem) DeepCopy() *BackendApiItem {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendApiItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *BackendApiList) DeepCopyInto(out *BackendApiList) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]BackendApi, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new BackendApiList copying the receiver.
+func (in *BackendApiList) DeepCopy() *BackendApiList {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendApiList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *BillingAddressSpec) DeepCopyInto(out *BillingAddressSpec) {
+	*out = *in
+	if in.Company != nil {
+		in, out := &in.Company, &out.Company
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address1 != nil {
+		in, out := &in.Address1, &out.Address1
+		*out = new(string)
+		**out = **in
+	}
+	if in.Address2 != nil {
+		in, out := &in.Address2, &out.Address2
+		*out = new(string)
+		**out = **in
+	}
+	if in.PhoneNumber != nil {
+		in, out := &in.PhoneNumber, &out.PhoneNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.City != nil {
+		in, out := &in.City, &out.City
+		*out = new(string)
+		**out = **in
+	}
+	if in.Country != nil {
+		in, out := &in.Country, &out.Country
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zip != nil {
+		in, out := &in.Zip, &out.Zip
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a new BillingAddressSpec copying the receiver.
+func (in *BillingAddressSpec) DeepCopy() *BillingAddressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BillingAddressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *CMSFile) DeepCopyInto(out *CMSFile) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SectionID != nil {
+		in, out := &in.SectionID, &out.SectionID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Downloadable != nil {
+		in, out := &in.Downloadable, &out.Downloadable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a new CMSFile copying the receiver.
+func (in *CMSFile) DeepCopy() *CMSFile {
+	if in == nil {
+		return nil
+	}
+	out := new(CMSFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *CMSFileList) DeepCopyInto(out *CMSFileList) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]CMSFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new CMSFileList copying the receiver.
+func (in *CMSFileList) DeepCopy() *CMSFileList {
+	if in == nil {
+		return nil
+	}
+	out := new(CMSFileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *CMSTemplate) DeepCopyInto(out *CMSTemplate) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.SystemName != nil {
+		in, out := &in.SystemName, &out.SystemName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Title != nil {
+		in, out := &in.Title, &out.Title
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.SectionID != nil {
+		in, out := &in.SectionID, &out.SectionID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LayoutName != nil {
+		in, out := &in.LayoutName, &out.LayoutName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LayoutID != nil {
+		in, out := &in.LayoutID, &out.LayoutID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Handler != nil {
+		in, out := &in.Handler, &out.Handler
+		*out = new(string)
+		**out = **in
+	}
+	if in.LiquidEnabled != nil {
+		in, out := &in.LiquidEnabled, &out.LiquidEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Hidden != nil {
+		in, out := &in.Hidden, &out.Hidden
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Draft != nil {
+		in, out := &in.Draft, &out.Draft
+		*out = new(string)
+		**out = **in
+	}
+	if in.Published != nil {
+		in, out := &in.Published, &out.Published
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a new CMSTemplate copying the receiver.
+func (in *CMSTemplate) DeepCopy() *CMSTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CMSTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *CMSTemplateList) DeepCopyInto(out *CMSTemplateList) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]CMSTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new CMSTemplateList copying the receiver.
+func (in *CMSTemplateList) DeepCopy() *CMSTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(CMSTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Configuration) DeepCopyInto(out *Configuration) {
+	*out = *in
+}
+
+// DeepCopy creates a new Configuration copying the receiver.
+func (in *Configuration) DeepCopy() *Configuration {
+	if in == nil {
+		return nil
+	}
+	out := new(Configuration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Content) DeepCopyInto(out *Content) {
+	*out = *in
+	out.OnelineDescription = deepCopyJSONValue(in.OnelineDescription)
+	out.Description = deepCopyJSONValue(in.Description)
+	out.TxtAPI = deepCopyJSONValue(in.TxtAPI)
+	out.TxtSupport = deepCopyJSONValue(in.TxtSupport)
+	out.TxtFeatures = deepCopyJSONValue(in.TxtFeatures)
+	out.LogoFileName = deepCopyJSONValue(in.LogoFileName)
+	out.LogoContentType = deepCopyJSONValue(in.LogoContentType)
+	out.LogoFileSize = deepCopyJSONValue(in.LogoFileSize)
+	out.Infobar = deepCopyJSONValue(in.Infobar)
+	out.Terms = deepCopyJSONValue(in.Terms)
+	out.TechSupportEmail = deepCopyJSONValue(in.TechSupportEmail)
+	out.AdminSupportEmail = deepCopyJSONValue(in.AdminSupportEmail)
+	out.CreditCardSupportEmail = deepCopyJSONValue(in.CreditCardSupportEmail)
+	out.NotificationSettings = deepCopyJSONValue(in.NotificationSettings)
+	out.DefaultEndUserPlanID = deepCopyJSONValue(in.DefaultEndUserPlanID)
+	in.Proxy.DeepCopyInto(&out.Proxy)
+}
+
+// DeepCopy creates a new Content copying the receiver.
+func (in *Content) DeepCopy() *Content {
+	if in == nil {
+		return nil
+	}
+	out := new(Content)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ContentProxy) DeepCopyInto(out *ContentProxy) {
+	*out = *in
+	out.DeployedAt = deepCopyJSONValue(in.DeployedAt)
+	if in.HostnameRewrite != nil {
+		in, out := &in.HostnameRewrite, &out.HostnameRewrite
+		*out = new(string)
+		**out = **in
+	}
+	out.OauthLoginURL = deepCopyJSONValue(in.OauthLoginURL)
+	if in.APITestSuccess != nil {
+		in, out := &in.APITestSuccess, &out.APITestSuccess
+		*out = new(bool)
+		**out = **in
+	}
+	out.OidcIssuerEndpoint = deepCopyJSONValue(in.OidcIssuerEndpoint)
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PolicyChain != nil {
+		in, out := &in.PolicyChain, &out.PolicyChain
+		*out = make([]PolicyChain, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProxyRules != nil {
+		in, out := &in.ProxyRules, &out.ProxyRules
+		*out = make([]ProxyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a new ContentProxy copying the receiver.
+func (in *ContentProxy) DeepCopy() *ContentProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *DeveloperAccount) DeepCopyInto(out *DeveloperAccount) {
+	*out = *in
+	in.Element.DeepCopyInto(&out.Element)
+}
+
+// DeepCopy creates a new DeveloperAccount copying the receiver.
+func (in *DeveloperAccount) DeepCopy() *DeveloperAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *DeveloperAccountItem) DeepCopyInto(out *DeveloperAccountItem) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreditCardStored != nil {
+		in, out := &in.CreditCardStored, &out.CreditCardStored
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MonthlyBillingEnabled != nil {
+		in, out := &in.MonthlyBillingEnabled, &out.MonthlyBillingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MonthlyChargingEnabled != nil {
+		in, out := &in.MonthlyChargingEnabled, &out.MonthlyChargingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VatRate != nil {
+		in, out := &in.VatRate, &out.VatRate
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgName != nil {
+		in, out := &in.OrgName, &out.OrgName
+		*out = new(string)
+		**out = **in
+	}
+	if in.City != nil {
+		in, out := &in.City, &out.City
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgLegalAddress != nil {
+		in, out := &in.OrgLegalAddress, &out.OrgLegalAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.BillingAddress != nil {
+		in, out := &in.BillingAddress, &out.BillingAddress
+		*out = new(BillingAddressSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BussinessCategory != nil {
+		in, out := &in.BussinessCategory, &out.BussinessCategory
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrgLegaladdressCont != nil {
+		in, out := &in.OrgLegaladdressCont, &out.OrgLegaladdressCont
+		*out = new(string)
+		**out = **in
+	}
+	if in.VatCode != nil {
+		in, out := &in.VatCode, &out.VatCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.TelephoneNumber != nil {
+		in, out := &in.TelephoneNumber, &out.TelephoneNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.FiscalCode != nil {
+		in, out := &in.FiscalCode, &out.FiscalCode
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateRegion != nil {
+		in, out := &in.StateRegion, &out.StateRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Country != nil {
+		in, out := &in.Country, &out.Country
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zip != nil {
+		in, out := &in.Zip, &out.Zip
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryBussiness != nil {
+		in, out := &in.PrimaryBussiness, &out.PrimaryBussiness
+		*out = new(string)
+		**out = **in
+	}
+	if in.PoNumber != nil {
+		in, out := &in.PoNumber, &out.PoNumber
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string]interface{}, len(*in))
+		for key, val := range *in {
+			(*out)[key] = deepCopyJSONValue(val)
+		}
+	}
+}
+
+// DeepCopy creates a new DeveloperAccountItem copying the receiver.
+func (in *DeveloperAccountItem) DeepCopy() *DeveloperAccountItem {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccountItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *DeveloperAccountList) DeepCopyInto(out *DeveloperAccountList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeveloperAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new DeveloperAccountList copying the receiver.
+func (in *DeveloperAccountList) DeepCopy() *DeveloperAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *DeveloperUser) DeepCopyInto(out *DeveloperUser) {
+	*out = *in
+	in.Element.DeepCopyInto(&out.Element)
+}
+
+// DeepCopy creates a new DeveloperUser copying the receiver.
+func (in *DeveloperUser) DeepCopy() *DeveloperUser {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *DeveloperUserItem) DeepCopyInto(out *DeveloperUserItem) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(string)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = new(string)
+		**out = **in
+	}
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string]interface{}, len(*in))
+		for key, val := range *in {
+			(*out)[key] = deepCopyJSONValue(val)
+		}
+	}
+}
+
+// DeepCopy creates a new DeveloperUserItem copying the receiver.
+func (in *DeveloperUserItem) DeepCopy() *DeveloperUserItem {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperUserItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *DeveloperUserList) DeepCopyInto(out *DeveloperUserList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeveloperUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a new DeveloperUserList copying the receiver.
+func (in *DeveloperUserList) DeepCopy() *DeveloperUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeveloperUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
+	*out = *in
+}
+
+// DeepCopy creates a new EndpointStatus copying the receiver.
+func (in *EndpointStatus) DeepCopy() *EndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ErrorResp) DeepCopyInto(out *ErrorResp) {
+	*out = *in
+}
+
+// DeepCopy creates a new ErrorResp copying the receiver.
+func (in *ErrorResp) DeepCopy() *ErrorResp {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorResp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Feature) DeepCopyInto(out *Feature) {
+	*out = *in
+}
+
+// DeepCopy creates a new Feature copying the receiver.
+func (in *Feature) DeepCopy() *Feature {
+	if in == nil {
+		return nil
+	}
+	out := new(Feature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *FeatureItem) DeepCopyInto(out *FeatureItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new FeatureItem copying the receiver.
+func (in *FeatureItem) DeepCopy() *FeatureItem {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *FeatureList) DeepCopyInto(out *FeatureList) {
+	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]Feature, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new FeatureList copying the receiver.
+func (in *FeatureList) DeepCopy() *FeatureList {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Invitation) DeepCopyInto(out *Invitation) {
+	*out = *in
+}
+
+// DeepCopy creates a new Invitation copying the receiver.
+func (in *Invitation) DeepCopy() *Invitation {
+	if in == nil {
+		return nil
+	}
+	out := new(Invitation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvitationItem) DeepCopyInto(out *InvitationItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new InvitationItem copying the receiver.
+func (in *InvitationItem) DeepCopy() *InvitationItem {
+	if in == nil {
+		return nil
+	}
+	out := new(InvitationItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvitationList) DeepCopyInto(out *InvitationList) {
+	*out = *in
+	if in.Invitations != nil {
+		in, out := &in.Invitations, &out.Invitations
+		*out = make([]Invitation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new InvitationList copying the receiver.
+func (in *InvitationList) DeepCopy() *InvitationList {
+	if in == nil {
+		return nil
+	}
+	out := new(InvitationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Invoice) DeepCopyInto(out *Invoice) {
+	*out = *in
+}
+
+// DeepCopy creates a new Invoice copying the receiver.
+func (in *Invoice) DeepCopy() *Invoice {
+	if in == nil {
+		return nil
+	}
+	out := new(Invoice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvoiceItem) DeepCopyInto(out *InvoiceItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new InvoiceItem copying the receiver.
+func (in *InvoiceItem) DeepCopy() *InvoiceItem {
+	if in == nil {
+		return nil
+	}
+	out := new(InvoiceItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvoiceLineItem) DeepCopyInto(out *InvoiceLineItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new InvoiceLineItem copying the receiver.
+func (in *InvoiceLineItem) DeepCopy() *InvoiceLineItem {
+	if in == nil {
+		return nil
+	}
+	out := new(InvoiceLineItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvoiceLineItemItem) DeepCopyInto(out *InvoiceLineItemItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new InvoiceLineItemItem copying the receiver.
+func (in *InvoiceLineItemItem) DeepCopy() *InvoiceLineItemItem {
+	if in == nil {
+		return nil
+	}
+	out := new(InvoiceLineItemItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvoiceLineItemList) DeepCopyInto(out *InvoiceLineItemList) {
+	*out = *in
+	if in.LineItems != nil {
+		in, out := &in.LineItems, &out.LineItems
+		*out = make([]InvoiceLineItem, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new InvoiceLineItemList copying the receiver.
+func (in *InvoiceLineItemList) DeepCopy() *InvoiceLineItemList {
+	if in == nil {
+		return nil
+	}
+	out := new(InvoiceLineItemList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvoiceList) DeepCopyInto(out *InvoiceList) {
+	*out = *in
+	if in.Invoices != nil {
+		in, out := &in.Invoices, &out.Invoices
+		*out = make([]Invoice, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new InvoiceList copying the receiver.
+func (in *InvoiceList) DeepCopy() *InvoiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(InvoiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *InvoicePeriod) DeepCopyInto(out *InvoicePeriod) {
+	*out = *in
+}
+
+// DeepCopy creates a new InvoicePeriod copying the receiver.
+func (in *InvoicePeriod) DeepCopy() *InvoicePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(InvoicePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Limit) DeepCopyInto(out *Limit) {
+	*out = *in
+}
+
+// DeepCopy creates a new Limit copying the receiver.
+func (in *Limit) DeepCopy() *Limit {
+	if in == nil {
+		return nil
+	}
+	out := new(Limit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *LimitList) DeepCopyInto(out *LimitList) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]Limit, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new LimitList copying the receiver.
+func (in *LimitList) DeepCopy() *LimitList {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MappingRule) DeepCopyInto(out *MappingRule) {
+	*out = *in
+}
+
+// DeepCopy creates a new MappingRule copying the receiver.
+func (in *MappingRule) DeepCopy() *MappingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MappingRuleItem) DeepCopyInto(out *MappingRuleItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new MappingRuleItem copying the receiver.
+func (in *MappingRuleItem) DeepCopy() *MappingRuleItem {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingRuleItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MappingRuleJSON) DeepCopyInto(out *MappingRuleJSON) {
+	*out = *in
+}
+
+// DeepCopy creates a new MappingRuleJSON copying the receiver.
+func (in *MappingRuleJSON) DeepCopy() *MappingRuleJSON {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingRuleJSON)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MappingRuleJSONList) DeepCopyInto(out *MappingRuleJSONList) {
+	*out = *in
+	if in.MappingRules != nil {
+		in, out := &in.MappingRules, &out.MappingRules
+		*out = make([]MappingRuleJSON, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new MappingRuleJSONList copying the receiver.
+func (in *MappingRuleJSONList) DeepCopy() *MappingRuleJSONList {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingRuleJSONList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MappingRuleList) DeepCopyInto(out *MappingRuleList) {
+	*out = *in
+	if in.MappingRules != nil {
+		in, out := &in.MappingRules, &out.MappingRules
+		*out = make([]MappingRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new MappingRuleList copying the receiver.
+func (in *MappingRuleList) DeepCopy() *MappingRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MappingRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MemberPermissions) DeepCopyInto(out *MemberPermissions) {
+	*out = *in
+	in.Element.DeepCopyInto(&out.Element)
+}
+
+// DeepCopy creates a new MemberPermissions copying the receiver.
+func (in *MemberPermissions) DeepCopy() *MemberPermissions {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberPermissions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MemberPermissionsItem) DeepCopyInto(out *MemberPermissionsItem) {
+	*out = *in
+	if in.AllowedSections != nil {
+		in, out := &in.AllowedSections, &out.AllowedSections
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedServiceIDs != nil {
+		in, out := &in.AllowedServiceIDs, &out.AllowedServiceIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new MemberPermissionsItem copying the receiver.
+func (in *MemberPermissionsItem) DeepCopy() *MemberPermissionsItem {
+	if in == nil {
+		return nil
+	}
+	out := new(MemberPermissionsItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Method) DeepCopyInto(out *Method) {
+	*out = *in
+}
+
+// DeepCopy creates a new Method copying the receiver.
+func (in *Method) DeepCopy() *Method {
+	if in == nil {
+		return nil
+	}
+	out := new(Method)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MethodItem) DeepCopyInto(out *MethodItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new MethodItem copying the receiver.
+func (in *MethodItem) DeepCopy() *MethodItem {
+	if in == nil {
+		return nil
+	}
+	out := new(MethodItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MethodList) DeepCopyInto(out *MethodList) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]Method, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new MethodList copying the receiver.
+func (in *MethodList) DeepCopy() *MethodList {
+	if in == nil {
+		return nil
+	}
+	out := new(MethodList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Metric) DeepCopyInto(out *Metric) {
+	*out = *in
+}
+
+// DeepCopy creates a new Metric copying the receiver.
+func (in *Metric) DeepCopy() *Metric {
+	if in == nil {
+		return nil
+	}
+	out := new(Metric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MetricItem) DeepCopyInto(out *MetricItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new MetricItem copying the receiver.
+func (in *MetricItem) DeepCopy() *MetricItem {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MetricJSON) DeepCopyInto(out *MetricJSON) {
+	*out = *in
+}
+
+// DeepCopy creates a new MetricJSON copying the receiver.
+func (in *MetricJSON) DeepCopy() *MetricJSON {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricJSON)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MetricJSONList) DeepCopyInto(out *MetricJSONList) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]MetricJSON, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new MetricJSONList copying the receiver.
+func (in *MetricJSONList) DeepCopy() *MetricJSONList {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricJSONList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *MetricList) DeepCopyInto(out *MetricList) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]Metric, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new MetricList copying the receiver.
+func (in *MetricList) DeepCopy() *MetricList {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *OIDCConfiguration) DeepCopyInto(out *OIDCConfiguration) {
+	*out = *in
+}
+
+// DeepCopy creates a new OIDCConfiguration copying the receiver.
+func (in *OIDCConfiguration) DeepCopy() *OIDCConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *OIDCConfigurationItem) DeepCopyInto(out *OIDCConfigurationItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new OIDCConfigurationItem copying the receiver.
+func (in *OIDCConfigurationItem) DeepCopy() *OIDCConfigurationItem {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigurationItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PageInfo) DeepCopyInto(out *PageInfo) {
+	*out = *in
+}
+
+// DeepCopy creates a new PageInfo copying the receiver.
+func (in *PageInfo) DeepCopy() *PageInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PageInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in Params) DeepCopyInto(out *Params) {
+	*out = in
+	if in != nil {
+		in, out := &in, &(*out)
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy creates a new Params copying the receiver.
+func (in Params) DeepCopy() Params {
+	if in == nil {
+		return nil
+	}
+	out := new(Params)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Plan) DeepCopyInto(out *Plan) {
+	*out = *in
+}
+
+// DeepCopy creates a new Plan copying the receiver.
+func (in *Plan) DeepCopy() *Plan {
+	if in == nil {
+		return nil
+	}
+	out := new(Plan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PlanComparison) DeepCopyInto(out *PlanComparison) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]PlanLimitDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PricingRules != nil {
+		in, out := &in.PricingRules, &out.PricingRules
+		*out = make([]PlanPricingRuleDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]PlanFeatureDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a new PlanComparison copying the receiver.
+func (in *PlanComparison) DeepCopy() *PlanComparison {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanComparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PlanFeatureDiff) DeepCopyInto(out *PlanFeatureDiff) {
+	*out = *in
+	if in.PlanA != nil {
+		in, out := &in.PlanA, &out.PlanA
+		*out = new(FeatureItem)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlanB != nil {
+		in, out := &in.PlanB, &out.PlanB
+		*out = new(FeatureItem)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new PlanFeatureDiff copying the receiver.
+func (in *PlanFeatureDiff) DeepCopy() *PlanFeatureDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanFeatureDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PlanLimitDiff) DeepCopyInto(out *PlanLimitDiff) {
+	*out = *in
+	if in.PlanA != nil {
+		in, out := &in.PlanA, &out.PlanA
+		*out = new(ApplicationPlanLimitItem)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlanB != nil {
+		in, out := &in.PlanB, &out.PlanB
+		*out = new(ApplicationPlanLimitItem)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new PlanLimitDiff copying the receiver.
+func (in *PlanLimitDiff) DeepCopy() *PlanLimitDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanLimitDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PlanPricingRuleDiff) DeepCopyInto(out *PlanPricingRuleDiff) {
+	*out = *in
+	if in.PlanA != nil {
+		in, out := &in.PlanA, &out.PlanA
+		*out = new(ApplicationPlanPricingRuleItem)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlanB != nil {
+		in, out := &in.PlanB, &out.PlanB
+		*out = new(ApplicationPlanPricingRuleItem)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new PlanPricingRuleDiff copying the receiver.
+func (in *PlanPricingRuleDiff) DeepCopy() *PlanPricingRuleDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanPricingRuleDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PoliciesConfigList) DeepCopyInto(out *PoliciesConfigList) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicyConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy creates a new PoliciesConfigList copying the receiver.
+func (in *PoliciesConfigList) DeepCopy() *PoliciesConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(PoliciesConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PolicyChain) DeepCopyInto(out *PolicyChain) {
+	*out = *in
+}
+
+// DeepCopy creates a new PolicyChain copying the receiver.
+func (in *PolicyChain) DeepCopy() *PolicyChain {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyChain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *PolicyConfig) DeepCopyInto(out *PolicyConfig) {
+	*out = *in
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = make(map[string]interface{}, len(*in))
+		for key, val := range *in {
+			(*out)[key] = deepCopyJSONValue(val)
+		}
+	}
+}
+
+// DeepCopy creates a new PolicyConfig copying the receiver.
+func (in *PolicyConfig) DeepCopy() *PolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Product) DeepCopyInto(out *Product) {
+	*out = *in
+}
+
+// DeepCopy creates a new Product copying the receiver.
+func (in *Product) DeepCopy() *Product {
+	if in == nil {
+		return nil
+	}
+	out := new(Product)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProductItem) DeepCopyInto(out *ProductItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ProductItem copying the receiver.
+func (in *ProductItem) DeepCopy() *ProductItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProductList) DeepCopyInto(out *ProductList) {
+	*out = *in
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]Product, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new ProductList copying the receiver.
+func (in *ProductList) DeepCopy() *ProductList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProviderPlan) DeepCopyInto(out *ProviderPlan) {
+	*out = *in
+}
+
+// DeepCopy creates a new ProviderPlan copying the receiver.
+func (in *ProviderPlan) DeepCopy() *ProviderPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProviderPlanItem) DeepCopyInto(out *ProviderPlanItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ProviderPlanItem copying the receiver.
+func (in *ProviderPlanItem) DeepCopy() *ProviderPlanItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderPlanItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProviderPlanList) DeepCopyInto(out *ProviderPlanList) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]ProviderPlan, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ProviderPlanList copying the receiver.
+func (in *ProviderPlanList) DeepCopy() *ProviderPlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderPlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Proxy) DeepCopyInto(out *Proxy) {
+	*out = *in
+}
+
+// DeepCopy creates a new Proxy copying the receiver.
+func (in *Proxy) DeepCopy() *Proxy {
+	if in == nil {
+		return nil
+	}
+	out := new(Proxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
+	*out = *in
+	in.Content.DeepCopyInto(&out.Content)
+}
+
+// DeepCopy creates a new ProxyConfig copying the receiver.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProxyConfigElement) DeepCopyInto(out *ProxyConfigElement) {
+	*out = *in
+	in.ProxyConfig.DeepCopyInto(&out.ProxyConfig)
+}
+
+// DeepCopy creates a new ProxyConfigElement copying the receiver.
+func (in *ProxyConfigElement) DeepCopy() *ProxyConfigElement {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfigElement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProxyConfigList) DeepCopyInto(out *ProxyConfigList) {
+	*out = *in
+	if in.ProxyConfigs != nil {
+		in, out := &in.ProxyConfigs, &out.ProxyConfigs
+		*out = make([]ProxyConfigElement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new ProxyConfigList copying the receiver.
+func (in *ProxyConfigList) DeepCopy() *ProxyConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProxyItem) DeepCopyInto(out *ProxyItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ProxyItem copying the receiver.
+func (in *ProxyItem) DeepCopy() *ProxyItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProxyJSON) DeepCopyInto(out *ProxyJSON) {
+	*out = *in
+}
+
+// DeepCopy creates a new ProxyJSON copying the receiver.
+func (in *ProxyJSON) DeepCopy() *ProxyJSON {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyJSON)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ProxyRule) DeepCopyInto(out *ProxyRule) {
+	*out = *in
+	out.RedirectURL = deepCopyJSONValue(in.RedirectURL)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ProxyRule copying the receiver.
+func (in *ProxyRule) DeepCopy() *ProxyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *RateLimitPlanResult) DeepCopyInto(out *RateLimitPlanResult) {
+	*out = *in
+}
+
+// DeepCopy creates a new RateLimitPlanResult copying the receiver.
+func (in *RateLimitPlanResult) DeepCopy() *RateLimitPlanResult {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitPlanResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *RateLimitResult) DeepCopyInto(out *RateLimitResult) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]RateLimitPlanResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new RateLimitResult copying the receiver.
+func (in *RateLimitResult) DeepCopy() *RateLimitResult {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new RateLimitSpec copying the receiver.
+func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *RequestSummary) DeepCopyInto(out *RequestSummary) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy creates a new RequestSummary copying the receiver.
+func (in *RequestSummary) DeepCopy() *RequestSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *SSOToken) DeepCopyInto(out *SSOToken) {
+	*out = *in
+}
+
+// DeepCopy creates a new SSOToken copying the receiver.
+func (in *SSOToken) DeepCopy() *SSOToken {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *SSOTokenItem) DeepCopyInto(out *SSOTokenItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new SSOTokenItem copying the receiver.
+func (in *SSOTokenItem) DeepCopy() *SSOTokenItem {
+	if in == nil {
+		return nil
+	}
+	out := new(SSOTokenItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Service) DeepCopyInto(out *Service) {
+	*out = *in
+	in.Metrics.DeepCopyInto(&out.Metrics)
+}
+
+// DeepCopy creates a new Service copying the receiver.
+func (in *Service) DeepCopy() *Service {
+	if in == nil {
+		return nil
+	}
+	out := new(Service)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ServiceList) DeepCopyInto(out *ServiceList) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]Service, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(PageInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy creates a new ServiceList copying the receiver.
+func (in *ServiceList) DeepCopy() *ServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ServicePlan) DeepCopyInto(out *ServicePlan) {
+	*out = *in
+}
+
+// DeepCopy creates a new ServicePlan copying the receiver.
+func (in *ServicePlan) DeepCopy() *ServicePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ServicePlanItem) DeepCopyInto(out *ServicePlanItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ServicePlanItem copying the receiver.
+func (in *ServicePlanItem) DeepCopy() *ServicePlanItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ServicePlanList) DeepCopyInto(out *ServicePlanList) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]ServicePlan, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ServicePlanList copying the receiver.
+func (in *ServicePlanList) DeepCopy() *ServicePlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ServiceSubscription) DeepCopyInto(out *ServiceSubscription) {
+	*out = *in
+}
+
+// DeepCopy creates a new ServiceSubscription copying the receiver.
+func (in *ServiceSubscription) DeepCopy() *ServiceSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ServiceSubscriptionItem) DeepCopyInto(out *ServiceSubscriptionItem) {
+	*out = *in
+}
+
+// DeepCopy creates a new ServiceSubscriptionItem copying the receiver.
+func (in *ServiceSubscriptionItem) DeepCopy() *ServiceSubscriptionItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSubscriptionItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *ServiceSubscriptionList) DeepCopyInto(out *ServiceSubscriptionList) {
+	*out = *in
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]ServiceSubscription, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new ServiceSubscriptionList copying the receiver.
+func (in *ServiceSubscriptionList) DeepCopy() *ServiceSubscriptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSubscriptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Settings) DeepCopyInto(out *Settings) {
+	*out = *in
+	in.Element.DeepCopyInto(&out.Element)
+}
+
+// DeepCopy creates a new Settings copying the receiver.
+func (in *Settings) DeepCopy() *Settings {
+	if in == nil {
+		return nil
+	}
+	out := new(Settings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *SettingsItem) DeepCopyInto(out *SettingsItem) {
+	*out = *in
+	if in.UserAccountAreaEnabled != nil {
+		in, out := &in.UserAccountAreaEnabled, &out.UserAccountAreaEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HideService != nil {
+		in, out := &in.HideService, &out.HideService
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SignupsEnabled != nil {
+		in, out := &in.SignupsEnabled, &out.SignupsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccountApprovalRequired != nil {
+		in, out := &in.AccountApprovalRequired, &out.AccountApprovalRequired
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StrongPasswordsEnabled != nil {
+		in, out := &in.StrongPasswordsEnabled, &out.StrongPasswordsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicSearch != nil {
+		in, out := &in.PublicSearch, &out.PublicSearch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccountPlansUIVisible != nil {
+		in, out := &in.AccountPlansUIVisible, &out.AccountPlansUIVisible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ChangeAccountPlanPermission != nil {
+		in, out := &in.ChangeAccountPlanPermission, &out.ChangeAccountPlanPermission
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServicePlansUIVisible != nil {
+		in, out := &in.ServicePlansUIVisible, &out.ServicePlansUIVisible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ChangeServicePlanPermission != nil {
+		in, out := &in.ChangeServicePlanPermission, &out.ChangeServicePlanPermission
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy creates a new SettingsItem copying the receiver.
+func (in *SettingsItem) DeepCopy() *SettingsItem {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Signup) DeepCopyInto(out *Signup) {
+	*out = *in
+	in.AccessToken.DeepCopyInto(&out.AccessToken)
+}
+
+// DeepCopy creates a new Signup copying the receiver.
+func (in *Signup) DeepCopy() *Signup {
+	if in == nil {
+		return nil
+	}
+	out := new(Signup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *Tenant) DeepCopyInto(out *Tenant) {
+	*out = *in
+	in.Signup.DeepCopyInto(&out.Signup)
+}
+
+// DeepCopy creates a new Tenant copying the receiver.
+func (in *Tenant) DeepCopy() *Tenant {
+	if in == nil {
+		return nil
+	}
+	out := new(Tenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *TenantConfig) DeepCopyInto(out *TenantConfig) {
+	*out = *in
+	if in.Products != nil {
+		in, out := &in.Products, &out.Products
+		*out = make([]ProductItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendApis != nil {
+		in, out := &in.BackendApis, &out.BackendApis
+		*out = make([]BackendApiItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationPlans != nil {
+		in, out := &in.ApplicationPlans, &out.ApplicationPlans
+		*out = make([]TenantConfigPlan, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]TenantConfigMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]ApplicationPlanLimitItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendUsages != nil {
+		in, out := &in.BackendUsages, &out.BackendUsages
+		*out = make([]BackendAPIUsageItem, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new TenantConfig copying the receiver.
+func (in *TenantConfig) DeepCopy() *TenantConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *TenantConfigMetric) DeepCopyInto(out *TenantConfigMetric) {
+	*out = *in
+}
+
+// DeepCopy creates a new TenantConfigMetric copying the receiver.
+func (in *TenantConfigMetric) DeepCopy() *TenantConfigMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfigMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *TenantConfigPlan) DeepCopyInto(out *TenantConfigPlan) {
+	*out = *in
+}
+
+// DeepCopy creates a new TenantConfigPlan copying the receiver.
+func (in *TenantConfigPlan) DeepCopy() *TenantConfigPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantConfigPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *TopApplication) DeepCopyInto(out *TopApplication) {
+	*out = *in
+}
+
+// DeepCopy creates a new TopApplication copying the receiver.
+func (in *TopApplication) DeepCopy() *TopApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(TopApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *TopApplicationRef) DeepCopyInto(out *TopApplicationRef) {
+	*out = *in
+}
+
+// DeepCopy creates a new TopApplicationRef copying the receiver.
+func (in *TopApplicationRef) DeepCopy() *TopApplicationRef {
+	if in == nil {
+		return nil
+	}
+	out := new(TopApplicationRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *TopApplications) DeepCopyInto(out *TopApplications) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]TopApplication, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new TopApplications copying the receiver.
+func (in *TopApplications) DeepCopy() *TopApplications {
+	if in == nil {
+		return nil
+	}
+	out := new(TopApplications)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *TopApplicationsParams) DeepCopyInto(out *TopApplicationsParams) {
+	*out = *in
+}
+
+// DeepCopy creates a new TopApplicationsParams copying the receiver.
+func (in *TopApplicationsParams) DeepCopy() *TopApplicationsParams {
+	if in == nil {
+		return nil
+	}
+	out := new(TopApplicationsParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *UsagePoint) DeepCopyInto(out *UsagePoint) {
+	*out = *in
+}
+
+// DeepCopy creates a new UsagePoint copying the receiver.
+func (in *UsagePoint) DeepCopy() *UsagePoint {
+	if in == nil {
+		return nil
+	}
+	out := new(UsagePoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *UsageStats) DeepCopyInto(out *UsageStats) {
+	*out = *in
+	if in.PreviousTotal != nil {
+		in, out := &in.PreviousTotal, &out.PreviousTotal
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Change != nil {
+		in, out := &in.Change, &out.Change
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new UsageStats copying the receiver.
+func (in *UsageStats) DeepCopy() *UsageStats {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *UsageStatsMetric) DeepCopyInto(out *UsageStatsMetric) {
+	*out = *in
+}
+
+// DeepCopy creates a new UsageStatsMetric copying the receiver.
+func (in *UsageStatsMetric) DeepCopy() *UsageStatsMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageStatsMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *UsageStatsParams) DeepCopyInto(out *UsageStatsParams) {
+	*out = *in
+}
+
+// DeepCopy creates a new UsageStatsParams copying the receiver.
+func (in *UsageStatsParams) DeepCopy() *UsageStatsParams {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageStatsParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *UsageStatsPeriod) DeepCopyInto(out *UsageStatsPeriod) {
+	*out = *in
+}
+
+// DeepCopy creates a new UsageStatsPeriod copying the receiver.
+func (in *UsageStatsPeriod) DeepCopy() *UsageStatsPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageStatsPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *User) DeepCopyInto(out *User) {
+	*out = *in
+}
+
+// DeepCopy creates a new User copying the receiver.
+func (in *User) DeepCopy() *User {
+	if in == nil {
+		return nil
+	}
+	out := new(User)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *UserElem) DeepCopyInto(out *UserElem) {
+	*out = *in
+}
+
+// DeepCopy creates a new UserElem copying the receiver.
+func (in *UserElem) DeepCopy() *UserElem {
+	if in == nil {
+		return nil
+	}
+	out := new(UserElem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *UserList) DeepCopyInto(out *UserList) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]UserElem, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new UserList copying the receiver.
+func (in *UserList) DeepCopy() *UserList {
+	if in == nil {
+		return nil
+	}
+	out := new(UserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *WebhookFailure) DeepCopyInto(out *WebhookFailure) {
+	*out = *in
+}
+
+// DeepCopy creates a new WebhookFailure copying the receiver.
+func (in *WebhookFailure) DeepCopy() *WebhookFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto copies all properties of this object into another object of the same type.
+func (in *WebhookFailureList) DeepCopyInto(out *WebhookFailureList) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]WebhookFailure, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy creates a new WebhookFailureList copying the receiver.
+func (in *WebhookFailureList) DeepCopy() *WebhookFailureList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookFailureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// deepCopyJSONValue copies values decoded from JSON into interface{} fields
+func deepCopyJSONValue(in interface{}) interface{} {
+	switch t := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for key, val := range t {
+			out[key] = deepCopyJSONValue(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = deepCopyJSONValue(val)
+		}
+		return out
+	default:
+		return in
+	}
+}
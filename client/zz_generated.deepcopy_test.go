@@ -0,0 +1,39 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestDeepCopy(t *testing.T) {
+	orgName := "org"
+	account := &DeveloperAccount{Element: DeveloperAccountItem{OrgName: &orgName}}
+
+	accountCopy := account.DeepCopy()
+	*accountCopy.Element.OrgName = "changed"
+	equals(t, "org", *account.Element.OrgName)
+
+	policies := &PoliciesConfigList{
+		Policies: []PolicyConfig{
+			{
+				Name:          "headers",
+				Configuration: map[string]interface{}{"request": []interface{}{map[string]interface{}{"op": "set"}}},
+			},
+		},
+	}
+
+	policiesCopy := policies.DeepCopy()
+	policiesCopy.Policies[0].Name = "cors"
+	policiesCopy.Policies[0].Configuration["request"].([]interface{})[0].(map[string]interface{})["op"] = "push"
+	equals(t, "headers", policies.Policies[0].Name)
+	equals(t, "set", policies.Policies[0].Configuration["request"].([]interface{})[0].(map[string]interface{})["op"])
+
+	params := Params{"name": "value"}
+	paramsCopy := params.DeepCopy()
+	paramsCopy["name"] = "changed"
+	equals(t, "value", params["name"])
+
+	var nilAccount *DeveloperAccount
+	if nilAccount.DeepCopy() != nil {
+		t.Fatal("DeepCopy of nil expected to be nil")
+	}
+}
@@ -0,0 +1,436 @@
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+)
+
+// fixtureTimestamp is the created_at and updated_at of every fixture, keeping responses deterministic
+const fixtureTimestamp = "2019-02-11T15:19:48Z"
+
+// Fixture is a single Account Management API object rendered as a JSON or XML response body.
+// Build them with the NewX constructors, e.g. NewApplication(WithAttr("name", "my app"))
+type Fixture struct {
+	kind  *fixtureKind
+	attrs map[string]interface{}
+}
+
+// FixtureList is a list of API objects of the same type, e.g. NewAccountList(3)
+type FixtureList struct {
+	kind  *fixtureKind
+	Items []*Fixture
+}
+
+// Option customizes the attributes of a fixture
+type Option func(*Fixture)
+
+type fixtureKind struct {
+	// element wraps every object, list wraps object lists. Empty list renders a bare JSON array
+	element string
+	list    string
+	// xmlElement and xmlList override element and list on XML bodies
+	xmlElement string
+	xmlList    string
+	// defaults returns valid attributes of the object with the given id
+	defaults func(id int64) map[string]interface{}
+}
+
+var (
+	accountKind = &fixtureKind{element: "account", list: "accounts", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"state": "approved", "org_name": fmt.Sprintf("Organization %d", id),
+			"credit_card_stored": false, "monthly_billing_enabled": true, "monthly_charging_enabled": true,
+		}
+	}}
+	applicationKind = &fixtureKind{element: "application", list: "applications", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"state": "live", "enabled": true, "end_user_required": false,
+			"service_id": 1, "plan_id": 1, "account_id": 1,
+			"user_key":                  fmt.Sprintf("%032x", id),
+			"provider_verification_key": fmt.Sprintf("%032x", id+1000),
+			"name":                      fmt.Sprintf("Application %d", id),
+			"description":               fmt.Sprintf("Application %d description", id),
+		}
+	}}
+	applicationPlanKind = &fixtureKind{element: "application_plan", list: "plans", xmlElement: "plan", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": fmt.Sprintf("Plan %d", id), "system_name": fmt.Sprintf("plan_%d", id), "state": "published",
+			"setup_fee": 0.0, "cost_per_month": 0.0, "trial_period_days": 0, "cancellation_period": 0,
+			"approval_required": false, "default": false, "custom": false,
+		}
+	}}
+	productKind = &fixtureKind{element: "service", list: "services", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": fmt.Sprintf("Product %d", id), "system_name": fmt.Sprintf("product_%d", id),
+			"description": "", "state": "incomplete", "deployment_option": "hosted", "backend_version": "1",
+			"buyers_manage_apps": true, "buyers_manage_keys": true, "custom_keys_enabled": true,
+			"buyer_plan_change_permission": "request",
+		}
+	}}
+	backendAPIKind = &fixtureKind{element: "backend_api", list: "backend_apis", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": fmt.Sprintf("Backend %d", id), "system_name": fmt.Sprintf("backend_%d", id),
+			"description": "", "private_endpoint": "https://echo-api.3scale.net:443", "account_id": 1,
+		}
+	}}
+	backendUsageKind = &fixtureKind{element: "backend_usage", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{"path": "/", "service_id": 1, "backend_id": 1}
+	}}
+	metricKind = &fixtureKind{element: "metric", list: "metrics", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": fmt.Sprintf("metric_%d", id), "friendly_name": fmt.Sprintf("Metric %d", id),
+			"system_name": fmt.Sprintf("metric_%d", id), "unit": "hit", "description": "",
+		}
+	}}
+	methodKind = &fixtureKind{element: "method", list: "methods", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": fmt.Sprintf("method_%d", id), "friendly_name": fmt.Sprintf("Method %d", id),
+			"system_name": fmt.Sprintf("method_%d", id), "parent_id": 1, "description": "",
+		}
+	}}
+	mappingRuleKind = &fixtureKind{element: "mapping_rule", list: "mapping_rules", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"metric_id": 1, "pattern": fmt.Sprintf("/path%d", id), "http_method": "GET",
+			"delta": 1, "position": id, "last": false,
+		}
+	}}
+	proxyKind = &fixtureKind{element: "proxy", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"service_id": id, "endpoint": "https://api.production.gw.apicast.io:443",
+			"sandbox_endpoint": "https://api.staging.gw.apicast.io:443", "api_backend": "https://echo-api.3scale.net:443",
+			"credentials_location": "query", "auth_app_key": "app_key", "auth_app_id": "app_id", "auth_user_key": "user_key",
+			"error_auth_failed": "Authentication failed", "error_auth_missing": "Authentication parameters missing",
+			"error_status_auth_failed": 403, "error_headers_auth_failed": "text/plain; charset=us-ascii",
+			"error_status_auth_missing": 403, "error_headers_auth_missing": "text/plain; charset=us-ascii",
+			"error_no_match": "No Mapping Rule matched", "error_status_no_match": 404,
+			"error_headers_no_match": "text/plain; charset=us-ascii", "lock_version": 1,
+		}
+	}}
+	limitKind = &fixtureKind{element: "limit", list: "limits", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{"period": "minute", "value": 10, "metric_id": 1, "plan_id": 1}
+	}}
+	pricingRuleKind = &fixtureKind{element: "pricing_rule", list: "pricing_rules", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{"metric_id": 1, "cost_per_unit": "0.1", "min": 1, "max": 100}
+	}}
+	developerUserKind = &fixtureKind{element: "user", list: "users", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"state": "active", "role": "member",
+			"username": fmt.Sprintf("user%d", id), "email": fmt.Sprintf("user%d@example.com", id),
+		}
+	}}
+	activeDocKind = &fixtureKind{element: "api_doc", list: "api_docs", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": fmt.Sprintf("ActiveDoc %d", id), "system_name": fmt.Sprintf("activedoc_%d", id),
+			"description": "", "published": false, "skip_swagger_validations": false,
+			"body": `{"swagger":"2.0","info":{"title":"API","version":"1.0"},"paths":{}}`,
+		}
+	}}
+	featureKind = &fixtureKind{element: "feature", list: "features", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"name": fmt.Sprintf("Feature %d", id), "system_name": fmt.Sprintf("feature_%d", id),
+			"scope": "application_plan", "visible": true, "description": "",
+		}
+	}}
+	invoiceKind = &fixtureKind{element: "invoice", list: "invoices", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"friendly_id": fmt.Sprintf("2019-02-%08d", id), "account_id": 1, "state": "open", "currency": "EUR",
+			"cost": 0.0, "cost_without_vat": 0.0, "vat_amount": 0.0, "vat_rate": 0.0,
+			"period": map[string]interface{}{"from": "2019-02-01", "to": "2019-02-28"},
+		}
+	}}
+	alertKind = &fixtureKind{element: "alert", list: "alerts", defaults: func(id int64) map[string]interface{} {
+		return map[string]interface{}{
+			"account_id": 1, "application_id": 1, "service_id": 1, "alert_id": id, "state": "unread",
+			"level": 80, "utilization": 0.8, "message": "hits per minute: 80 of 100", "timestamp": fixtureTimestamp,
+		}
+	}}
+)
+
+// NewAccount is a developer account fixture
+func NewAccount(opts ...Option) *Fixture { return newFixture(accountKind, 1, opts) }
+
+// NewAccountList is a list of n developer account fixtures
+func NewAccountList(n int, opts ...Option) *FixtureList { return newFixtureList(accountKind, n, opts) }
+
+// NewApplication is an application fixture
+func NewApplication(opts ...Option) *Fixture { return newFixture(applicationKind, 1, opts) }
+
+// NewApplicationList is a list of n application fixtures
+func NewApplicationList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(applicationKind, n, opts)
+}
+
+// NewApplicationPlan is an application plan fixture
+func NewApplicationPlan(opts ...Option) *Fixture { return newFixture(applicationPlanKind, 1, opts) }
+
+// NewApplicationPlanList is a list of n application plan fixtures
+func NewApplicationPlanList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(applicationPlanKind, n, opts)
+}
+
+// NewProduct is a product (service) fixture
+func NewProduct(opts ...Option) *Fixture { return newFixture(productKind, 1, opts) }
+
+// NewProductList is a list of n product (service) fixtures
+func NewProductList(n int, opts ...Option) *FixtureList { return newFixtureList(productKind, n, opts) }
+
+// NewBackendAPI is a backend API fixture
+func NewBackendAPI(opts ...Option) *Fixture { return newFixture(backendAPIKind, 1, opts) }
+
+// NewBackendAPIList is a list of n backend API fixtures
+func NewBackendAPIList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(backendAPIKind, n, opts)
+}
+
+// NewBackendUsage is a backend usage fixture
+func NewBackendUsage(opts ...Option) *Fixture { return newFixture(backendUsageKind, 1, opts) }
+
+// NewBackendUsageList is a list of n backend usage fixtures, rendered as a bare JSON array like the API does
+func NewBackendUsageList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(backendUsageKind, n, opts)
+}
+
+// NewMetric is a metric fixture
+func NewMetric(opts ...Option) *Fixture { return newFixture(metricKind, 1, opts) }
+
+// NewMetricList is a list of n metric fixtures
+func NewMetricList(n int, opts ...Option) *FixtureList { return newFixtureList(metricKind, n, opts) }
+
+// NewMethod is a method fixture
+func NewMethod(opts ...Option) *Fixture { return newFixture(methodKind, 1, opts) }
+
+// NewMethodList is a list of n method fixtures
+func NewMethodList(n int, opts ...Option) *FixtureList { return newFixtureList(methodKind, n, opts) }
+
+// NewMappingRule is a mapping rule fixture
+func NewMappingRule(opts ...Option) *Fixture { return newFixture(mappingRuleKind, 1, opts) }
+
+// NewMappingRuleList is a list of n mapping rule fixtures
+func NewMappingRuleList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(mappingRuleKind, n, opts)
+}
+
+// NewProxy is a product proxy fixture
+func NewProxy(opts ...Option) *Fixture { return newFixture(proxyKind, 1, opts) }
+
+// NewLimit is an application plan limit fixture
+func NewLimit(opts ...Option) *Fixture { return newFixture(limitKind, 1, opts) }
+
+// NewLimitList is a list of n application plan limit fixtures
+func NewLimitList(n int, opts ...Option) *FixtureList { return newFixtureList(limitKind, n, opts) }
+
+// NewPricingRule is an application plan pricing rule fixture
+func NewPricingRule(opts ...Option) *Fixture { return newFixture(pricingRuleKind, 1, opts) }
+
+// NewPricingRuleList is a list of n application plan pricing rule fixtures
+func NewPricingRuleList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(pricingRuleKind, n, opts)
+}
+
+// NewDeveloperUser is a developer user fixture
+func NewDeveloperUser(opts ...Option) *Fixture { return newFixture(developerUserKind, 1, opts) }
+
+// NewDeveloperUserList is a list of n developer user fixtures
+func NewDeveloperUserList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(developerUserKind, n, opts)
+}
+
+// NewActiveDoc is an ActiveDoc fixture
+func NewActiveDoc(opts ...Option) *Fixture { return newFixture(activeDocKind, 1, opts) }
+
+// NewActiveDocList is a list of n ActiveDoc fixtures
+func NewActiveDocList(n int, opts ...Option) *FixtureList {
+	return newFixtureList(activeDocKind, n, opts)
+}
+
+// NewFeature is a plan feature fixture
+func NewFeature(opts ...Option) *Fixture { return newFixture(featureKind, 1, opts) }
+
+// NewFeatureList is a list of n plan feature fixtures
+func NewFeatureList(n int, opts ...Option) *FixtureList { return newFixtureList(featureKind, n, opts) }
+
+// NewInvoice is an invoice fixture
+func NewInvoice(opts ...Option) *Fixture { return newFixture(invoiceKind, 1, opts) }
+
+// NewInvoiceList is a list of n invoice fixtures
+func NewInvoiceList(n int, opts ...Option) *FixtureList { return newFixtureList(invoiceKind, n, opts) }
+
+// NewAlert is a usage limit alert fixture
+func NewAlert(opts ...Option) *Fixture { return newFixture(alertKind, 1, opts) }
+
+// NewAlertList is a list of n usage limit alert fixtures
+func NewAlertList(n int, opts ...Option) *FixtureList { return newFixtureList(alertKind, n, opts) }
+
+// WithID sets the fixture id
+func WithID(id int64) Option {
+	return WithAttr("id", id)
+}
+
+// WithAttr sets a fixture attribute, a nil value renders as JSON null
+func WithAttr(key string, value interface{}) Option {
+	return func(f *Fixture) {
+		f.attrs[key] = value
+	}
+}
+
+// WithAttrs sets several fixture attributes
+func WithAttrs(attrs map[string]interface{}) Option {
+	return func(f *Fixture) {
+		for key, value := range attrs {
+			f.attrs[key] = value
+		}
+	}
+}
+
+// WithoutAttr removes a fixture attribute
+func WithoutAttr(key string) Option {
+	return func(f *Fixture) {
+		delete(f.attrs, key)
+	}
+}
+
+func newFixture(kind *fixtureKind, id int64, opts []Option) *Fixture {
+	f := &Fixture{kind: kind, attrs: kind.defaults(id)}
+	f.attrs["id"] = id
+	f.attrs["created_at"] = fixtureTimestamp
+	f.attrs["updated_at"] = fixtureTimestamp
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// newFixtureList builds n fixtures with ids from 1 to n, options apply to every item
+func newFixtureList(kind *fixtureKind, n int, opts []Option) *FixtureList {
+	list := &FixtureList{kind: kind, Items: make([]*Fixture, 0, n)}
+	for i := 1; i <= n; i++ {
+		list.Items = append(list.Items, newFixture(kind, int64(i), opts))
+	}
+	return list
+}
+
+// Attr returns a fixture attribute
+func (f *Fixture) Attr(key string) interface{} {
+	return f.attrs[key]
+}
+
+// JSON renders the fixture wrapped in its element key, e.g. {"application": {...}}
+func (f *Fixture) JSON() string {
+	return mustMarshalJSON(map[string]interface{}{f.kind.element: f.attrs})
+}
+
+// XML renders the fixture as a 3scale XML document, one child element per attribute
+func (f *Fixture) XML() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString(xml.Header)
+	writeXMLElement(buf, f.kind.xmlElementName(), f.attrs)
+	return buf.String()
+}
+
+// Response returns a http response with the JSON fixture as body
+func (f *Fixture) Response(statusCode int) *http.Response {
+	return fixtureResponse(statusCode, "application/json", f.JSON())
+}
+
+// XMLResponse returns a http response with the XML fixture as body
+func (f *Fixture) XMLResponse(statusCode int) *http.Response {
+	return fixtureResponse(statusCode, "application/xml", f.XML())
+}
+
+// JSON renders the list wrapped in its list key, e.g. {"accounts": [{"account": {...}}]}
+func (l *FixtureList) JSON() string {
+	items := make([]interface{}, 0, len(l.Items))
+	for _, item := range l.Items {
+		items = append(items, map[string]interface{}{l.kind.element: item.attrs})
+	}
+
+	if l.kind.list == "" {
+		return mustMarshalJSON(items)
+	}
+	return mustMarshalJSON(map[string]interface{}{l.kind.list: items})
+}
+
+// XML renders the list as a 3scale XML document
+func (l *FixtureList) XML() string {
+	buf := &bytes.Buffer{}
+	buf.WriteString(xml.Header)
+	fmt.Fprintf(buf, "<%s>", l.kind.xmlListName())
+	for _, item := range l.Items {
+		writeXMLElement(buf, l.kind.xmlElementName(), item.attrs)
+	}
+	fmt.Fprintf(buf, "</%s>", l.kind.xmlListName())
+	return buf.String()
+}
+
+// Response returns a http response with the JSON list as body
+func (l *FixtureList) Response(statusCode int) *http.Response {
+	return fixtureResponse(statusCode, "application/json", l.JSON())
+}
+
+// XMLResponse returns a http response with the XML list as body
+func (l *FixtureList) XMLResponse(statusCode int) *http.Response {
+	return fixtureResponse(statusCode, "application/xml", l.XML())
+}
+
+func (k *fixtureKind) xmlElementName() string {
+	if k.xmlElement != "" {
+		return k.xmlElement
+	}
+	return k.element
+}
+
+func (k *fixtureKind) xmlListName() string {
+	switch {
+	case k.xmlList != "":
+		return k.xmlList
+	case k.list != "":
+		return k.list
+	}
+	return k.element + "s"
+}
+
+func writeXMLElement(buf *bytes.Buffer, name string, attrs map[string]interface{}) {
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(buf, "<%s>", name)
+	for _, key := range keys {
+		switch value := attrs[key].(type) {
+		case nil:
+			fmt.Fprintf(buf, "<%s/>", key)
+		case map[string]interface{}:
+			writeXMLElement(buf, key, value)
+		default:
+			fmt.Fprintf(buf, "<%s>", key)
+			xml.EscapeText(buf, []byte(fmt.Sprint(value)))
+			fmt.Fprintf(buf, "</%s>", key)
+		}
+	}
+	fmt.Fprintf(buf, "</%s>", name)
+}
+
+func mustMarshalJSON(obj interface{}) string {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		panic(fmt.Sprintf("fixture is not JSON serializable: %v", err))
+	}
+	return string(body)
+}
+
+func fixtureResponse(statusCode int, contentType, body string) *http.Response {
+	header := make(http.Header)
+	header.Set("Content-Type", contentType)
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		Header:     header,
+	}
+}
@@ -0,0 +1,98 @@
+package fake
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// RequestAssertion describes the expected shape of an outgoing request.
+// Empty fields are not checked, and only the listed Query and Form keys are compared.
+// Form params also require the url encoded form Content-Type
+type RequestAssertion struct {
+	Method string
+	Path   string
+	Query  map[string]string
+	Form   map[string]string
+}
+
+// Assert fails the test when the request does not match the assertion
+func (a RequestAssertion) Assert(t testing.TB, req *http.Request) {
+	t.Helper()
+	if err := a.Check(req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Check returns an error describing every mismatch between the request and the assertion.
+// The request body is left readable for the caller
+func (a RequestAssertion) Check(req *http.Request) error {
+	var mismatches []string
+
+	if a.Method != "" && req.Method != a.Method {
+		mismatches = append(mismatches, fmt.Sprintf("Method does not match. Expected [%s]; got [%s]", a.Method, req.Method))
+	}
+
+	if a.Path != "" && req.URL.Path != a.Path {
+		mismatches = append(mismatches, fmt.Sprintf("Path does not match. Expected [%s]; got [%s]", a.Path, req.URL.Path))
+	}
+
+	mismatches = append(mismatches, paramMismatches("query", a.Query, req.URL.Query())...)
+
+	if len(a.Form) > 0 {
+		if contentType := req.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+			mismatches = append(mismatches, fmt.Sprintf("Content-Type does not match form params. Expected [application/x-www-form-urlencoded]; got [%s]", contentType))
+		}
+		form, err := readForm(req)
+		if err != nil {
+			return fmt.Errorf("reading form params: %v", err)
+		}
+		mismatches = append(mismatches, paramMismatches("form", a.Form, form)...)
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("request %s %s does not match:\n%s", req.Method, req.URL.Path, strings.Join(mismatches, "\n"))
+	}
+	return nil
+}
+
+// readForm decodes the url encoded request body and restores it for later reads
+func readForm(req *http.Request) (url.Values, error) {
+	if req.Body == nil {
+		return url.Values{}, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return url.ParseQuery(string(body))
+}
+
+func paramMismatches(kind string, expected map[string]string, actual url.Values) []string {
+	keys := make([]string, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var mismatches []string
+	for _, key := range keys {
+		if _, ok := actual[key]; !ok {
+			mismatches = append(mismatches, fmt.Sprintf("%s param %s missing", kind, key))
+			continue
+		}
+		if actual.Get(key) != expected[key] {
+			mismatches = append(mismatches, fmt.Sprintf("%s param %s does not match. Expected [%s]; got [%s]", kind, key, expected[key], actual.Get(key)))
+		}
+	}
+	return mismatches
+}
@@ -0,0 +1,600 @@
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Server is an in-memory Admin Portal implementing CRUD over the JSON Account Management API
+// endpoints of developer accounts, applications, application plans and products (services).
+// State is kept for the lifetime of the server, so objects created through one request
+// are returned by the following ones.
+type Server struct {
+	*httptest.Server
+
+	// AccessToken when not empty is required as basic auth password of every request
+	AccessToken string
+
+	mu           sync.Mutex
+	lastID       int64
+	accounts     *collection
+	applications *collection
+	plans        *collection
+	services     *collection
+	// admin users of the developer accounts, by account id
+	users map[int64]accountUser
+	// route patterns matched in order, path ids are passed to the handler
+	routes []route
+}
+
+type accountUser struct {
+	username string
+	email    string
+}
+
+type route struct {
+	pattern *regexp.Regexp
+	methods map[string]func(w http.ResponseWriter, r *http.Request, ids []int64)
+}
+
+// resource is the JSON representation of a stored object
+type resource map[string]interface{}
+
+// collection stores the objects of one resource type
+type collection struct {
+	// element is the key wrapping every object, list the key wrapping object lists
+	element string
+	list    string
+	// numbers and booleans list the attributes decoded from form values
+	numbers  map[string]bool
+	booleans map[string]bool
+	items    map[int64]resource
+}
+
+// read-only attributes, managed by the server
+var protectedAttrs = map[string]bool{
+	"id": true, "created_at": true, "updated_at": true, "state": true,
+	"account_id": true, "service_id": true, "plan_id": true,
+	"user_key": true, "provider_verification_key": true, "state_event": true,
+}
+
+// NewServer starts an empty fake Admin Portal. The caller must Close it when done
+func NewServer() *Server {
+	s := &Server{
+		accounts: newCollection("account", "accounts",
+			nil,
+			[]string{"monthly_billing_enabled", "monthly_charging_enabled", "credit_card_stored"}),
+		applications: newCollection("application", "applications",
+			nil,
+			[]string{"enabled", "end_user_required"}),
+		plans: newCollection("application_plan", "plans",
+			[]string{"setup_fee", "cost_per_month", "trial_period_days", "cancellation_period"},
+			[]string{"approval_required", "default", "custom"}),
+		services: newCollection("service", "services",
+			nil,
+			[]string{"intentions_required", "buyers_manage_apps", "buyers_manage_keys", "referrer_filters_required",
+				"custom_keys_enabled", "buyer_key_regenerate_enabled", "mandatory_app_key", "buyer_can_select_plan"}),
+		users: map[int64]accountUser{},
+	}
+
+	s.handle(`/admin/api/accounts\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet: s.list(s.accounts, nil),
+	})
+	s.handle(`/admin/api/accounts/find\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet: s.findAccount,
+	})
+	s.handle(`/admin/api/signup\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodPost: s.signup,
+	})
+	s.handle(`/admin/api/accounts/(\d+)\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet:    s.read(s.accounts),
+		http.MethodPut:    s.update(s.accounts),
+		http.MethodDelete: s.deleteAccount,
+	})
+	s.handle(`/admin/api/accounts/(\d+)/applications\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet:  s.listAccountApplications,
+		http.MethodPost: s.createApplication,
+	})
+	s.handle(`/admin/api/accounts/(\d+)/applications/(\d+)\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet:    s.nested(s.accounts, s.applications, "account_id", s.read(s.applications)),
+		http.MethodPut:    s.nested(s.accounts, s.applications, "account_id", s.update(s.applications)),
+		http.MethodDelete: s.nested(s.accounts, s.applications, "account_id", s.delete(s.applications)),
+	})
+	s.handle(`/admin/api/applications\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet: s.list(s.applications, nil),
+	})
+	s.handle(`/admin/api/services\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet:  s.list(s.services, nil),
+		http.MethodPost: s.createService,
+	})
+	s.handle(`/admin/api/services/(\d+)\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet:    s.read(s.services),
+		http.MethodPut:    s.update(s.services),
+		http.MethodDelete: s.deleteService,
+	})
+	s.handle(`/admin/api/services/(\d+)/application_plans\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet:  s.listServicePlans,
+		http.MethodPost: s.createPlan,
+	})
+	s.handle(`/admin/api/services/(\d+)/application_plans/(\d+)\.json`, map[string]func(http.ResponseWriter, *http.Request, []int64){
+		http.MethodGet:    s.nested(s.services, s.plans, "service_id", s.read(s.plans)),
+		http.MethodPut:    s.nested(s.services, s.plans, "service_id", s.update(s.plans)),
+		http.MethodDelete: s.nested(s.services, s.plans, "service_id", s.delete(s.plans)),
+	})
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func newCollection(element, list string, numbers, booleans []string) *collection {
+	c := &collection{
+		element:  element,
+		list:     list,
+		numbers:  map[string]bool{},
+		booleans: map[string]bool{},
+		items:    map[int64]resource{},
+	}
+	for _, attr := range numbers {
+		c.numbers[attr] = true
+	}
+	for _, attr := range booleans {
+		c.booleans[attr] = true
+	}
+	return c
+}
+
+func (s *Server) handle(pattern string, methods map[string]func(http.ResponseWriter, *http.Request, []int64)) {
+	s.routes = append(s.routes, route{pattern: regexp.MustCompile("^" + pattern + "$"), methods: methods})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.AccessToken != "" {
+		if _, token, ok := r.BasicAuth(); !ok || token != s.AccessToken {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "Your access token does not have the correct permissions"})
+			return
+		}
+	}
+
+	for _, rt := range s.routes {
+		match := rt.pattern.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+
+		handler, ok := rt.methods[r.Method]
+		if !ok {
+			writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"status": "Method not allowed"})
+			return
+		}
+
+		ids := make([]int64, 0, len(match)-1)
+		for _, raw := range match[1:] {
+			id, _ := strconv.ParseInt(raw, 10, 64)
+			ids = append(ids, id)
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		handler(w, r, ids)
+		return
+	}
+
+	writeNotFound(w)
+}
+
+// list writes the objects matching the filter, paginated when page or per_page query params are set
+func (s *Server) list(c *collection, filter func(resource) bool) func(http.ResponseWriter, *http.Request, []int64) {
+	return func(w http.ResponseWriter, r *http.Request, _ []int64) {
+		ids := make([]int64, 0, len(c.items))
+		for id, item := range c.items {
+			if filter == nil || filter(item) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		ids = paginate(r, ids)
+
+		items := make([]resource, 0, len(ids))
+		for _, id := range ids {
+			items = append(items, resource{c.element: c.items[id]})
+		}
+		writeJSON(w, http.StatusOK, resource{c.list: items})
+	}
+}
+
+func (s *Server) read(c *collection) func(http.ResponseWriter, *http.Request, []int64) {
+	return func(w http.ResponseWriter, r *http.Request, ids []int64) {
+		item, ok := c.items[ids[len(ids)-1]]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, http.StatusOK, resource{c.element: item})
+	}
+}
+
+func (s *Server) update(c *collection) func(http.ResponseWriter, *http.Request, []int64) {
+	return func(w http.ResponseWriter, r *http.Request, ids []int64) {
+		item, ok := c.items[ids[len(ids)-1]]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+
+		attrs, err := readAttributes(r, c)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		// system_name is immutable once created
+		delete(attrs, "system_name")
+
+		item.merge(attrs)
+		if c == s.plans {
+			item["state"] = planState(item["state"].(string), stringAttr(attrs, "state_event"))
+		}
+		item["updated_at"] = now()
+		writeJSON(w, http.StatusOK, resource{c.element: item})
+	}
+}
+
+func (s *Server) delete(c *collection) func(http.ResponseWriter, *http.Request, []int64) {
+	return func(w http.ResponseWriter, r *http.Request, ids []int64) {
+		id := ids[len(ids)-1]
+		if _, ok := c.items[id]; !ok {
+			writeNotFound(w)
+			return
+		}
+		delete(c.items, id)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// nested guards handlers of objects living under a parent object, like account applications.
+// Objects not belonging to the parent are reported as not found
+func (s *Server) nested(parents, children *collection, parentAttr string, handler func(http.ResponseWriter, *http.Request, []int64)) func(http.ResponseWriter, *http.Request, []int64) {
+	return func(w http.ResponseWriter, r *http.Request, ids []int64) {
+		if _, ok := parents.items[ids[0]]; !ok {
+			writeNotFound(w)
+			return
+		}
+		child, ok := children.items[ids[1]]
+		if !ok || child[parentAttr] != ids[0] {
+			writeNotFound(w)
+			return
+		}
+		handler(w, r, ids)
+	}
+}
+
+func (s *Server) signup(w http.ResponseWriter, r *http.Request, _ []int64) {
+	attrs, err := readAttributes(r, s.accounts)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+
+	user := accountUser{username: stringAttr(attrs, "username"), email: stringAttr(attrs, "email")}
+	delete(attrs, "username")
+	delete(attrs, "email")
+	delete(attrs, "password")
+
+	switch {
+	case stringAttr(attrs, "org_name") == "":
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("org_name", "can't be blank"))
+		return
+	case user.username == "":
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("username", "can't be blank"))
+		return
+	}
+	for _, existing := range s.users {
+		if existing.username == user.username {
+			writeJSON(w, http.StatusUnprocessableEntity, validationErrors("username", "has already been taken"))
+			return
+		}
+	}
+
+	account := s.create(s.accounts, attrs)
+	account["state"] = "approved"
+	s.users[account["id"].(int64)] = user
+	writeJSON(w, http.StatusCreated, resource{s.accounts.element: account})
+}
+
+func (s *Server) findAccount(w http.ResponseWriter, r *http.Request, _ []int64) {
+	username, email := r.URL.Query().Get("username"), r.URL.Query().Get("email")
+	for id, user := range s.users {
+		if (username != "" && user.username == username) || (email != "" && user.email == email) {
+			writeJSON(w, http.StatusOK, resource{s.accounts.element: s.accounts.items[id]})
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) deleteAccount(w http.ResponseWriter, r *http.Request, ids []int64) {
+	if _, ok := s.accounts.items[ids[0]]; !ok {
+		writeNotFound(w)
+		return
+	}
+
+	for id, app := range s.applications.items {
+		if app["account_id"] == ids[0] {
+			delete(s.applications.items, id)
+		}
+	}
+	delete(s.users, ids[0])
+	delete(s.accounts.items, ids[0])
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listAccountApplications(w http.ResponseWriter, r *http.Request, ids []int64) {
+	if _, ok := s.accounts.items[ids[0]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	s.list(s.applications, func(app resource) bool { return app["account_id"] == ids[0] })(w, r, ids)
+}
+
+func (s *Server) createApplication(w http.ResponseWriter, r *http.Request, ids []int64) {
+	if _, ok := s.accounts.items[ids[0]]; !ok {
+		writeNotFound(w)
+		return
+	}
+
+	planID, _ := strconv.ParseInt(formOrQuery(r, "plan_id"), 10, 64)
+	attrs, err := readAttributes(r, s.applications)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+
+	plan, ok := s.plans.items[planID]
+	switch {
+	case !ok:
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("plan", "can't be blank"))
+		return
+	case stringAttr(attrs, "name") == "":
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("name", "can't be blank"))
+		return
+	}
+
+	app := s.create(s.applications, attrs)
+	app["state"] = "live"
+	app["enabled"] = true
+	app["account_id"] = ids[0]
+	app["plan_id"] = planID
+	app["service_id"] = plan["service_id"]
+	app["user_key"] = randomKey()
+	app["provider_verification_key"] = randomKey()
+	writeJSON(w, http.StatusCreated, resource{s.applications.element: app})
+}
+
+func (s *Server) createService(w http.ResponseWriter, r *http.Request, _ []int64) {
+	attrs, err := readAttributes(r, s.services)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	if stringAttr(attrs, "name") == "" {
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("name", "can't be blank"))
+		return
+	}
+	if !s.assignSystemName(s.services, attrs, nil) {
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("system_name", "has already been taken"))
+		return
+	}
+
+	service := s.create(s.services, attrs)
+	service["state"] = "incomplete"
+	writeJSON(w, http.StatusCreated, resource{s.services.element: service})
+}
+
+func (s *Server) deleteService(w http.ResponseWriter, r *http.Request, ids []int64) {
+	if _, ok := s.services.items[ids[0]]; !ok {
+		writeNotFound(w)
+		return
+	}
+
+	for id, plan := range s.plans.items {
+		if plan["service_id"] == ids[0] {
+			delete(s.plans.items, id)
+		}
+	}
+	for id, app := range s.applications.items {
+		if app["service_id"] == ids[0] {
+			delete(s.applications.items, id)
+		}
+	}
+	delete(s.services.items, ids[0])
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) listServicePlans(w http.ResponseWriter, r *http.Request, ids []int64) {
+	if _, ok := s.services.items[ids[0]]; !ok {
+		writeNotFound(w)
+		return
+	}
+	s.list(s.plans, func(plan resource) bool { return plan["service_id"] == ids[0] })(w, r, ids)
+}
+
+func (s *Server) createPlan(w http.ResponseWriter, r *http.Request, ids []int64) {
+	if _, ok := s.services.items[ids[0]]; !ok {
+		writeNotFound(w)
+		return
+	}
+
+	attrs, err := readAttributes(r, s.plans)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return
+	}
+	if stringAttr(attrs, "name") == "" {
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("name", "can't be blank"))
+		return
+	}
+	inService := func(plan resource) bool { return plan["service_id"] == ids[0] }
+	if !s.assignSystemName(s.plans, attrs, inService) {
+		writeJSON(w, http.StatusUnprocessableEntity, validationErrors("system_name", "has already been taken"))
+		return
+	}
+
+	plan := s.create(s.plans, attrs)
+	plan["service_id"] = ids[0]
+	plan["state"] = planState("hidden", stringAttr(attrs, "state_event"))
+	writeJSON(w, http.StatusCreated, resource{s.plans.element: plan})
+}
+
+// create stores a new object built from the attributes
+func (s *Server) create(c *collection, attrs resource) resource {
+	s.lastID++
+	item := resource{"id": s.lastID, "created_at": now(), "updated_at": now()}
+	item.merge(attrs)
+	c.items[s.lastID] = item
+	return item
+}
+
+// assignSystemName defaults system_name to the name and reports whether it is unique within the scope
+func (s *Server) assignSystemName(c *collection, attrs resource, scope func(resource) bool) bool {
+	systemName := stringAttr(attrs, "system_name")
+	if systemName == "" {
+		systemName = systemNameFrom(stringAttr(attrs, "name"))
+		attrs["system_name"] = systemName
+	}
+
+	for _, item := range c.items {
+		if (scope == nil || scope(item)) && item["system_name"] == systemName {
+			return false
+		}
+	}
+	return true
+}
+
+func (r resource) merge(attrs resource) {
+	for key, value := range attrs {
+		if !protectedAttrs[key] {
+			r[key] = value
+		}
+	}
+}
+
+// readAttributes decodes a JSON object or url encoded form body
+func readAttributes(r *http.Request, c *collection) (resource, error) {
+	attrs := resource{}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := json.NewDecoder(r.Body).Decode(&attrs); err != nil {
+			return nil, fmt.Errorf("invalid JSON body: %v", err)
+		}
+		return attrs, nil
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	for key := range r.Form {
+		value := r.Form.Get(key)
+		switch {
+		case c.numbers[key]:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("param %s is not a number", key)
+			}
+			attrs[key] = number
+		case c.booleans[key]:
+			attrs[key] = value == "true" || value == "1"
+		default:
+			attrs[key] = value
+		}
+	}
+	return attrs, nil
+}
+
+// paginate returns the requested page of ids, all of them when no pagination param is set
+func paginate(r *http.Request, ids []int64) []int64 {
+	query := r.URL.Query()
+	if query.Get("page") == "" && query.Get("per_page") == "" {
+		return ids
+	}
+
+	page, err := strconv.Atoi(query.Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	perPage, err := strconv.Atoi(query.Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = 500
+	}
+
+	start := (page - 1) * perPage
+	if start >= len(ids) {
+		return []int64{}
+	}
+	end := start + perPage
+	if end > len(ids) {
+		end = len(ids)
+	}
+	return ids[start:end]
+}
+
+func planState(current, stateEvent string) string {
+	switch stateEvent {
+	case "publish":
+		return "published"
+	case "hide":
+		return "hidden"
+	}
+	return current
+}
+
+func formOrQuery(r *http.Request, key string) string {
+	if err := r.ParseForm(); err != nil {
+		return ""
+	}
+	return r.Form.Get(key)
+}
+
+func stringAttr(attrs resource, key string) string {
+	value, _ := attrs[key].(string)
+	return value
+}
+
+var systemNameInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+func systemNameFrom(name string) string {
+	return strings.Trim(systemNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+func randomKey() string {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(key)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func validationErrors(attr, msg string) map[string]map[string][]string {
+	return map[string]map[string][]string{"errors": {attr: {msg}}}
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, map[string]string{"status": "Not found"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(obj)
+}
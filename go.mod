@@ -1,3 +1,3 @@
 module github.com/3scale/3scale-porta-go-client
 
-go 1.13
+go 1.18
@@ -0,0 +1,341 @@
+// Package snapshot mirrors tenant resources into a local store
+// so reporting and offline queries do not need to hit 3scale.
+package snapshot
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/3scale/3scale-porta-go-client/client"
+)
+
+// Mirrored resource kinds
+const (
+	KindAccounts         = "accounts"
+	KindApplications     = "applications"
+	KindProducts         = "products"
+	KindApplicationPlans = "application_plans"
+)
+
+// DefaultConcurrency is the default number of concurrent requests issued while refreshing
+const DefaultConcurrency = 4
+
+// KindStats holds the changes applied to the store for one resource kind
+type KindStats struct {
+	Added     int
+	Updated   int
+	Unchanged int
+	Deleted   int
+}
+
+// RefreshStats holds the changes applied to the store by a refresh, indexed by kind
+type RefreshStats map[string]KindStats
+
+// Mirror copies accounts, applications, products and application plans of a tenant into a Store
+type Mirror struct {
+	client      *client.ThreeScaleClient
+	store       Store
+	concurrency int
+}
+
+// NewMirror returns a Mirror reading from the 3scale client and writing into the store
+func NewMirror(c *client.ThreeScaleClient, store Store) *Mirror {
+	return &Mirror{client: c, store: store, concurrency: DefaultConcurrency}
+}
+
+// SetConcurrency sets the maximum number of in-flight requests while refreshing.
+// Values lower than 1 restore DefaultConcurrency
+func (m *Mirror) SetConcurrency(concurrency int) {
+	if concurrency < 1 {
+		concurrency = DefaultConcurrency
+	}
+	m.concurrency = concurrency
+}
+
+// Refresh incrementally updates the store by updated_at: only records whose updated_at moved past
+// the stored one, or that carry none, are written, and records no longer present in 3scale are deleted.
+// The Account Management API lists take no updated since filter, so the resources are still listed
+// to find the changed and deleted ones
+func (m *Mirror) Refresh() (RefreshStats, error) {
+	accounts, err := m.client.ListDeveloperAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	products, err := m.client.ListProducts()
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := make([]int64, 0, len(accounts.Items))
+	accountRecords := make([]Record, 0, len(accounts.Items))
+	for _, account := range accounts.Items {
+		var id int64
+		if account.Element.ID != nil {
+			id = *account.Element.ID
+		}
+		var updatedAt string
+		if account.Element.UpdatedAt != nil {
+			updatedAt = *account.Element.UpdatedAt
+		}
+		record, err := newRecord(id, updatedAt, account.Element)
+		if err != nil {
+			return nil, err
+		}
+		accountIDs = append(accountIDs, id)
+		accountRecords = append(accountRecords, record)
+	}
+
+	productIDs := make([]int64, 0, len(products.Products))
+	productRecords := make([]Record, 0, len(products.Products))
+	for _, product := range products.Products {
+		record, err := newRecord(product.Element.ID, product.Element.UpdatedAt, product.Element)
+		if err != nil {
+			return nil, err
+		}
+		productIDs = append(productIDs, product.Element.ID)
+		productRecords = append(productRecords, record)
+	}
+
+	applicationRecords, err := m.fetchAll(accountIDs, func(accountID int64) ([]Record, error) {
+		list, err := m.client.ListApplications(accountID)
+		if err != nil {
+			return nil, err
+		}
+		records := make([]Record, 0, len(list.Applications))
+		for _, app := range list.Applications {
+			record, err := newRecord(app.Application.ID, app.Application.UpdatedAt, app.Application)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		return records, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	planRecords, err := m.fetchAll(productIDs, func(productID int64) ([]Record, error) {
+		list, err := m.client.ListApplicationPlansByProduct(productID)
+		if err != nil {
+			return nil, err
+		}
+		records := make([]Record, 0, len(list.Plans))
+		for _, plan := range list.Plans {
+			record, err := newRecord(plan.Element.ID, plan.Element.UpdatedAt, plan.Element)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		return records, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := RefreshStats{}
+	for kind, records := range map[string][]Record{
+		KindAccounts:         accountRecords,
+		KindProducts:         productRecords,
+		KindApplications:     applicationRecords,
+		KindApplicationPlans: planRecords,
+	} {
+		kindStats, err := m.apply(kind, records)
+		if err != nil {
+			return nil, err
+		}
+		stats[kind] = kindStats
+	}
+
+	return stats, nil
+}
+
+// fetchAll calls fetch for every parent ID with at most m.concurrency calls in flight.
+// No further calls are started once one fails
+func (m *Mirror) fetchAll(parentIDs []int64, fetch func(int64) ([]Record, error)) ([]Record, error) {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		records  []Record
+		firstErr error
+	)
+
+	sem := make(chan struct{}, m.concurrency)
+	for _, parentID := range parentIDs {
+		sem <- struct{}{}
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(parentID int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result, err := fetch(parentID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			records = append(records, result...)
+		}(parentID)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return records, nil
+}
+
+// apply writes new and changed records of a kind and deletes the stale ones
+func (m *Mirror) apply(kind string, records []Record) (KindStats, error) {
+	var stats KindStats
+
+	stored, err := m.store.Records(kind)
+	if err != nil {
+		return stats, err
+	}
+
+	changed := []Record{}
+	for _, record := range records {
+		previous, ok := stored[record.ID]
+		delete(stored, record.ID)
+		switch {
+		case !ok:
+			stats.Added++
+		case updatedSince(record.UpdatedAt, previous.UpdatedAt):
+			stats.Updated++
+		default:
+			stats.Unchanged++
+			continue
+		}
+		changed = append(changed, record)
+	}
+
+	stale := make([]int64, 0, len(stored))
+	for id := range stored {
+		stale = append(stale, id)
+	}
+	stats.Deleted = len(stale)
+
+	if err := m.store.Put(kind, changed); err != nil {
+		return stats, err
+	}
+	return stats, m.store.Delete(kind, stale)
+}
+
+// Accounts returns the mirrored developer accounts sorted by ID
+func (m *Mirror) Accounts() ([]client.DeveloperAccountItem, error) {
+	var items []client.DeveloperAccountItem
+	err := m.load(KindAccounts, func(data json.RawMessage) error {
+		var item client.DeveloperAccountItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// Applications returns the mirrored applications sorted by ID
+func (m *Mirror) Applications() ([]client.Application, error) {
+	var items []client.Application
+	err := m.load(KindApplications, func(data json.RawMessage) error {
+		var item client.Application
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// Products returns the mirrored products sorted by ID
+func (m *Mirror) Products() ([]client.ProductItem, error) {
+	var items []client.ProductItem
+	err := m.load(KindProducts, func(data json.RawMessage) error {
+		var item client.ProductItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// ApplicationPlans returns the mirrored application plans sorted by ID
+func (m *Mirror) ApplicationPlans() ([]client.ApplicationPlanItem, error) {
+	var items []client.ApplicationPlanItem
+	err := m.load(KindApplicationPlans, func(data json.RawMessage) error {
+		var item client.ApplicationPlanItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return err
+		}
+		items = append(items, item)
+		return nil
+	})
+	return items, err
+}
+
+// load calls decode for the data of every record of a kind in ID order
+func (m *Mirror) load(kind string, decode func(json.RawMessage) error) error {
+	records, err := m.store.Records(kind)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int64, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		if err := decode(records[id].Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updatedSince reports whether a record updated at updatedAt is newer than the stored one updated at stored.
+// Timestamps not in RFC 3339 format are only compared for equality, a missing one always counts as newer
+func updatedSince(updatedAt, stored string) bool {
+	if updatedAt == "" || stored == "" {
+		return true
+	}
+	current, err := time.Parse(time.RFC3339, updatedAt)
+	if err != nil {
+		return updatedAt != stored
+	}
+	previous, err := time.Parse(time.RFC3339, stored)
+	if err != nil {
+		return updatedAt != stored
+	}
+	return current.After(previous)
+}
+
+func newRecord(id int64, updatedAt string, obj interface{}) (Record, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return Record{}, err
+	}
+	return Record{ID: id, UpdatedAt: updatedAt, Data: data}, nil
+}
@@ -0,0 +1,193 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/client"
+)
+
+type fakeTenant struct {
+	mu        sync.Mutex
+	responses map[string]interface{}
+}
+
+func (f *fakeTenant) set(path string, obj interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.responses[path] = obj
+}
+
+func (f *fakeTenant) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	obj, ok := f.responses[req.URL.Path]
+	f.mu.Unlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(obj)
+}
+
+func int64Ptr(v int64) *int64    { return &v }
+func stringPtr(v string) *string { return &v }
+
+func newTestMirror(t *testing.T, tenant *fakeTenant) *Mirror {
+	t.Helper()
+	server := httptest.NewServer(tenant)
+	t.Cleanup(server.Close)
+
+	ap, err := client.NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "snapshot.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	return NewMirror(client.NewThreeScale(ap, "someAccessToken", server.Client()), store)
+}
+
+func application(id int64, updatedAt string) client.ApplicationElem {
+	return client.ApplicationElem{Application: client.Application{ID: id, UpdatedAt: updatedAt}}
+}
+
+func TestMirrorRefresh(t *testing.T) {
+	tenant := &fakeTenant{responses: map[string]interface{}{
+		"/admin/api/accounts.json": client.DeveloperAccountList{Items: []client.DeveloperAccount{
+			{Element: client.DeveloperAccountItem{ID: int64Ptr(1), UpdatedAt: stringPtr("t1")}},
+			{Element: client.DeveloperAccountItem{ID: int64Ptr(2), UpdatedAt: stringPtr("t1")}},
+		}},
+		"/admin/api/services.json": client.ProductList{Products: []client.Product{
+			{Element: client.ProductItem{ID: 10, UpdatedAt: "t1"}},
+		}},
+		"/admin/api/accounts/1/applications.json": client.ApplicationList{Applications: []client.ApplicationElem{
+			application(100, "t1"), application(101, "t1"),
+		}},
+		"/admin/api/accounts/2/applications.json": client.ApplicationList{Applications: []client.ApplicationElem{
+			application(200, "t1"),
+		}},
+		"/admin/api/services/10/application_plans.json": client.ApplicationPlanJSONList{Plans: []client.ApplicationPlan{
+			{Element: client.ApplicationPlanItem{ID: 1000, UpdatedAt: "t1"}},
+		}},
+	}}
+
+	m := newTestMirror(t, tenant)
+	m.SetConcurrency(1)
+
+	stats, err := m.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, RefreshStats{
+		KindAccounts:         {Added: 2},
+		KindProducts:         {Added: 1},
+		KindApplications:     {Added: 3},
+		KindApplicationPlans: {Added: 1},
+	}, stats)
+
+	tenant.set("/admin/api/accounts/1/applications.json", client.ApplicationList{Applications: []client.ApplicationElem{
+		application(100, "t2"),
+	}})
+
+	stats, err = m.Refresh()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, RefreshStats{
+		KindAccounts:         {Unchanged: 2},
+		KindProducts:         {Unchanged: 1},
+		KindApplications:     {Updated: 1, Unchanged: 1, Deleted: 1},
+		KindApplicationPlans: {Unchanged: 1},
+	}, stats)
+
+	apps, err := m.Applications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, []client.Application{
+		{ID: 100, UpdatedAt: "t2"},
+		{ID: 200, UpdatedAt: "t1"},
+	}, apps)
+
+	accounts, err := m.Accounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 2, len(accounts))
+	equals(t, int64(1), *accounts[0].ID)
+
+	products, err := m.Products()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, []client.ProductItem{{ID: 10, UpdatedAt: "t1"}}, products)
+
+	plans, err := m.ApplicationPlans()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, []client.ApplicationPlanItem{{ID: 1000, UpdatedAt: "t1"}}, plans)
+}
+
+func TestMirrorRefreshError(t *testing.T) {
+	tenant := &fakeTenant{responses: map[string]interface{}{
+		"/admin/api/accounts.json": client.DeveloperAccountList{Items: []client.DeveloperAccount{
+			{Element: client.DeveloperAccountItem{ID: int64Ptr(1)}},
+		}},
+		"/admin/api/services.json": client.ProductList{},
+	}}
+
+	m := newTestMirror(t, tenant)
+	if _, err := m.Refresh(); !client.IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+
+	apps, err := m.Applications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 0, len(apps))
+}
+
+func TestUpdatedSince(t *testing.T) {
+	equals(t, true, updatedSince("2024-01-02T00:00:00Z", "2024-01-01T00:00:00Z"))
+	equals(t, false, updatedSince("2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z"))
+	equals(t, false, updatedSince("2023-12-31T00:00:00Z", "2024-01-01T01:00:00+01:00"))
+	equals(t, true, updatedSince("t2", "t1"))
+	equals(t, false, updatedSince("t1", "t1"))
+	equals(t, true, updatedSince("", "t1"))
+}
+
+func TestMirrorFetchAllStopsAfterError(t *testing.T) {
+	m := newTestMirror(t, &fakeTenant{responses: map[string]interface{}{}})
+	m.SetConcurrency(1)
+
+	fetchErr := errors.New("fetch failed")
+	calls := 0
+	_, err := m.fetchAll([]int64{1, 2, 3, 4}, func(int64) ([]Record, error) {
+		calls++
+		return nil, fetchErr
+	})
+	if err != fetchErr {
+		t.Fatalf("expected fetch error; got %v", err)
+	}
+	equals(t, 1, calls)
+}
+
+func equals(tb testing.TB, exp, act interface{}) {
+	tb.Helper()
+	if !reflect.DeepEqual(exp, act) {
+		tb.Fatalf("exp: %#v\n\n\tgot: %#v", exp, act)
+	}
+}
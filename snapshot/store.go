@@ -0,0 +1,231 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CompactAfter is the number of journal entries appended by a FileStore before it compacts its file
+const CompactAfter = 1000
+
+// Record is a single mirrored resource
+type Record struct {
+	ID        int64           `json:"id"`
+	UpdatedAt string          `json:"updated_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Store persists mirrored records grouped by resource kind.
+// Implementations must be safe for concurrent use.
+// Embedded databases (SQLite, bolt, ...) can be plugged in by implementing this interface
+type Store interface {
+	// Records returns the stored records of the given kind indexed by ID
+	Records(kind string) (map[int64]Record, error)
+	// Put inserts or replaces records of the given kind
+	Put(kind string, records []Record) error
+	// Delete removes records of the given kind
+	Delete(kind string, ids []int64) error
+}
+
+// journalEntry is a line of the FileStore file, records written or deleted for a kind by a single call
+type journalEntry struct {
+	Kind   string   `json:"kind"`
+	Put    []Record `json:"put,omitempty"`
+	Delete []int64  `json:"delete,omitempty"`
+}
+
+// FileStore is an embedded Store backed by an append-only journal file of JSON lines.
+// Put and Delete append and sync a single entry holding the changed records only, so refreshes
+// touching a few records write a few bytes whatever the size of the snapshot.
+// The journal is replayed and rewritten with the live records when opened, and again every CompactAfter entries.
+// A last entry left incomplete by a crash is discarded
+type FileStore struct {
+	path string
+
+	mu       sync.RWMutex
+	data     map[string]map[int64]Record
+	journal  *os.File
+	appended int
+}
+
+// NewFileStore opens the journal at path, creating an empty one when missing
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, data: map[string]map[int64]Record{}}
+
+	if err := s.replay(); err != nil {
+		return nil, err
+	}
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Records returns the stored records of the given kind indexed by ID
+func (s *FileStore) Records(kind string) (map[int64]Record, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := make(map[int64]Record, len(s.data[kind]))
+	for id, record := range s.data[kind] {
+		records[id] = record
+	}
+	return records, nil
+}
+
+// Put inserts or replaces records of the given kind
+func (s *FileStore) Put(kind string, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	return s.write(journalEntry{Kind: kind, Put: records})
+}
+
+// Delete removes records of the given kind
+func (s *FileStore) Delete(kind string, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return s.write(journalEntry{Kind: kind, Delete: ids})
+}
+
+// Close releases the journal file. The store must not be used afterwards
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.journal.Close()
+}
+
+// write appends the entry to the journal before applying it in memory
+func (s *FileStore) write(entry journalEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.journal.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if err := s.journal.Sync(); err != nil {
+		return err
+	}
+	s.apply(entry)
+
+	s.appended++
+	if s.appended < CompactAfter {
+		return nil
+	}
+	return s.compact()
+}
+
+// apply changes the in memory records. Callers must hold the write lock
+func (s *FileStore) apply(entry journalEntry) {
+	records := s.data[entry.Kind]
+	if records == nil {
+		records = map[int64]Record{}
+		s.data[entry.Kind] = records
+	}
+	for _, record := range entry.Put {
+		records[record.ID] = record
+	}
+	for _, id := range entry.Delete {
+		delete(records, id)
+	}
+}
+
+// replay loads the journal entries in order, ignoring an incomplete last entry
+func (s *FileStore) replay() error {
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// only the last entry may be missing its line end, when the process died while appending it
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var entry journalEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return err
+		}
+		s.apply(entry)
+	}
+}
+
+// compact atomically replaces the journal with an entry per kind holding its live records,
+// reopening it for appending. Callers must hold the write lock or own the store
+func (s *FileStore) compact() error {
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := bufio.NewWriter(tmp)
+	for kind, records := range s.data {
+		if len(records) == 0 {
+			continue
+		}
+		entry := journalEntry{Kind: kind, Put: make([]Record, 0, len(records))}
+		for _, record := range records {
+			entry.Put = append(entry.Put, record)
+		}
+		line, err := json.Marshal(entry)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		writer.Write(append(line, '\n'))
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	journal, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if s.journal != nil {
+		s.journal.Close()
+	}
+	s.journal = journal
+	s.appended = 0
+	return nil
+}
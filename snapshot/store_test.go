@@ -0,0 +1,130 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileStorePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := []Record{
+		{ID: 1, UpdatedAt: "t1", Data: json.RawMessage(`{"id":1}`)},
+		{ID: 2, UpdatedAt: "t1", Data: json.RawMessage(`{"id":2}`)},
+	}
+	if err := store.Put(KindProducts, records); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(KindProducts, []int64{2}); err != nil {
+		t.Fatal(err)
+	}
+
+	store.Close()
+
+	reopened, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	stored, err := reopened.Records(KindProducts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, map[int64]Record{1: records[0]}, stored)
+
+	stored, err = reopened.Records(KindAccounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, 0, len(stored))
+}
+
+func journalLines(t *testing.T, path string) []string {
+	t.Helper()
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSuffix(string(raw), "\n"), "\n")
+}
+
+func TestFileStoreAppendsChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	if err := store.Put(KindProducts, []Record{{ID: 1, UpdatedAt: "t1", Data: json.RawMessage(`{"id":1}`)}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put(KindProducts, []Record{{ID: 2, UpdatedAt: "t1", Data: json.RawMessage(`{"id":2}`)}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete(KindProducts, []int64{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, []string{
+		`{"kind":"products","put":[{"id":1,"updated_at":"t1","data":{"id":1}}]}`,
+		`{"kind":"products","put":[{"id":2,"updated_at":"t1","data":{"id":2}}]}`,
+		`{"kind":"products","delete":[1]}`,
+	}, journalLines(t, path))
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	for idx := 0; idx < CompactAfter; idx++ {
+		if err := store.Put(KindProducts, []Record{{ID: 1, UpdatedAt: "t1", Data: json.RawMessage(`{"id":1}`)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	equals(t, []string{`{"kind":"products","put":[{"id":1,"updated_at":"t1","data":{"id":1}}]}`}, journalLines(t, path))
+}
+
+func TestFileStoreIncompleteEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	journal := `{"kind":"products","put":[{"id":1,"updated_at":"t1","data":{"id":1}}]}` + "\n" + `{"kind":"products","put":[{"id":2,`
+	if err := ioutil.WriteFile(path, []byte(journal), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	stored, err := store.Records(KindProducts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, map[int64]Record{1: {ID: 1, UpdatedAt: "t1", Data: json.RawMessage(`{"id":1}`)}}, stored)
+
+	if err := store.Delete(KindProducts, []int64{1}); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, []string{
+		`{"kind":"products","put":[{"id":1,"updated_at":"t1","data":{"id":1}}]}`,
+		`{"kind":"products","delete":[1]}`,
+	}, journalLines(t, path))
+}
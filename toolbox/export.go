@@ -0,0 +1,370 @@
+// Package toolbox converts 3scale products to and from the resource formats
+// understood by the 3scale toolbox and the 3scale operator.
+package toolbox
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/3scale/3scale-porta-go-client/client"
+)
+
+// APIVersion is the apiVersion of the exported Product and Backend resources
+const APIVersion = "capabilities.3scale.net/v1beta1"
+
+const hitsSystemName = "hits"
+
+// metricRef identifies a metric or method by system name and, for backend metrics, backend system name
+type metricRef struct {
+	systemName string
+	backend    string
+}
+
+func (r metricRef) yaml() yamlMap {
+	ref := yamlMap{}
+	ref.set("systemName", r.systemName)
+	if r.backend != "" {
+		ref.set("backend", r.backend)
+	}
+	return ref
+}
+
+// metricSet holds the metrics and methods of a product or backend
+type metricSet struct {
+	metrics []client.MetricItem
+	methods []client.MethodItem
+	refs    map[int64]metricRef
+}
+
+// Export walks the proxy, metrics, methods, mapping rules, application plans, limits, pricing rules,
+// policies and backends of a product and serializes them in the 3scale toolbox product YAML format:
+// a Product resource followed by one Backend resource per backend used by the product.
+// Custom plans are skipped as they belong to a single application.
+// The output does not hold IDs nor timestamps, exporting an unchanged product twice gives the same bytes
+func Export(c *client.ThreeScaleClient, productID int64) ([]byte, error) {
+	product, err := c.Product(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy, err := c.ProductProxy(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	productMetrics, err := c.ListProductMetrics(productID)
+	if err != nil {
+		return nil, err
+	}
+	productSet, err := newMetricSet(productMetrics, "", func(hitsID int64) (*client.MethodList, error) {
+		return c.ListProductMethods(productID, hitsID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	productRules, err := c.ListProductMappingRules(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := c.ListBackendapiUsages(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// every metric limits and pricing rules may refer to, indexed by ID
+	refs := map[int64]metricRef{}
+	for id, ref := range productSet.refs {
+		refs[id] = ref
+	}
+
+	backendUsages := yamlMap{}
+	backendDocs := make([]yamlMap, 0, len(usages))
+	for _, usage := range usages {
+		backendID := usage.Element.BackendAPIID
+		backend, err := c.BackendApi(backendID)
+		if err != nil {
+			return nil, err
+		}
+
+		backendMetrics, err := c.ListBackendapiMetrics(backendID)
+		if err != nil {
+			return nil, err
+		}
+		backendSet, err := newMetricSet(backendMetrics, backend.Element.SystemName, func(hitsID int64) (*client.MethodList, error) {
+			return c.ListBackendapiMethods(backendID, hitsID)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for id, ref := range backendSet.refs {
+			refs[id] = ref
+		}
+
+		backendRules, err := c.ListBackendapiMappingRules(backendID)
+		if err != nil {
+			return nil, err
+		}
+
+		backendUsages.set(backend.Element.SystemName, yamlMap{{key: "path", value: usage.Element.Path}})
+		backendDocs = append(backendDocs, backendDocument(backend.Element, backendSet, backendRules))
+	}
+
+	plans, err := c.ListApplicationPlansByProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+	applicationPlans := yamlMap{}
+	for _, plan := range plans.Plans {
+		if plan.Element.Custom {
+			continue
+		}
+		planDoc, err := applicationPlan(c, plan.Element, refs)
+		if err != nil {
+			return nil, err
+		}
+		applicationPlans.set(plan.Element.SystemName, planDoc)
+	}
+
+	policies, err := c.Policies(productID)
+	if err != nil {
+		return nil, err
+	}
+	policyList := make([]interface{}, 0, len(policies.Policies))
+	for _, policy := range policies.Policies {
+		policyDoc := yamlMap{}
+		policyDoc.set("name", policy.Name)
+		policyDoc.set("version", policy.Version)
+		policyDoc.set("enabled", policy.Enabled)
+		if len(policy.Configuration) > 0 {
+			policyDoc.set("configuration", policy.Configuration)
+		}
+		policyList = append(policyList, policyDoc)
+	}
+
+	spec := yamlMap{}
+	spec.set("name", product.Element.Name)
+	spec.set("systemName", product.Element.SystemName)
+	if product.Element.Description != "" {
+		spec.set("description", product.Element.Description)
+	}
+	spec.set("deployment", deployment(product.Element, proxy.Element))
+	spec.set("mappingRules", mappingRules(productRules, productSet.refs))
+	spec.set("metrics", metrics(productSet))
+	spec.set("methods", methods(productSet))
+	spec.set("policies", policyList)
+	spec.set("applicationPlans", applicationPlans)
+	spec.set("backendUsages", backendUsages)
+
+	documents := append([]yamlMap{resource("Product", product.Element.SystemName, spec)}, backendDocs...)
+	return marshalYAML(documents...), nil
+}
+
+// newMetricSet splits the metric list into metrics and methods, the latter being children of the hits metric
+func newMetricSet(list *client.MetricJSONList, backend string, listMethods func(hitsID int64) (*client.MethodList, error)) (*metricSet, error) {
+	set := &metricSet{refs: map[int64]metricRef{}}
+
+	var hitsID int64
+	for _, metric := range list.Metrics {
+		if metricSystemName(metric.Element.SystemName, backend) == hitsSystemName {
+			hitsID = metric.Element.ID
+		}
+	}
+
+	methodIDs := map[int64]bool{}
+	if hitsID != 0 {
+		methodList, err := listMethods(hitsID)
+		if err != nil {
+			return nil, err
+		}
+		for _, method := range methodList.Methods {
+			method.Element.SystemName = metricSystemName(method.Element.SystemName, backend)
+			methodIDs[method.Element.ID] = true
+			set.methods = append(set.methods, method.Element)
+			set.refs[method.Element.ID] = metricRef{systemName: method.Element.SystemName, backend: backend}
+		}
+	}
+
+	for _, metric := range list.Metrics {
+		if methodIDs[metric.Element.ID] {
+			continue
+		}
+		metric.Element.SystemName = metricSystemName(metric.Element.SystemName, backend)
+		set.metrics = append(set.metrics, metric.Element)
+		set.refs[metric.Element.ID] = metricRef{systemName: metric.Element.SystemName, backend: backend}
+	}
+
+	return set, nil
+}
+
+// backendMetricSuffix matches the ".<backend id>" suffix 3scale appends to backend metric system names
+var backendMetricSuffix = regexp.MustCompile(`\.[0-9]+$`)
+
+func metricSystemName(systemName, backend string) string {
+	if backend == "" {
+		return systemName
+	}
+	return backendMetricSuffix.ReplaceAllString(systemName, "")
+}
+
+func resource(kind, systemName string, spec yamlMap) yamlMap {
+	doc := yamlMap{}
+	doc.set("apiVersion", APIVersion)
+	doc.set("kind", kind)
+	doc.set("metadata", yamlMap{{key: "name", value: resourceName(systemName)}})
+	doc.set("spec", spec)
+	return doc
+}
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// resourceName turns a system name into a valid Kubernetes resource name
+func resourceName(systemName string) string {
+	name := invalidResourceNameChars.ReplaceAllString(strings.ToLower(systemName), "-")
+	return strings.Trim(name, "-.")
+}
+
+func deployment(product client.ProductItem, proxy client.ProxyItem) yamlMap {
+	auth := yamlMap{}
+	switch product.BackendVersion {
+	case client.BackendVersionUserKey:
+		userKey := yamlMap{}
+		userKey.set("authUserKey", proxy.AuthUserKey)
+		userKey.set("credentials", proxy.CredentialsLocation)
+		auth.set("userkey", userKey)
+	case client.BackendVersionAppIDAppKey:
+		appKeyAppID := yamlMap{}
+		appKeyAppID.set("appID", proxy.AuthAppID)
+		appKeyAppID.set("appKey", proxy.AuthAppKey)
+		appKeyAppID.set("credentials", proxy.CredentialsLocation)
+		auth.set("appKeyAppID", appKeyAppID)
+	case client.BackendVersionOIDC:
+		oidc := yamlMap{}
+		oidc.set("issuerType", proxy.OidcIssuerType)
+		oidc.set("issuerEndpoint", proxy.OidcIssuerEndpoint)
+		if proxy.JwtClaimWithClientID != "" {
+			oidc.set("jwtClaimWithClientID", proxy.JwtClaimWithClientID)
+			oidc.set("jwtClaimWithClientIDType", proxy.JwtClaimWithClientIDType)
+		}
+		oidc.set("credentials", proxy.CredentialsLocation)
+		auth.set("oidc", oidc)
+	}
+
+	apicast := yamlMap{}
+	key := "apicastHosted"
+	if product.DeploymentOption == client.DeploymentOptionSelfManaged {
+		key = "apicastSelfManaged"
+		apicast.set("stagingPublicBaseURL", proxy.SandboxEndpoint)
+		apicast.set("productionPublicBaseURL", proxy.Endpoint)
+	}
+	apicast.set("authentication", auth)
+
+	return yamlMap{{key: key, value: apicast}}
+}
+
+func mappingRules(list *client.MappingRuleJSONList, refs map[int64]metricRef) []interface{} {
+	rules := make([]interface{}, 0, len(list.MappingRules))
+	for _, rule := range list.MappingRules {
+		doc := yamlMap{}
+		doc.set("httpMethod", rule.Element.HTTPMethod)
+		doc.set("pattern", rule.Element.Pattern)
+		doc.set("metricMethodRef", refs[rule.Element.MetricID].systemName)
+		doc.set("increment", rule.Element.Delta)
+		doc.set("last", rule.Element.Last)
+		rules = append(rules, doc)
+	}
+	return rules
+}
+
+func metrics(set *metricSet) yamlMap {
+	doc := yamlMap{}
+	for _, metric := range set.metrics {
+		item := yamlMap{}
+		item.set("friendlyName", metric.Name)
+		item.set("unit", metric.Unit)
+		if metric.Description != "" {
+			item.set("description", metric.Description)
+		}
+		doc.set(metric.SystemName, item)
+	}
+	return doc
+}
+
+func methods(set *metricSet) yamlMap {
+	doc := yamlMap{}
+	for _, method := range set.methods {
+		item := yamlMap{}
+		item.set("friendlyName", method.Name)
+		if method.Description != "" {
+			item.set("description", method.Description)
+		}
+		doc.set(method.SystemName, item)
+	}
+	return doc
+}
+
+func backendDocument(backend client.BackendApiItem, set *metricSet, rules *client.MappingRuleJSONList) yamlMap {
+	spec := yamlMap{}
+	spec.set("name", backend.Name)
+	spec.set("systemName", backend.SystemName)
+	spec.set("privateBaseURL", backend.PrivateEndpoint)
+	if backend.Description != "" {
+		spec.set("description", backend.Description)
+	}
+	spec.set("mappingRules", mappingRules(rules, set.refs))
+	spec.set("metrics", metrics(set))
+	spec.set("methods", methods(set))
+	return resource("Backend", backend.SystemName, spec)
+}
+
+func applicationPlan(c *client.ThreeScaleClient, plan client.ApplicationPlanItem, refs map[int64]metricRef) (yamlMap, error) {
+	limitList, err := c.ListApplicationPlansLimits(plan.ID)
+	if err != nil {
+		return nil, err
+	}
+	limits := make([]interface{}, 0, len(limitList.Limits))
+	for _, limit := range limitList.Limits {
+		doc := yamlMap{}
+		doc.set("period", limit.Element.Period)
+		doc.set("value", limit.Element.Value)
+		doc.set("metricMethodRef", refs[limit.Element.MetricID].yaml())
+		limits = append(limits, doc)
+	}
+
+	ruleList, err := c.ListApplicationPlansPricingRules(plan.ID)
+	if err != nil {
+		return nil, err
+	}
+	pricingRules := make([]interface{}, 0, len(ruleList.Rules))
+	for _, rule := range ruleList.Rules {
+		doc := yamlMap{}
+		doc.set("from", rule.Element.Min)
+		doc.set("to", rule.Element.Max)
+		doc.set("pricePerUnit", price(rule.Element.CostPerUnit))
+		doc.set("metricMethodRef", refs[rule.Element.MetricID].yaml())
+		pricingRules = append(pricingRules, doc)
+	}
+
+	doc := yamlMap{}
+	doc.set("name", plan.Name)
+	doc.set("appsRequireApproval", plan.ApprovalRequired)
+	doc.set("trialPeriod", plan.TrialPeriodDays)
+	doc.set("setupFee", fmt.Sprintf("%.2f", plan.SetupFee))
+	doc.set("costMonth", fmt.Sprintf("%.2f", plan.CostPerMonth))
+	doc.set("published", plan.State == "published")
+	doc.set("pricingRules", pricingRules)
+	doc.set("limits", limits)
+	return doc, nil
+}
+
+// price normalizes a 3scale decimal amount to the two decimal digits the toolbox format expects
+func price(amount string) string {
+	value, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return amount
+	}
+	return fmt.Sprintf("%.2f", value)
+}
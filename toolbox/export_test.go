@@ -0,0 +1,271 @@
+package toolbox
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/client"
+)
+
+type fakeTenant struct {
+	mu        sync.Mutex
+	responses map[string]interface{}
+	// writes holds the responses to non GET requests, indexed by "METHOD path"
+	writes map[string]interface{}
+	// requests records the non GET requests as "METHOD path body"
+	requests []string
+}
+
+func (f *fakeTenant) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status := http.StatusOK
+	obj, ok := f.responses[req.URL.Path]
+	if req.Method != http.MethodGet {
+		body, _ := ioutil.ReadAll(req.Body)
+		f.requests = append(f.requests, req.Method+" "+req.URL.Path+" "+string(body))
+		obj, ok = f.writes[req.Method+" "+req.URL.Path]
+		if req.Method == http.MethodPost {
+			status = http.StatusCreated
+		}
+	}
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(obj)
+}
+
+func newTestClient(t *testing.T, tenant *fakeTenant) *client.ThreeScaleClient {
+	t.Helper()
+	server := httptest.NewServer(tenant)
+	t.Cleanup(server.Close)
+
+	ap, err := client.NewAdminPortalFromStr(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.NewThreeScale(ap, "someAccessToken", server.Client())
+}
+
+func metric(id int64, name, systemName, unit string) client.MetricJSON {
+	return client.MetricJSON{Element: client.MetricItem{ID: id, Name: name, SystemName: systemName, Unit: unit, UpdatedAt: "t1"}}
+}
+
+func method(id int64, name, systemName string) client.Method {
+	return client.Method{Element: client.MethodItem{ID: id, Name: name, SystemName: systemName, UpdatedAt: "t1"}}
+}
+
+func mappingRule(metricID int64, httpMethod, pattern string, delta int, last bool) client.MappingRuleJSON {
+	return client.MappingRuleJSON{Element: client.MappingRuleItem{MetricID: metricID, HTTPMethod: httpMethod, Pattern: pattern, Delta: delta, Last: last}}
+}
+
+func exportTenant() *fakeTenant {
+	return &fakeTenant{responses: map[string]interface{}{
+		"/admin/api/services/1.json": client.Product{Element: client.ProductItem{
+			ID: 1, Name: "Pet Store", SystemName: "pet_store", Description: "Pets: cats & dogs",
+			DeploymentOption: "self_managed", BackendVersion: "2", UpdatedAt: "t1",
+		}},
+		"/admin/api/services/1/proxy.json": client.ProxyJSON{Element: client.ProxyItem{
+			Endpoint: "https://api.example.com:443", SandboxEndpoint: "https://staging.example.com:443",
+			CredentialsLocation: "headers", AuthAppID: "app_id", AuthAppKey: "app_key",
+		}},
+		"/admin/api/services/1/metrics.json": client.MetricJSONList{Metrics: []client.MetricJSON{
+			metric(10, "Hits", "hits", "hit"),
+			metric(11, "List pets", "list_pets", "hit"),
+			metric(12, "Bandwidth", "bandwidth", "kb"),
+		}},
+		"/admin/api/services/1/metrics/10/methods.json": client.MethodList{Methods: []client.Method{
+			method(11, "List pets", "list_pets"),
+		}},
+		"/admin/api/services/1/proxy/mapping_rules.json": client.MappingRuleJSONList{MappingRules: []client.MappingRuleJSON{
+			mappingRule(11, "GET", "/pets$", 1, true),
+		}},
+		"/admin/api/services/1/backend_usages.json": client.BackendAPIUsageList{
+			{Element: client.BackendAPIUsageItem{ID: 5, Path: "/", ProductID: 1, BackendAPIID: 2}},
+		},
+		"/admin/api/backend_apis/2.json": client.BackendApi{Element: client.BackendApiItem{
+			ID: 2, Name: "Pets backend", SystemName: "pets_backend", PrivateEndpoint: "https://pets.internal:443",
+		}},
+		"/admin/api/backend_apis/2/metrics.json": client.MetricJSONList{Metrics: []client.MetricJSON{
+			metric(20, "Hits", "hits.2", "hit"),
+			metric(21, "Create pet", "create_pet.2", "hit"),
+		}},
+		"/admin/api/backend_apis/2/metrics/20/methods.json": client.MethodList{Methods: []client.Method{
+			method(21, "Create pet", "create_pet.2"),
+		}},
+		"/admin/api/backend_apis/2/mapping_rules.json": client.MappingRuleJSONList{MappingRules: []client.MappingRuleJSON{
+			mappingRule(21, "POST", "/pets", 2, false),
+		}},
+		"/admin/api/services/1/application_plans.json": client.ApplicationPlanJSONList{Plans: []client.ApplicationPlan{
+			{Element: client.ApplicationPlanItem{ID: 100, Name: "Basic", SystemName: "basic", State: "published", SetupFee: 1.5, TrialPeriodDays: 7}},
+			{Element: client.ApplicationPlanItem{ID: 101, Name: "Custom", SystemName: "custom", Custom: true}},
+		}},
+		"/admin/api/application_plans/100/limits.json": client.ApplicationPlanLimitList{Limits: []client.ApplicationPlanLimit{
+			{Element: client.ApplicationPlanLimitItem{Period: "day", Value: 1000, MetricID: 10}},
+			{Element: client.ApplicationPlanLimitItem{Period: "minute", Value: 5, MetricID: 21}},
+		}},
+		"/admin/api/application_plans/100/pricing_rules.json": client.ApplicationPlanPricingRuleList{Rules: []client.ApplicationPlanPricingRule{
+			{Element: client.ApplicationPlanPricingRuleItem{MetricID: 12, CostPerUnit: "0.5", Min: 1, Max: 100}},
+		}},
+		"/admin/api/services/1/proxy/policies.json": client.PoliciesConfigList{Policies: []client.PolicyConfig{
+			{Name: "headers", Version: "builtin", Enabled: true, Configuration: map[string]interface{}{
+				"response": []interface{}{map[string]interface{}{"op": "set", "header": "X-Env", "value": "prod"}},
+			}},
+			{Name: "apicast", Version: "builtin", Enabled: true, Configuration: map[string]interface{}{}},
+		}},
+	}}
+}
+
+const expectedExport = `apiVersion: capabilities.3scale.net/v1beta1
+kind: Product
+metadata:
+  name: pet-store
+spec:
+  name: Pet Store
+  systemName: pet_store
+  description: "Pets: cats & dogs"
+  deployment:
+    apicastSelfManaged:
+      stagingPublicBaseURL: https://staging.example.com:443
+      productionPublicBaseURL: https://api.example.com:443
+      authentication:
+        appKeyAppID:
+          appID: app_id
+          appKey: app_key
+          credentials: headers
+  mappingRules:
+  - httpMethod: GET
+    pattern: /pets$
+    metricMethodRef: list_pets
+    increment: 1
+    last: true
+  metrics:
+    hits:
+      friendlyName: Hits
+      unit: hit
+    bandwidth:
+      friendlyName: Bandwidth
+      unit: kb
+  methods:
+    list_pets:
+      friendlyName: List pets
+  policies:
+  - name: headers
+    version: builtin
+    enabled: true
+    configuration:
+      response:
+      - header: X-Env
+        op: set
+        value: prod
+  - name: apicast
+    version: builtin
+    enabled: true
+  applicationPlans:
+    basic:
+      name: Basic
+      appsRequireApproval: false
+      trialPeriod: 7
+      setupFee: "1.50"
+      costMonth: "0.00"
+      published: true
+      pricingRules:
+      - from: 1
+        to: 100
+        pricePerUnit: "0.50"
+        metricMethodRef:
+          systemName: bandwidth
+      limits:
+      - period: day
+        value: 1000
+        metricMethodRef:
+          systemName: hits
+      - period: minute
+        value: 5
+        metricMethodRef:
+          systemName: create_pet
+          backend: pets_backend
+  backendUsages:
+    pets_backend:
+      path: /
+---
+apiVersion: capabilities.3scale.net/v1beta1
+kind: Backend
+metadata:
+  name: pets-backend
+spec:
+  name: Pets backend
+  systemName: pets_backend
+  privateBaseURL: https://pets.internal:443
+  mappingRules:
+  - httpMethod: POST
+    pattern: /pets
+    metricMethodRef: create_pet
+    increment: 2
+    last: false
+  metrics:
+    hits:
+      friendlyName: Hits
+      unit: hit
+  methods:
+    create_pet:
+      friendlyName: Create pet
+`
+
+func TestExport(t *testing.T) {
+	c := newTestClient(t, exportTenant())
+
+	out, err := Export(c, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, expectedExport, string(out))
+
+	again, err := Export(c, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, string(out), string(again))
+}
+
+func TestExportUserKeyHosted(t *testing.T) {
+	tenant := exportTenant()
+	tenant.responses["/admin/api/services/1.json"] = client.Product{Element: client.ProductItem{
+		ID: 1, Name: "Pet Store", SystemName: "pet_store", DeploymentOption: "hosted", BackendVersion: "1",
+	}}
+	tenant.responses["/admin/api/services/1/proxy.json"] = client.ProxyJSON{Element: client.ProxyItem{
+		CredentialsLocation: "query", AuthUserKey: "user_key",
+	}}
+
+	out, err := Export(newTestClient(t, tenant), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hosted := `  deployment:
+    apicastHosted:
+      authentication:
+        userkey:
+          authUserKey: user_key
+          credentials: query
+  mappingRules:
+`
+	if !strings.Contains(string(out), hosted) {
+		t.Fatalf("expected hosted user key deployment; got:\n%s", out)
+	}
+}
+
+func TestExportProductNotFound(t *testing.T) {
+	_, err := Export(newTestClient(t, &fakeTenant{responses: map[string]interface{}{}}), 1)
+	if !client.IsNotFound(err) {
+		t.Fatalf("expected not found error; got %v", err)
+	}
+}
@@ -0,0 +1,285 @@
+package toolbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/3scale/3scale-porta-go-client/client"
+)
+
+// openAPIOperationVerbs are the path item fields holding operations, in import order
+var openAPIOperationVerbs = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// OpenAPIImportOptions tunes ImportOpenAPI
+type OpenAPIImportOptions struct {
+	// PrefixMatching creates mapping rules matching any path starting with the operation path,
+	// instead of the operation path only
+	PrefixMatching bool
+
+	// ActiveDocsHidden creates the ActiveDocs entry unpublished
+	ActiveDocsHidden bool
+
+	// SkipValidation disables the OpenAPI validation 3scale runs on the ActiveDocs entry
+	SkipValidation bool
+}
+
+// OpenAPIImportResult holds the changes applied by ImportOpenAPI
+type OpenAPIImportResult struct {
+	MethodsCreated      int
+	MethodsUpdated      int
+	MappingRulesCreated int
+	ActiveDocID         int64
+	ActiveDocCreated    bool
+}
+
+type openAPIDocument struct {
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Servers []struct {
+		URL string `json:"url"`
+	} `json:"servers"`
+	Paths map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+type openAPIOperation struct {
+	OperationID string `json:"operationId"`
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+}
+
+// importedOperation is an OpenAPI operation mapped to a 3scale method and mapping rule
+type importedOperation struct {
+	httpMethod   string
+	pattern      string
+	friendlyName string
+	systemName   string
+	description  string
+}
+
+// ImportOpenAPI creates or updates, from the operations of an OpenAPI 3 document in JSON format,
+// the methods and mapping rules of a product, and creates or updates the product ActiveDocs entry.
+// Like toolbox "import openapi", every operation becomes a method named after its operationId,
+// mapped by a rule on the operation path prefixed by the path of the first server URL.
+// Methods are matched by system name and mapping rules by HTTP method, pattern and metric,
+// existing mapping rules are never deleted so importing the same document twice is a no-op
+func ImportOpenAPI(c *client.ThreeScaleClient, productID int64, spec []byte, opts OpenAPIImportOptions) (*OpenAPIImportResult, error) {
+	doc := &openAPIDocument{}
+	if err := json.Unmarshal(spec, doc); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q, expected 3.x", doc.OpenAPI)
+	}
+
+	operations, err := doc.operations(opts.PrefixMatching)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := c.Product(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := c.ListProductMetrics(productID)
+	if err != nil {
+		return nil, err
+	}
+	var hitsID int64
+	for _, metric := range metrics.Metrics {
+		if metric.Element.SystemName == hitsSystemName {
+			hitsID = metric.Element.ID
+		}
+	}
+	if hitsID == 0 {
+		return nil, errors.New("product has no hits metric")
+	}
+
+	methods, err := c.ListProductMethods(productID, hitsID)
+	if err != nil {
+		return nil, err
+	}
+	methodsBySystemName := map[string]client.MethodItem{}
+	for _, method := range methods.Methods {
+		methodsBySystemName[method.Element.SystemName] = method.Element
+	}
+
+	rules, err := c.ListProductMappingRules(productID)
+	if err != nil {
+		return nil, err
+	}
+	existingRules := map[string]bool{}
+	for _, rule := range rules.MappingRules {
+		existingRules[mappingRuleKey(rule.Element.HTTPMethod, rule.Element.Pattern, rule.Element.MetricID)] = true
+	}
+
+	result := &OpenAPIImportResult{}
+	for _, op := range operations {
+		method, ok := methodsBySystemName[op.systemName]
+		switch {
+		case !ok:
+			created, err := c.CreateProductMethod(productID, hitsID, client.Params{
+				"friendly_name": op.friendlyName,
+				"system_name":   op.systemName,
+				"description":   op.description,
+			})
+			if err != nil {
+				return nil, err
+			}
+			method = created.Element
+			methodsBySystemName[op.systemName] = method
+			result.MethodsCreated++
+		case method.Name != op.friendlyName || method.Description != op.description:
+			if _, err := c.UpdateProductMethod(productID, hitsID, method.ID, client.Params{
+				"friendly_name": op.friendlyName,
+				"description":   op.description,
+			}); err != nil {
+				return nil, err
+			}
+			result.MethodsUpdated++
+		}
+
+		key := mappingRuleKey(op.httpMethod, op.pattern, method.ID)
+		if existingRules[key] {
+			continue
+		}
+		if _, err := c.CreateProductMappingRule(productID, client.Params{
+			"http_method": op.httpMethod,
+			"pattern":     op.pattern,
+			"metric_id":   strconv.FormatInt(method.ID, 10),
+			"delta":       "1",
+		}); err != nil {
+			return nil, err
+		}
+		existingRules[key] = true
+		result.MappingRulesCreated++
+	}
+
+	activeDoc, created, err := importActiveDoc(c, product.Element, doc, spec, opts)
+	if err != nil {
+		return nil, err
+	}
+	if activeDoc.Element.ID != nil {
+		result.ActiveDocID = *activeDoc.Element.ID
+	}
+	result.ActiveDocCreated = created
+
+	return result, nil
+}
+
+// importActiveDoc updates the ActiveDocs entry named after the product system name, creating it when missing
+func importActiveDoc(c *client.ThreeScaleClient, product client.ProductItem, doc *openAPIDocument, spec []byte, opts OpenAPIImportOptions) (*client.ActiveDoc, bool, error) {
+	list, err := c.ListActiveDocs()
+	if err != nil {
+		return nil, false, err
+	}
+
+	body := string(spec)
+	serviceID := product.ID
+	skipValidation := opts.SkipValidation
+
+	for _, existing := range list.ActiveDocs {
+		if existing.Element.SystemName == nil || *existing.Element.SystemName != product.SystemName {
+			continue
+		}
+		updated, err := c.UpdateActiveDoc(&client.ActiveDoc{Element: client.ActiveDocItem{
+			ID:                     existing.Element.ID,
+			Body:                   &body,
+			ServiceID:              &serviceID,
+			SkipSwaggerValidations: &skipValidation,
+		}})
+		return updated, false, err
+	}
+
+	name := doc.Info.Title
+	if name == "" {
+		name = product.Name
+	}
+	systemName := product.SystemName
+	description := doc.Info.Description
+	published := !opts.ActiveDocsHidden
+	created, err := c.CreateActiveDoc(&client.ActiveDoc{Element: client.ActiveDocItem{
+		Name:                   &name,
+		SystemName:             &systemName,
+		Description:            &description,
+		Published:              &published,
+		SkipSwaggerValidations: &skipValidation,
+		Body:                   &body,
+		ServiceID:              &serviceID,
+	}})
+	return created, true, err
+}
+
+// operations returns the document operations sorted by path then verb
+func (doc *openAPIDocument) operations(prefixMatching bool) ([]importedOperation, error) {
+	basePath := ""
+	if len(doc.Servers) > 0 {
+		serverURL, err := url.Parse(doc.Servers[0].URL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing OpenAPI server URL: %w", err)
+		}
+		basePath = strings.TrimSuffix(serverURL.Path, "/")
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var operations []importedOperation
+	for _, path := range paths {
+		for _, verb := range openAPIOperationVerbs {
+			raw, ok := doc.Paths[path][verb]
+			if !ok {
+				continue
+			}
+			op := &openAPIOperation{}
+			if err := json.Unmarshal(raw, op); err != nil {
+				return nil, fmt.Errorf("parsing OpenAPI operation %s %s: %w", verb, path, err)
+			}
+
+			friendlyName := op.OperationID
+			if friendlyName == "" {
+				friendlyName = verb + path
+			}
+			description := op.Description
+			if description == "" {
+				description = op.Summary
+			}
+			pattern := basePath + path
+			if !prefixMatching {
+				pattern += "$"
+			}
+
+			operations = append(operations, importedOperation{
+				httpMethod:   strings.ToUpper(verb),
+				pattern:      pattern,
+				friendlyName: friendlyName,
+				systemName:   methodSystemName(friendlyName),
+				description:  description,
+			})
+		}
+	}
+	return operations, nil
+}
+
+var invalidSystemNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// methodSystemName turns an operation ID into a 3scale system name, like toolbox does
+func methodSystemName(name string) string {
+	return strings.Trim(invalidSystemNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+func mappingRuleKey(httpMethod, pattern string, metricID int64) string {
+	return fmt.Sprintf("%s %s %d", httpMethod, pattern, metricID)
+}
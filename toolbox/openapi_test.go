@@ -0,0 +1,117 @@
+package toolbox
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/3scale/3scale-porta-go-client/client"
+)
+
+const petStoreOpenAPI = `{
+  "openapi": "3.0.2",
+  "info": {"title": "Pet Store API", "description": "All the pets", "version": "1.0.0"},
+  "servers": [{"url": "https://petstore.example.com/v1/"}],
+  "paths": {
+    "/pets": {
+      "get": {"operationId": "listPets", "summary": "List all pets"},
+      "post": {"operationId": "createPet", "description": "Create a pet"}
+    },
+    "/pets/{petId}": {
+      "get": {"summary": "Info for a specific pet"}
+    }
+  }
+}`
+
+func openAPITenant() *fakeTenant {
+	return &fakeTenant{
+		responses: map[string]interface{}{
+			"/admin/api/services/1.json": client.Product{Element: client.ProductItem{ID: 1, Name: "Pet Store", SystemName: "pet_store"}},
+			"/admin/api/services/1/metrics.json": client.MetricJSONList{Metrics: []client.MetricJSON{
+				metric(10, "Hits", "hits", "hit"),
+				metric(11, "listPets", "listpets", "hit"),
+			}},
+			"/admin/api/services/1/metrics/10/methods.json": client.MethodList{Methods: []client.Method{
+				method(11, "listPets", "listpets"),
+			}},
+			"/admin/api/services/1/proxy/mapping_rules.json": client.MappingRuleJSONList{MappingRules: []client.MappingRuleJSON{
+				mappingRule(11, "GET", "/v1/pets$", 1, false),
+			}},
+			"/admin/api/active_docs.json": client.ActiveDocList{},
+		},
+		writes: map[string]interface{}{
+			"POST /admin/api/services/1/metrics/10/methods.json":   method(12, "createPet", "createpet"),
+			"PUT /admin/api/services/1/metrics/10/methods/11.json": method(11, "listPets", "listpets"),
+			"POST /admin/api/services/1/proxy/mapping_rules.json":  mappingRule(12, "POST", "/v1/pets$", 1, false),
+			"POST /admin/api/active_docs.json":                     client.ActiveDoc{Element: client.ActiveDocItem{ID: int64Ptr(7)}},
+			"PUT /admin/api/active_docs/7.json":                    client.ActiveDoc{Element: client.ActiveDocItem{ID: int64Ptr(7)}},
+		},
+	}
+}
+
+func int64Ptr(v int64) *int64    { return &v }
+func stringPtr(v string) *string { return &v }
+
+func TestImportOpenAPI(t *testing.T) {
+	tenant := openAPITenant()
+
+	result, err := ImportOpenAPI(newTestClient(t, tenant), 1, []byte(petStoreOpenAPI), OpenAPIImportOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, &OpenAPIImportResult{
+		MethodsCreated:      2,
+		MethodsUpdated:      1,
+		MappingRulesCreated: 2,
+		ActiveDocID:         7,
+		ActiveDocCreated:    true,
+	}, result)
+
+	equals(t, 6, len(tenant.requests))
+	equals(t, []string{
+		"PUT /admin/api/services/1/metrics/10/methods/11.json description=List+all+pets&friendly_name=listPets",
+		"POST /admin/api/services/1/metrics/10/methods.json description=Create+a+pet&friendly_name=createPet&system_name=createpet",
+		"POST /admin/api/services/1/proxy/mapping_rules.json delta=1&http_method=POST&metric_id=12&pattern=%2Fv1%2Fpets%24",
+		"POST /admin/api/services/1/metrics/10/methods.json description=Info+for+a+specific+pet&friendly_name=get%2Fpets%2F%7BpetId%7D&system_name=get_pets_petid",
+		"POST /admin/api/services/1/proxy/mapping_rules.json delta=1&http_method=GET&metric_id=12&pattern=%2Fv1%2Fpets%2F%7BpetId%7D%24",
+	}, tenant.requests[:5])
+	if !strings.HasPrefix(tenant.requests[5], "POST /admin/api/active_docs.json ") ||
+		!strings.Contains(tenant.requests[5], `"system_name":"pet_store"`) ||
+		!strings.Contains(tenant.requests[5], `"published":true`) ||
+		!strings.Contains(tenant.requests[5], `"service_id":1`) {
+		t.Fatalf("unexpected active docs request %s", tenant.requests[5])
+	}
+}
+
+func TestImportOpenAPIUpdatesActiveDoc(t *testing.T) {
+	tenant := openAPITenant()
+	tenant.responses["/admin/api/active_docs.json"] = client.ActiveDocList{ActiveDocs: []client.ActiveDoc{
+		{Element: client.ActiveDocItem{ID: int64Ptr(3), SystemName: stringPtr("other")}},
+		{Element: client.ActiveDocItem{ID: int64Ptr(7), SystemName: stringPtr("pet_store")}},
+	}}
+
+	result, err := ImportOpenAPI(newTestClient(t, tenant), 1, []byte(petStoreOpenAPI), OpenAPIImportOptions{PrefixMatching: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, int64(7), result.ActiveDocID)
+	equals(t, false, result.ActiveDocCreated)
+	// the existing rule is exact match, prefix matching creates a new one
+	equals(t, 3, result.MappingRulesCreated)
+
+	last := tenant.requests[len(tenant.requests)-1]
+	if !strings.HasPrefix(last, "PUT /admin/api/active_docs/7.json ") {
+		t.Fatalf("expected active docs update; got %s", last)
+	}
+}
+
+func TestImportOpenAPIInvalidDocument(t *testing.T) {
+	c := newTestClient(t, openAPITenant())
+
+	if _, err := ImportOpenAPI(c, 1, []byte("openapi: 3.0.0"), OpenAPIImportOptions{}); err == nil || !strings.Contains(err.Error(), "parsing OpenAPI document") {
+		t.Fatalf("expected parsing error; got %v", err)
+	}
+
+	if _, err := ImportOpenAPI(c, 1, []byte(`{"swagger": "2.0"}`), OpenAPIImportOptions{}); err == nil || !strings.Contains(err.Error(), "unsupported OpenAPI version") {
+		t.Fatalf("expected version error; got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package toolbox
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// yamlMap is a YAML mapping keeping its keys in insertion order
+type yamlMap []yamlEntry
+
+type yamlEntry struct {
+	key   string
+	value interface{}
+}
+
+// set appends key unless value is nil
+func (m *yamlMap) set(key string, value interface{}) {
+	if value == nil {
+		return
+	}
+	*m = append(*m, yamlEntry{key: key, value: value})
+}
+
+// marshalYAML encodes the documents, in block style, as a YAML stream
+func marshalYAML(documents ...yamlMap) []byte {
+	buf := &bytes.Buffer{}
+	for idx, doc := range documents {
+		if idx > 0 {
+			buf.WriteString("---\n")
+		}
+		writeYAMLMap(buf, doc, 0)
+	}
+	return buf.Bytes()
+}
+
+func writeYAMLMap(buf *bytes.Buffer, m yamlMap, indent int) {
+	for idx, entry := range m {
+		if idx > 0 {
+			buf.WriteString(strings.Repeat(" ", indent))
+		}
+		buf.WriteString(yamlScalar(entry.key))
+		buf.WriteString(":")
+		writeYAMLValue(buf, entry.value, indent, true)
+	}
+}
+
+func writeYAMLList(buf *bytes.Buffer, list []interface{}, indent int) {
+	for idx, item := range list {
+		if idx > 0 {
+			buf.WriteString(strings.Repeat(" ", indent))
+		}
+		buf.WriteString("-")
+		writeYAMLValue(buf, item, indent, false)
+	}
+}
+
+// writeYAMLValue writes value following a "key:" or "-" indicator at indent.
+// Lists nested in mappings are not indented further, the block sequence indicator counting as indentation
+func writeYAMLValue(buf *bytes.Buffer, value interface{}, indent int, inMap bool) {
+	switch v := normalizeYAML(value).(type) {
+	case yamlMap:
+		if len(v) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		if inMap {
+			buf.WriteString("\n")
+			buf.WriteString(strings.Repeat(" ", indent+2))
+		} else {
+			buf.WriteString(" ")
+		}
+		writeYAMLMap(buf, v, indent+2)
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		if inMap {
+			buf.WriteString("\n")
+			buf.WriteString(strings.Repeat(" ", indent))
+			writeYAMLList(buf, v, indent)
+		} else {
+			buf.WriteString(" ")
+			writeYAMLList(buf, v, indent+2)
+		}
+	default:
+		buf.WriteString(" ")
+		buf.WriteString(yamlScalarValue(v))
+		buf.WriteString("\n")
+	}
+}
+
+// normalizeYAML converts decoded JSON values, i.e. policy configurations, to yamlMap and []interface{}
+func normalizeYAML(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		m := make(yamlMap, 0, len(v))
+		for _, key := range keys {
+			m = append(m, yamlEntry{key: key, value: v[key]})
+		}
+		return m
+	case []yamlMap:
+		list := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			list = append(list, item)
+		}
+		return list
+	}
+	return value
+}
+
+func yamlScalarValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return yamlScalar(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		formatted := strconv.FormatFloat(v, 'f', -1, 64)
+		if !strings.ContainsAny(formatted, ".eE") {
+			formatted += ".0"
+		}
+		return formatted
+	}
+	return yamlScalar(fmt.Sprint(value))
+}
+
+var (
+	yamlReservedWord = regexp.MustCompile(`^(?i:true|false|yes|no|on|off|y|n|null|~)$`)
+	yamlNumber       = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9][0-9_]*(\.[0-9]*)?)([eE][-+]?[0-9]+)?$|^0[xob]`)
+)
+
+// yamlScalar returns the string plain when it reads back as the same string, double quoted otherwise
+func yamlScalar(s string) string {
+	if s == "" || yamlReservedWord.MatchString(s) || yamlNumber.MatchString(s) ||
+		strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@` \t") ||
+		strings.HasSuffix(s, " ") || strings.HasSuffix(s, ":") ||
+		strings.Contains(s, ": ") || strings.Contains(s, " #") ||
+		strings.IndexFunc(s, func(r rune) bool { return r < ' ' || r == 0x7f }) >= 0 {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+package toolbox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func equals(tb testing.TB, exp, act interface{}) {
+	tb.Helper()
+	if !reflect.DeepEqual(exp, act) {
+		tb.Fatalf("exp: %#v\n\n\tgot: %#v", exp, act)
+	}
+}
+
+func TestYAMLScalar(t *testing.T) {
+	for input, expected := range map[string]string{
+		"plain":           "plain",
+		"/v1/{id}":        "/v1/{id}",
+		"":                `""`,
+		"true":            `"true"`,
+		"No":              `"No"`,
+		"null":            `"null"`,
+		"42":              `"42"`,
+		"1.5":             `"1.5"`,
+		"0x1F":            `"0x1F"`,
+		"key: value":      `"key: value"`,
+		"value #comment":  `"value #comment"`,
+		"*anchor":         `"*anchor"`,
+		"- item":          `"- item"`,
+		"trailing ":       `"trailing "`,
+		"line\nbreak":     `"line\nbreak"`,
+		`say "hi"`:        `say "hi"`,
+		"http://host:80/": "http://host:80/",
+	} {
+		equals(t, expected, yamlScalar(input))
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	doc := yamlMap{}
+	doc.set("name", "api")
+	doc.set("skipped", nil)
+	doc.set("count", 3)
+	doc.set("ratio", float64(2))
+	doc.set("empty", yamlMap{})
+	doc.set("none", []interface{}{})
+	doc.set("nested", yamlMap{{key: "enabled", value: true}})
+	doc.set("list", []interface{}{
+		yamlMap{{key: "a", value: 1}, {key: "b", value: []interface{}{"x", "y"}}},
+		"scalar",
+	})
+	doc.set("config", map[string]interface{}{"z": "last", "a": []interface{}{map[string]interface{}{"k": "v"}}})
+
+	second := yamlMap{{key: "kind", value: "Backend"}}
+
+	equals(t, `name: api
+count: 3
+ratio: 2.0
+empty: {}
+none: []
+nested:
+  enabled: true
+list:
+- a: 1
+  b:
+  - x
+  - "y"
+- scalar
+config:
+  a:
+  - k: v
+  z: last
+---
+kind: Backend
+`, string(marshalYAML(doc, second)))
+}
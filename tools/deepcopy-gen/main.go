@@ -0,0 +1,316 @@
+// deepcopy-gen generates DeepCopy and DeepCopyInto methods for the exported types
+// declared in the given Go source files.
+//
+// Types annotated with a "+deepcopy-gen=false" comment are skipped.
+//
+// Usage:
+//
+//	deepcopy-gen -output zz_generated.deepcopy.go types.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const skipMarker = "+deepcopy-gen=false"
+
+// valueSelectors are imported types safe to copy by value
+var valueSelectors = map[string]bool{
+	"time.Time":     true,
+	"time.Duration": true,
+	"xml.Name":      true,
+}
+
+// byteSliceSelectors are imported types whose underlying type is []byte
+var byteSliceSelectors = map[string]bool{
+	"json.RawMessage": true,
+}
+
+type generator struct {
+	fset     *token.FileSet
+	types    map[string]*ast.TypeSpec
+	needDeep map[string]bool
+	imports  map[string]bool
+}
+
+func main() {
+	output := flag.String("output", "zz_generated.deepcopy.go", "output file")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		log.Fatal("no input files")
+	}
+
+	g := &generator{
+		fset:     token.NewFileSet(),
+		types:    map[string]*ast.TypeSpec{},
+		needDeep: map[string]bool{},
+		imports:  map[string]bool{},
+	}
+
+	var pkgName string
+	var names []string
+	for _, path := range flag.Args() {
+		file, err := parser.ParseFile(g.fset, path, nil, parser.ParseComments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pkgName = file.Name.Name
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				g.types[typeSpec.Name.Name] = typeSpec
+				if !typeSpec.Name.IsExported() || skipped(genDecl, typeSpec) {
+					continue
+				}
+				names = append(names, typeSpec.Name.Name)
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	var body bytes.Buffer
+	for _, name := range names {
+		if err := g.generate(&body, g.types[name]); err != nil {
+			log.Fatalf("type %s: %v", name, err)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by deepcopy-gen. DO NOT EDIT.\n\npackage %s\n\n", pkgName)
+	if len(g.imports) > 0 {
+		var imports []string
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		fmt.Fprintf(&out, "import (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		fmt.Fprintf(&out, ")\n\n")
+	}
+	out.Write(body.Bytes())
+	out.WriteString(deepCopyJSONValueFunc)
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, out.String())
+	}
+
+	if err := ioutil.WriteFile(*output, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Fprintf(os.Stderr, "deepcopy-gen: generated %d types into %s\n", len(names), *output)
+}
+
+func skipped(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec) bool {
+	for _, doc := range []*ast.CommentGroup{genDecl.Doc, typeSpec.Doc} {
+		if doc != nil && strings.Contains(doc.Text(), skipMarker) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, g.fset, expr)
+	return buf.String()
+}
+
+func isBasic(name string) bool {
+	switch name {
+	case "bool", "string", "byte", "rune", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+// needsDeepCopy reports whether a shallow copy of a value of the given type shares memory
+func (g *generator) needsDeepCopy(expr ast.Expr) (bool, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if isBasic(t.Name) {
+			return false, nil
+		}
+		return g.namedNeedsDeepCopy(t.Name)
+	case *ast.SelectorExpr:
+		name := g.exprString(t)
+		if valueSelectors[name] {
+			return false, nil
+		}
+		if byteSliceSelectors[name] {
+			return true, nil
+		}
+		return false, fmt.Errorf("unsupported type %s", name)
+	case *ast.StarExpr, *ast.MapType, *ast.InterfaceType:
+		return true, nil
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return true, nil
+		}
+		return g.needsDeepCopy(t.Elt)
+	case *ast.StructType:
+		for _, field := range t.Fields.List {
+			deep, err := g.needsDeepCopy(field.Type)
+			if err != nil || deep {
+				return deep, err
+			}
+		}
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported type %s", g.exprString(expr))
+}
+
+func (g *generator) namedNeedsDeepCopy(name string) (bool, error) {
+	if deep, ok := g.needDeep[name]; ok {
+		return deep, nil
+	}
+	typeSpec, ok := g.types[name]
+	if !ok {
+		return false, fmt.Errorf("unknown type %s", name)
+	}
+	// assume no deep copy while resolving recursive types
+	g.needDeep[name] = false
+	deep, err := g.needsDeepCopy(typeSpec.Type)
+	g.needDeep[name] = deep
+	return deep, err
+}
+
+// copyValue returns the statements deep copying inV into outV, given outV is already a shallow copy of inV
+func (g *generator) copyValue(expr ast.Expr, inV, outV string) (string, error) {
+	deep, err := g.needsDeepCopy(expr)
+	if err != nil || !deep {
+		return "", err
+	}
+
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return fmt.Sprintf("%s.DeepCopyInto(&%s)\n", inV, outV), nil
+	case *ast.SelectorExpr:
+		g.imports["encoding/json"] = true
+		return fmt.Sprintf("if %s != nil {\nin, out := &%s, &%s\n*out = make(%s, len(*in))\ncopy(*out, *in)\n}\n",
+			inV, inV, outV, g.exprString(t)), nil
+	case *ast.InterfaceType:
+		return fmt.Sprintf("%s = deepCopyJSONValue(%s)\n", outV, inV), nil
+	case *ast.ArrayType:
+		elemCopy, err := g.copyValue(t.Elt, "(*in)[i]", "(*out)[i]")
+		if err != nil {
+			return "", err
+		}
+		if elemCopy == "" {
+			elemCopy = "copy(*out, *in)\n"
+		} else {
+			elemCopy = "for i := range *in {\n" + elemCopy + "}\n"
+		}
+		return fmt.Sprintf("if %s != nil {\nin, out := &%s, &%s\n*out = make(%s, len(*in))\n%s}\n",
+			inV, inV, outV, g.exprString(t), elemCopy), nil
+	case *ast.MapType:
+		valCopy, err := g.copyValue(t.Value, "val", "outVal")
+		if err != nil {
+			return "", err
+		}
+		if _, ok := t.Value.(*ast.InterfaceType); ok {
+			valCopy = "(*out)[key] = deepCopyJSONValue(val)\n"
+		} else if valCopy == "" {
+			valCopy = "(*out)[key] = val\n"
+		} else {
+			valCopy = "outVal := val\n" + valCopy + "(*out)[key] = outVal\n"
+		}
+		return fmt.Sprintf("if %s != nil {\nin, out := &%s, &%s\n*out = make(%s, len(*in))\nfor key, val := range *in {\n%s}\n}\n",
+			inV, inV, outV, g.exprString(t), valCopy), nil
+	case *ast.StarExpr:
+		var elemCopy string
+		if ident, ok := t.X.(*ast.Ident); ok && !isBasic(ident.Name) {
+			elemCopy = "(*in).DeepCopyInto(*out)\n"
+		} else {
+			elemCopy, err = g.copyValue(t.X, "**in", "**out")
+			if err != nil {
+				return "", err
+			}
+			elemCopy = "**out = **in\n" + elemCopy
+		}
+		return fmt.Sprintf("if %s != nil {\nin, out := &%s, &%s\n*out = new(%s)\n%s}\n",
+			inV, inV, outV, g.exprString(t.X), elemCopy), nil
+	case *ast.StructType:
+		var buf bytes.Buffer
+		for _, field := range t.Fields.List {
+			for _, name := range field.Names {
+				stmt, err := g.copyValue(field.Type, inV+"."+name.Name, outV+"."+name.Name)
+				if err != nil {
+					return "", err
+				}
+				buf.WriteString(stmt)
+			}
+		}
+		return buf.String(), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", g.exprString(expr))
+}
+
+func (g *generator) generate(w *bytes.Buffer, typeSpec *ast.TypeSpec) error {
+	name := typeSpec.Name.Name
+
+	if _, ok := typeSpec.Type.(*ast.StructType); ok {
+		body, err := g.copyValue(typeSpec.Type, "in", "out")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "// DeepCopyInto copies all properties of this object into another object of the same type.\n")
+		fmt.Fprintf(w, "func (in *%s) DeepCopyInto(out *%s) {\n*out = *in\n%s}\n\n", name, name, body)
+		fmt.Fprintf(w, "// DeepCopy creates a new %s copying the receiver.\n", name)
+		fmt.Fprintf(w, "func (in *%s) DeepCopy() *%s {\nif in == nil {\nreturn nil\n}\nout := new(%s)\nin.DeepCopyInto(out)\nreturn out\n}\n\n", name, name, name)
+		return nil
+	}
+
+	body, err := g.copyValue(typeSpec.Type, "in", "(*out)")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "// DeepCopyInto copies all properties of this object into another object of the same type.\n")
+	fmt.Fprintf(w, "func (in %s) DeepCopyInto(out *%s) {\n*out = in\n%s}\n\n", name, name, body)
+	fmt.Fprintf(w, "// DeepCopy creates a new %s copying the receiver.\n", name)
+	fmt.Fprintf(w, "func (in %s) DeepCopy() %s {\nif in == nil {\nreturn nil\n}\nout := new(%s)\nin.DeepCopyInto(out)\nreturn *out\n}\n\n", name, name, name)
+	return nil
+}
+
+const deepCopyJSONValueFunc = `
+// deepCopyJSONValue copies values decoded from JSON into interface{} fields
+func deepCopyJSONValue(in interface{}) interface{} {
+	switch t := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for key, val := range t {
+			out[key] = deepCopyJSONValue(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = deepCopyJSONValue(val)
+		}
+		return out
+	default:
+		return in
+	}
+}
+`
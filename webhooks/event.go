@@ -0,0 +1,125 @@
+// Package webhooks decodes the XML event payloads 3scale delivers to webhook receivers.
+package webhooks
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Event types
+const (
+	EventTypeAccount     = "account"
+	EventTypeApplication = "application"
+	EventTypeUser        = "user"
+	EventTypeLimitAlert  = "limit_alert"
+)
+
+// Event actions
+const (
+	ActionCreated        = "created"
+	ActionUpdated        = "updated"
+	ActionDeleted        = "deleted"
+	ActionPlanChanged    = "plan_changed"
+	ActionSuspended      = "suspended"
+	ActionUserKeyUpdated = "user_key_updated"
+	ActionKeyCreated     = "key_created"
+	ActionKeyDeleted     = "key_deleted"
+)
+
+// ErrUnknownEvent is returned by Decode when the event carries none of the known objects
+var ErrUnknownEvent = errors.New("unknown webhook event object")
+
+// Event - Webhook payload. Only the object matching Type is set
+type Event struct {
+	XMLName     xml.Name     `xml:"event"`
+	Type        string       `xml:"type"`
+	Action      string       `xml:"action"`
+	Account     *Account     `xml:"object>account"`
+	Application *Application `xml:"object>application"`
+	User        *User        `xml:"object>user"`
+	Alert       *Alert       `xml:"object>alert"`
+}
+
+// Plan - Plan embedded in account and application objects
+type Plan struct {
+	ID         int64  `xml:"id"`
+	Name       string `xml:"name"`
+	SystemName string `xml:"system_name"`
+	Type       string `xml:"type"`
+	State      string `xml:"state"`
+	ServiceID  int64  `xml:"service_id"`
+	Default    bool   `xml:"default,attr"`
+	Custom     bool   `xml:"custom,attr"`
+}
+
+// Account - Developer account object
+type Account struct {
+	ID                     int64  `xml:"id"`
+	CreatedAt              string `xml:"created_at"`
+	UpdatedAt              string `xml:"updated_at"`
+	State                  string `xml:"state"`
+	OrgName                string `xml:"org_name"`
+	MonthlyBillingEnabled  bool   `xml:"monthly_billing_enabled"`
+	MonthlyChargingEnabled bool   `xml:"monthly_charging_enabled"`
+	CreditCardStored       bool   `xml:"credit_card_stored"`
+	Plans                  []Plan `xml:"plans>plan"`
+	Users                  []User `xml:"users>user"`
+}
+
+// Application - Application object
+type Application struct {
+	ID            int64    `xml:"id"`
+	CreatedAt     string   `xml:"created_at"`
+	UpdatedAt     string   `xml:"updated_at"`
+	State         string   `xml:"state"`
+	UserAccountID int64    `xml:"user_account_id"`
+	ServiceID     int64    `xml:"service_id"`
+	Name          string   `xml:"name"`
+	Description   string   `xml:"description"`
+	UserKey       string   `xml:"user_key"`
+	ApplicationID string   `xml:"application_id"`
+	Keys          []string `xml:"keys>key"`
+	Plan          *Plan    `xml:"plan"`
+}
+
+// User - Developer user object
+type User struct {
+	ID        int64  `xml:"id"`
+	CreatedAt string `xml:"created_at"`
+	UpdatedAt string `xml:"updated_at"`
+	AccountID int64  `xml:"account_id"`
+	State     string `xml:"state"`
+	Role      string `xml:"role"`
+	Username  string `xml:"username"`
+	Email     string `xml:"email"`
+}
+
+// Alert - Usage limit alert object
+type Alert struct {
+	ID            int64   `xml:"id"`
+	AlertID       int64   `xml:"alert_id"`
+	Timestamp     string  `xml:"timestamp"`
+	Level         int     `xml:"level"`
+	Message       string  `xml:"message"`
+	State         string  `xml:"state"`
+	ApplicationID int64   `xml:"application_id"`
+	ServiceID     int64   `xml:"service_id"`
+	Utilization   float64 `xml:"utilization"`
+}
+
+// Decode reads a webhook XML payload.
+// Returns ErrUnknownEvent when the payload is well formed but carries an object not modelled here
+func Decode(r io.Reader) (*Event, error) {
+	event := &Event{}
+	if err := xml.NewDecoder(r).Decode(event); err != nil {
+		return nil, fmt.Errorf("decoding webhook event: %v", err)
+	}
+
+	if event.Account == nil && event.Application == nil && event.User == nil && event.Alert == nil {
+		return event, fmt.Errorf("%w: type %q action %q", ErrUnknownEvent, event.Type, event.Action)
+	}
+
+	return event, nil
+}
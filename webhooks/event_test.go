@@ -0,0 +1,145 @@
+package webhooks
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const applicationCreatedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<event>
+  <type>application</type>
+  <action>created</action>
+  <object>
+    <application>
+      <id>10</id>
+      <created_at>2020-03-31T10:00:00Z</created_at>
+      <updated_at>2020-03-31T10:00:00Z</updated_at>
+      <state>live</state>
+      <user_account_id>3</user_account_id>
+      <service_id>2</service_id>
+      <user_key>abc123</user_key>
+      <name>My App</name>
+      <description>Mobile app</description>
+      <plan custom="false" default="true">
+        <id>7</id>
+        <name>Basic</name>
+        <type>application_plan</type>
+        <state>published</state>
+        <service_id>2</service_id>
+      </plan>
+    </application>
+  </object>
+</event>`
+
+const accountUpdatedXML = `<?xml version="1.0" encoding="UTF-8"?>
+<event>
+  <type>account</type>
+  <action>updated</action>
+  <object>
+    <account>
+      <id>3</id>
+      <state>approved</state>
+      <org_name>ACME</org_name>
+      <monthly_billing_enabled>true</monthly_billing_enabled>
+      <users>
+        <user>
+          <id>4</id>
+          <account_id>3</account_id>
+          <state>active</state>
+          <role>admin</role>
+          <username>john</username>
+          <email>john@example.com</email>
+        </user>
+      </users>
+    </account>
+  </object>
+</event>`
+
+const limitAlertXML = `<?xml version="1.0" encoding="UTF-8"?>
+<event>
+  <type>limit_alert</type>
+  <action>created</action>
+  <object>
+    <alert>
+      <id>1</id>
+      <alert_id>5</alert_id>
+      <timestamp>2020-03-31 10:00:00 UTC</timestamp>
+      <level>80</level>
+      <message>hits per minute: 80 of 100</message>
+      <state>unread</state>
+      <application_id>10</application_id>
+      <service_id>2</service_id>
+      <utilization>0.8</utilization>
+    </alert>
+  </object>
+</event>`
+
+func TestDecodeApplication(t *testing.T) {
+	event, err := Decode(strings.NewReader(applicationCreatedXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, EventTypeApplication, event.Type)
+	equals(t, ActionCreated, event.Action)
+	if event.Application == nil {
+		t.Fatal("application object not decoded")
+	}
+	equals(t, int64(10), event.Application.ID)
+	equals(t, int64(3), event.Application.UserAccountID)
+	equals(t, "abc123", event.Application.UserKey)
+	equals(t, &Plan{ID: 7, Name: "Basic", Type: "application_plan", State: "published", ServiceID: 2, Default: true}, event.Application.Plan)
+	equals(t, (*Account)(nil), event.Account)
+}
+
+func TestDecodeAccount(t *testing.T) {
+	event, err := Decode(strings.NewReader(accountUpdatedXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, EventTypeAccount, event.Type)
+	equals(t, ActionUpdated, event.Action)
+	equals(t, "ACME", event.Account.OrgName)
+	equals(t, true, event.Account.MonthlyBillingEnabled)
+	equals(t, []User{{ID: 4, AccountID: 3, State: "active", Role: "admin", Username: "john", Email: "john@example.com"}}, event.Account.Users)
+}
+
+func TestDecodeLimitAlert(t *testing.T) {
+	event, err := Decode(strings.NewReader(limitAlertXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	equals(t, EventTypeLimitAlert, event.Type)
+	equals(t, 80, event.Alert.Level)
+	equals(t, 0.8, event.Alert.Utilization)
+	equals(t, int64(10), event.Alert.ApplicationID)
+}
+
+func TestDecodeUnknownEvent(t *testing.T) {
+	payload := `<event><type>service</type><action>created</action><object><service><id>1</id></service></object></event>`
+
+	event, err := Decode(strings.NewReader(payload))
+	if !errors.Is(err, ErrUnknownEvent) {
+		t.Fatalf("expected ErrUnknownEvent; got %v", err)
+	}
+	equals(t, "service", event.Type)
+}
+
+func TestDecodeInvalidPayload(t *testing.T) {
+	for _, payload := range []string{"", "<event><type>", "<alert><id>1</id></alert>"} {
+		if _, err := Decode(strings.NewReader(payload)); err == nil || errors.Is(err, ErrUnknownEvent) {
+			t.Fatalf("payload %q: expected decoding error; got %v", payload, err)
+		}
+	}
+}
+
+func equals(tb testing.TB, exp, act interface{}) {
+	tb.Helper()
+	if !reflect.DeepEqual(exp, act) {
+		tb.Fatalf("exp: %#v\n\n\tgot: %#v", exp, act)
+	}
+}